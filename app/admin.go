@@ -0,0 +1,126 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/services/auth/entity"
+	userStorage "github.com/citizenkz/core/services/auth/storage"
+	userUsecase "github.com/citizenkz/core/services/auth/usecase"
+	outboxStorage "github.com/citizenkz/core/services/outbox/storage"
+	"github.com/citizenkz/core/utils/lockout"
+	"github.com/citizenkz/core/utils/validate"
+)
+
+const resendEmailUsage = "usage: resend-email verification|password-reset <email>"
+
+// withUserUsecase opens the database and runs fn with the auth usecase the
+// server would build.
+func withUserUsecase(ctx context.Context, cfg *config.Config, log *slog.Logger, fn func(userUsecase.UseCase) error) error {
+	client, err := openClient(ctx, cfg, log)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	storage := userStorage.New(client, log)
+
+	return fn(userUsecase.New(log, storage, outboxStorage.New(client, log), lockout.NewMemoryStore(), cfg))
+}
+
+// runCreateAdmin creates a verified admin account. The password is read from
+// stdin so it doesn't end up in the shell history.
+func runCreateAdmin(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	req := &entity.CreateAdminRequest{}
+
+	flags := flag.NewFlagSet("create-admin", flag.ContinueOnError)
+	flags.StringVar(&req.Email, "email", "", "admin email")
+	flags.StringVar(&req.FirstName, "first-name", "", "admin first name")
+	flags.StringVar(&req.LastName, "last-name", "", "admin last name")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+	req.Password = strings.TrimRight(password, "\r\n")
+
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+
+	return withUserUsecase(ctx, cfg, log, func(usecase userUsecase.UseCase) error {
+		resp, err := usecase.CreateAdmin(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		log.Info("admin created", slog.Int("id", resp.Profile.ID), slog.String("email", resp.Profile.Email))
+		return nil
+	})
+}
+
+// runPurgeAttempts deletes the password reset attempts that have expired.
+func runPurgeAttempts(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	if len(args) != 0 {
+		return errors.New("usage: purge-attempts")
+	}
+
+	return withUserUsecase(ctx, cfg, log, func(usecase userUsecase.UseCase) error {
+		resp, err := usecase.PurgeExpiredAttempts(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Info("expired attempts purged", slog.Int("deleted", resp.Deleted))
+		return nil
+	})
+}
+
+// runResendEmail queues a user's verification or password reset email again,
+// for when the first one was lost.
+func runResendEmail(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	if len(args) != 2 {
+		return errors.New(resendEmailUsage)
+	}
+	kind, email := args[0], args[1]
+
+	return withUserUsecase(ctx, cfg, log, func(usecase userUsecase.UseCase) error {
+		switch kind {
+		case "verification":
+			req := &entity.ResendVerificationByEmailRequest{Email: email}
+			if err := validate.Struct(req); err != nil {
+				return err
+			}
+			if _, err := usecase.ResendVerificationByEmail(ctx, req); err != nil {
+				return err
+			}
+			log.Info("verification email queued", slog.String("email", email))
+			return nil
+		case "password-reset":
+			req := &entity.ForgetPasswordRequest{Email: email}
+			if err := validate.Struct(req); err != nil {
+				return err
+			}
+			resp, err := usecase.ResendPasswordResetByEmail(ctx, req)
+			if err != nil {
+				return err
+			}
+			log.Info("password reset email queued", slog.String("attempt_id", resp.AttemptID.String()))
+			return nil
+		default:
+			return errors.New(resendEmailUsage)
+		}
+	})
+}
@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/citizenkz/core/config"
-	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/auth/consts"
 	userServer "github.com/citizenkz/core/services/auth/server"
 	userStorage "github.com/citizenkz/core/services/auth/storage"
 	userUsecase "github.com/citizenkz/core/services/auth/usecase"
@@ -23,6 +24,16 @@ import (
 	filterServer "github.com/citizenkz/core/services/filter/server"
 	filterStorage "github.com/citizenkz/core/services/filter/storage"
 	filterUsecase "github.com/citizenkz/core/services/filter/usecase"
+	outboxServer "github.com/citizenkz/core/services/outbox/server"
+	outboxStorage "github.com/citizenkz/core/services/outbox/storage"
+	outboxUsecase "github.com/citizenkz/core/services/outbox/usecase"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/citizenkz/core/utils/lockout"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -31,7 +42,9 @@ import (
 )
 
 type Server interface {
-	Run() error
+	// Run serves until ctx is cancelled, then stops taking new requests and
+	// waits for the running ones to finish.
+	Run(ctx context.Context) error
 }
 
 type server struct {
@@ -46,99 +59,225 @@ func New(cfg *config.Config, log *slog.Logger) Server {
 	}
 }
 
-func (s *server) Run() error {
+func (s *server) Run(ctx context.Context) error {
+	shutdownTracing, err := tracing.Setup(ctx, s.cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("failed to tracing.Setup: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			s.log.Error("failed to flush spans", slog.String("error", err.Error()))
+		}
+	}()
+
 	router := chi.NewRouter()
-	router.Use(middleware.Logger)
+	// Behind a proxy every request comes from its address, which would put
+	// all clients in one lockout budget
+	if s.cfg.HTTP.TrustProxy {
+		router.Use(middleware.RealIP)
+	}
+	router.Use(tracing.Middleware)
+	router.Use(logger.Middleware(s.log, jwt.Identify(s.cfg.JwtSecret)))
+	// A panicking handler answers 500 instead of dropping the connection
+	router.Use(middleware.Recoverer)
+	router.Use(metrics.Middleware)
+	router.Use(locale.Middleware)
 	router.Use(middleware.URLFormat)
 	router.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", logger.RequestIDHeader},
+		ExposedHeaders:   []string{"Link", logger.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
-	entPsqlConnect := fmt.Sprintf("host=%s port=%d user=%s dbname=%s password=%s sslmode=%s",
-		s.cfg.Database.Host,
-		s.cfg.Database.Port,
-		s.cfg.Database.User,
-		s.cfg.Database.Name,
-		s.cfg.Database.Password,
-		s.cfg.Database.SSLMode,
-	)
-
-	client, err := ent.Open("postgres", entPsqlConnect)
+	db, err := openDB(ctx, s.cfg, s.log)
 	if err != nil {
-		s.log.Error("failed opening connection to postgres", slog.String("error", err.Error()))
+		return err
 	}
+	client := newClient(db)
 	defer client.Close()
 
-	if err := client.Schema.Create(context.Background()); err != nil {
-		s.log.Error("failed creating schema resources", slog.String("error", err.Error()))
+	health := newHealth(s.log, db, s.cfg)
+	router.Get("/healthz", health.HandleHealthz)
+	router.Get("/readyz", health.HandleReadyz)
+	router.Handle("/metrics", metrics.Handler())
+
+	outboxStorage := outboxStorage.New(client, s.log)
+	emailSender, err := email.New(s.cfg, s.log)
+	if err != nil {
+		return fmt.Errorf("failed to email.New: %w", err)
 	}
+	outboxUsecase := outboxUsecase.New(s.log, outboxStorage, emailSender, s.cfg)
+	outboxServer := outboxServer.New(s.log, outboxUsecase)
 
 	userStorage := userStorage.New(client, s.log)
-	userUsecase := userUsecase.New(s.log, userStorage, s.cfg)
+	lockoutStore := lockout.NewMemoryStore()
+	userUsecase := userUsecase.New(s.log, userStorage, outboxStorage, lockoutStore, s.cfg)
 	userServer := userServer.New(s.log, userUsecase)
 
+	benefitStorage := benefitStorage.New(client, s.log)
+
 	filterStorage := filterStorage.New(s.log, client)
-	filterUsecase := filterUsecase.New(s.log, filterStorage, s.cfg)
+	filterUsecase := filterUsecase.New(s.log, filterStorage, benefitStorage, s.cfg)
 	filterServer := filterServer.New(s.log, filterUsecase)
 
 	categoryStorage := categoryStorage.New(client, s.log)
 	categoryUsecase := categoryUsecase.New(s.log, categoryStorage, s.cfg)
 	categoryServer := categoryServer.New(s.log, categoryUsecase)
 
-	benefitStorage := benefitStorage.New(client, s.log)
 	benefitUsecase := benefitUsecase.New(s.log, benefitStorage, s.cfg)
 	benefitServer := benefitServer.New(s.log, benefitUsecase)
 
 	childStorage := childStorage.New(client, s.log)
-	childUsecase := childUsecase.New(s.log, childStorage, s.cfg)
+	childUsecase := childUsecase.New(s.log, childStorage, benefitStorage, s.cfg)
 	childServer := childServer.New(s.log, childUsecase)
 
+	requireEditor := jwt.RequireRole(s.cfg.JwtSecret, consts.Editor.String(), consts.Admin.String())
+	requireAdmin := jwt.RequireRole(s.cfg.JwtSecret, consts.Admin.String())
+	// Routes acting on a user's data need a confirmed email. Account routes
+	// under /auth stay open so an unverified user can confirm or correct it
+	requireVerified := jwt.RequireVerified(s.cfg.JwtSecret)
+
 	router.Route("/api/v1", func(apiRouter chi.Router) {
 		apiRouter.Route("/auth", func(authRouter chi.Router) {
 			authRouter.Post("/login", userServer.HandleLogin)
+			authRouter.Post("/login/2fa", userServer.HandleLoginTwoFactor)
 			authRouter.Post("/register", userServer.HandleRegister)
+			authRouter.Post("/refresh", userServer.HandleRefresh)
+			authRouter.Post("/logout", userServer.HandleLogout)
 			authRouter.Get("/profile", userServer.HandleGet)
 			authRouter.Put("/password", userServer.HandleUpdatePassword)
 			authRouter.Put("/email", userServer.HandleUpdateEmail)
+			authRouter.Put("/locale", userServer.HandleUpdateLocale)
+			authRouter.Post("/verify-email", userServer.HandleVerifyEmail)
+			authRouter.Post("/verify-email/resend", userServer.HandleResendVerification)
 			authRouter.Delete("/profile", userServer.HandleDelete)
 			authRouter.Post("/forget-password", userServer.HandleForgetPassword)
 			authRouter.Post("/forget-password/confirm", userServer.HandleForgetPasswordConfirm)
+			authRouter.Post("/forget-password/reset", userServer.HandleResetPassword)
+			authRouter.Post("/2fa/enroll", userServer.HandleEnrollTOTP)
+			authRouter.Post("/2fa/confirm", userServer.HandleConfirmTOTP)
+			authRouter.Post("/2fa/disable", userServer.HandleDisableTOTP)
+			authRouter.With(requireVerified, requireAdmin).Put("/role", userServer.HandleGrantRole)
+			authRouter.With(requireVerified, requireAdmin).Put("/2fa/require", userServer.HandleRequireTOTP)
 		})
 		apiRouter.Route("/filter", func(filterRouter chi.Router) {
-			filterRouter.Post("/", filterServer.Create)
-			filterRouter.Post("/save", filterServer.SaveUserFitlers)
+			filterRouter.With(requireVerified).Post("/save", filterServer.SaveUserFitlers)
 			filterRouter.Get("/", filterServer.List)
-			filterRouter.Delete("/{id}", filterServer.Delete)
+			filterRouter.With(requireVerified).Get("/next", filterServer.Next)
+			filterRouter.Group(func(editorRouter chi.Router) {
+				editorRouter.Use(requireVerified, requireEditor)
+				editorRouter.Post("/", filterServer.Create)
+				editorRouter.Delete("/{id}", filterServer.Delete)
+				editorRouter.Get("/{id}/translations", filterServer.ListTranslations)
+				editorRouter.Put("/{id}/translations/{locale}", filterServer.SaveTranslation)
+				editorRouter.Delete("/{id}/translations/{locale}", filterServer.DeleteTranslation)
+				editorRouter.Post("/translations/missing", filterServer.MissingTranslations)
+			})
 		})
 		apiRouter.Route("/category", func(categoryRouter chi.Router) {
-			categoryRouter.Post("/", categoryServer.HandleCreate)
 			categoryRouter.Post("/list", categoryServer.HandleList)
 			categoryRouter.Get("/{id}", categoryServer.HandleGet)
-			categoryRouter.Put("/{id}", categoryServer.HandleUpdate)
-			categoryRouter.Delete("/{id}", categoryServer.HandleDelete)
+			categoryRouter.Group(func(editorRouter chi.Router) {
+				editorRouter.Use(requireVerified, requireEditor)
+				editorRouter.Post("/", categoryServer.HandleCreate)
+				editorRouter.Put("/{id}", categoryServer.HandleUpdate)
+				editorRouter.Delete("/{id}", categoryServer.HandleDelete)
+				editorRouter.Get("/{id}/translations", categoryServer.HandleListTranslations)
+				editorRouter.Put("/{id}/translations/{locale}", categoryServer.HandleSaveTranslation)
+				editorRouter.Delete("/{id}/translations/{locale}", categoryServer.HandleDeleteTranslation)
+				editorRouter.Post("/translations/missing", categoryServer.HandleMissingTranslations)
+			})
 		})
 		apiRouter.Route("/benefit", func(benefitRouter chi.Router) {
-			benefitRouter.Post("/", benefitServer.HandleCreate)
 			benefitRouter.Post("/list", benefitServer.HandleList)
+			benefitRouter.With(requireVerified).Get("/eligible", benefitServer.HandleEligible)
 			benefitRouter.Get("/{id}", benefitServer.HandleGet)
-			benefitRouter.Put("/{id}", benefitServer.HandleUpdate)
-			benefitRouter.Delete("/{id}", benefitServer.HandleDelete)
+			benefitRouter.Group(func(editorRouter chi.Router) {
+				editorRouter.Use(requireVerified, requireEditor)
+				editorRouter.Post("/", benefitServer.HandleCreate)
+				editorRouter.Put("/{id}", benefitServer.HandleUpdate)
+				editorRouter.Delete("/{id}", benefitServer.HandleDelete)
+				editorRouter.Get("/{id}/translations", benefitServer.HandleListTranslations)
+				editorRouter.Put("/{id}/translations/{locale}", benefitServer.HandleSaveTranslation)
+				editorRouter.Delete("/{id}/translations/{locale}", benefitServer.HandleDeleteTranslation)
+				editorRouter.Post("/translations/missing", benefitServer.HandleMissingTranslations)
+			})
 		})
 		apiRouter.Route("/child", func(childRouter chi.Router) {
+			childRouter.Use(requireVerified)
 			childRouter.Post("/", childServer.HandleCreate)
 			childRouter.Post("/list", childServer.HandleList)
 			childRouter.Get("/{id}", childServer.HandleGet)
 			childRouter.Put("/{id}", childServer.HandleUpdate)
 			childRouter.Delete("/{id}", childServer.HandleDelete)
+			childRouter.Get("/{id}/benefits", childServer.HandleBenefits)
 			childRouter.Post("/filters", childServer.HandleSaveFilters)
 		})
+		apiRouter.Route("/outbox", func(outboxRouter chi.Router) {
+			outboxRouter.Use(requireVerified, requireAdmin)
+			outboxRouter.Post("/list", outboxServer.HandleList)
+			outboxRouter.Post("/retry", outboxServer.HandleRetryDead)
+			outboxRouter.Post("/{id}/retry", outboxServer.HandleRetry)
+		})
 	})
 
-	s.log.Debug("server running", slog.String("address", fmt.Sprintf("localhost:%d", s.cfg.Port)))
-	return http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", s.cfg.Port), router)
+	// The worker outlives ctx so emails queued while draining still go out
+	workerCtx, stopWorker := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorker()
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		outboxUsecase.Work(workerCtx)
+	}()
+
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf("0.0.0.0:%d", s.cfg.Port),
+		Handler:           router,
+		ReadTimeout:       s.cfg.HTTP.ReadTimeout,
+		ReadHeaderTimeout: s.cfg.HTTP.ReadHeaderTimeout,
+		WriteTimeout:      s.cfg.HTTP.WriteTimeout,
+		IdleTimeout:       s.cfg.HTTP.IdleTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		s.log.Debug("server running", slog.String("address", fmt.Sprintf("localhost:%d", s.cfg.Port)))
+		serveErr <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.log.Info("shutting down", slog.Duration("drain_delay", s.cfg.HTTP.DrainDelay))
+	health.drain()
+
+	// Keep serving until the load balancer has seen readiness fail
+	select {
+	case <-time.After(s.cfg.HTTP.DrainDelay):
+	case err := <-serveErr:
+		return err
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.HTTP.ShutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to httpServer.Shutdown: %w", err)
+	}
+
+	// Emails queued after a request answered go out with the last batch
+	userUsecase.Wait()
+
+	// Let the worker finish the batch it is on
+	stopWorker()
+	<-workerDone
+
+	s.log.Info("server stopped")
+	return nil
 }
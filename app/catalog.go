@@ -0,0 +1,283 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/citizenkz/core/config"
+	benefitConsts "github.com/citizenkz/core/services/benefit/consts"
+	benefitEntity "github.com/citizenkz/core/services/benefit/entity"
+	benefitStorage "github.com/citizenkz/core/services/benefit/storage"
+	categoryEntity "github.com/citizenkz/core/services/category/entity"
+	categoryStorage "github.com/citizenkz/core/services/category/storage"
+	filterEntity "github.com/citizenkz/core/services/filter/entity"
+	filterStorage "github.com/citizenkz/core/services/filter/storage"
+	"github.com/citizenkz/core/utils/validate"
+)
+
+//go:embed seed.json
+var seedCatalog []byte
+
+type (
+	// catalog is the file format of the import command. Benefits refer to
+	// filters and categories by name, so a file can be written by hand and
+	// imported into any database.
+	catalog struct {
+		Filters    []filterEntity.CreateRequest   `json:"filters"`
+		Categories []categoryEntity.CreateRequest `json:"categories"`
+		Benefits   []catalogBenefit               `json:"benefits"`
+	}
+
+	catalogBenefit struct {
+		Title      string          `json:"title" validate:"required,max=255"`
+		Content    string          `json:"content"`
+		Bonus      string          `json:"bonus"`
+		VideoURL   *string         `json:"video_url,omitempty"`
+		SourceURL  *string         `json:"source_url,omitempty"`
+		Categories []string        `json:"categories,omitempty"`
+		Filters    []catalogFilter `json:"filters,omitempty"`
+		Rules      *catalogRule    `json:"rules,omitempty"`
+	}
+
+	catalogFilter struct {
+		Filter string  `json:"filter" validate:"required"`
+		Value  *string `json:"value,omitempty"`
+		From   *string `json:"from,omitempty"`
+		To     *string `json:"to,omitempty"`
+	}
+
+	catalogRule struct {
+		Operator benefitConsts.Operator `json:"operator" validate:"required,oneof=AND OR NOT"`
+		Filters  []catalogFilter        `json:"filters,omitempty"`
+		Groups   []catalogRule          `json:"groups,omitempty"`
+	}
+)
+
+// runSeed imports the built-in demo catalog.
+func runSeed(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	if len(args) != 0 {
+		return errors.New("usage: seed")
+	}
+
+	return importCatalog(ctx, cfg, log, seedCatalog)
+}
+
+// runImport imports a catalog file.
+func runImport(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: import <catalog.json>")
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	return importCatalog(ctx, cfg, log, data)
+}
+
+// importCatalog creates the filters, categories and benefits of a catalog
+// that don't exist yet, matching filters and categories by name and benefits
+// by title, so importing the same file twice changes nothing.
+func importCatalog(ctx context.Context, cfg *config.Config, log *slog.Logger, data []byte) error {
+	c := &catalog{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("failed to parse catalog: %w", err)
+	}
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+
+	client, err := openClient(ctx, cfg, log)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	filterIDs, err := importFilters(ctx, filterStorage.New(log, client), c.Filters)
+	if err != nil {
+		return err
+	}
+
+	categoryIDs, err := importCategories(ctx, categoryStorage.New(client, log), c.Categories)
+	if err != nil {
+		return err
+	}
+
+	// Every reference is checked before the first benefit is written
+	requests := make([]*benefitEntity.CreateRequest, 0, len(c.Benefits))
+	for _, b := range c.Benefits {
+		req, err := b.createRequest(filterIDs, categoryIDs)
+		if err != nil {
+			return fmt.Errorf("benefit %q: %w", b.Title, err)
+		}
+		requests = append(requests, req)
+	}
+
+	return importBenefits(ctx, log, benefitStorage.New(client, log), requests)
+}
+
+func importFilters(ctx context.Context, storage filterStorage.Storage, filters []filterEntity.CreateRequest) (map[string]int, error) {
+	existing, err := storage.List(ctx, &filterEntity.ListRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to storage.List: %w", err)
+	}
+
+	ids := make(map[string]int, len(existing))
+	for _, f := range existing {
+		ids[f.Name] = f.ID
+	}
+
+	for _, req := range filters {
+		if _, ok := ids[req.Name]; ok {
+			continue
+		}
+
+		created, err := storage.Create(ctx, &req)
+		if err != nil {
+			return nil, fmt.Errorf("filter %q: failed to storage.Create: %w", req.Name, err)
+		}
+		ids[created.Name] = created.ID
+	}
+
+	return ids, nil
+}
+
+func importCategories(ctx context.Context, storage categoryStorage.Storage, categories []categoryEntity.CreateRequest) (map[string]int, error) {
+	existing, _, err := storage.ListCategories(ctx, &categoryEntity.ListRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to storage.ListCategories: %w", err)
+	}
+
+	ids := make(map[string]int, len(existing))
+	for _, c := range existing {
+		ids[c.Name] = c.ID
+	}
+
+	for _, req := range categories {
+		if _, ok := ids[req.Name]; ok {
+			continue
+		}
+
+		created, err := storage.CreateCategory(ctx, &req)
+		if err != nil {
+			return nil, fmt.Errorf("category %q: failed to storage.CreateCategory: %w", req.Name, err)
+		}
+		ids[created.Name] = created.ID
+	}
+
+	return ids, nil
+}
+
+func importBenefits(ctx context.Context, log *slog.Logger, storage benefitStorage.Storage, requests []*benefitEntity.CreateRequest) error {
+	existing, _, err := storage.ListBenefits(ctx, &benefitEntity.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to storage.ListBenefits: %w", err)
+	}
+
+	titles := make(map[string]bool, len(existing))
+	for _, b := range existing {
+		titles[b.Title] = true
+	}
+
+	created := 0
+	for _, req := range requests {
+		if titles[req.Title] {
+			continue
+		}
+
+		if _, err := storage.CreateBenefit(ctx, req); err != nil {
+			return fmt.Errorf("benefit %q: failed to storage.CreateBenefit: %w", req.Title, err)
+		}
+		titles[req.Title] = true
+		created++
+	}
+
+	log.Info("catalog imported",
+		slog.Int("benefits_created", created),
+		slog.Int("benefits_skipped", len(requests)-created),
+	)
+
+	return nil
+}
+
+// createRequest resolves the filter and category names of a benefit.
+func (b *catalogBenefit) createRequest(filterIDs, categoryIDs map[string]int) (*benefitEntity.CreateRequest, error) {
+	req := &benefitEntity.CreateRequest{
+		Title:     b.Title,
+		Content:   b.Content,
+		Bonus:     b.Bonus,
+		VideoURL:  b.VideoURL,
+		SourceURL: b.SourceURL,
+	}
+
+	for _, name := range b.Categories {
+		id, ok := categoryIDs[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown category %q", name)
+		}
+		req.Categories = append(req.Categories, id)
+	}
+
+	filters, err := resolveFilters(b.Filters, filterIDs)
+	if err != nil {
+		return nil, err
+	}
+	req.Filters = filters
+
+	if b.Rules != nil {
+		if req.Rules, err = b.Rules.ruleGroupRequest(filterIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+func (r *catalogRule) ruleGroupRequest(filterIDs map[string]int) (*benefitEntity.RuleGroupRequest, error) {
+	filters, err := resolveFilters(r.Filters, filterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &benefitEntity.RuleGroupRequest{
+		Operator: r.Operator,
+		Filters:  filters,
+	}
+
+	for _, g := range r.Groups {
+		child, err := g.ruleGroupRequest(filterIDs)
+		if err != nil {
+			return nil, err
+		}
+		group.Groups = append(group.Groups, *child)
+	}
+
+	return group, nil
+}
+
+func resolveFilters(filters []catalogFilter, filterIDs map[string]int) ([]benefitEntity.BenefitFilterRequest, error) {
+	requests := make([]benefitEntity.BenefitFilterRequest, 0, len(filters))
+	for _, f := range filters {
+		id, ok := filterIDs[f.Filter]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter %q", f.Filter)
+		}
+		requests = append(requests, benefitEntity.BenefitFilterRequest{
+			FilterID: id,
+			Value:    f.Value,
+			From:     f.From,
+			To:       f.To,
+		})
+	}
+
+	return requests, nil
+}
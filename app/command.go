@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/utils/apperr"
+)
+
+const commandUsage = `commands:
+  migrate up | down [steps] | status | baseline <version>
+  seed                                   import the demo catalog
+  import <catalog.json>                  import filters, categories and benefits
+  create-admin -email E -first-name F -last-name L   password is read from stdin
+  purge-attempts                         delete expired password reset attempts
+  resend-email verification|password-reset <email>`
+
+type command func(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error
+
+var commands = map[string]command{
+	"migrate":        runMigrate,
+	"seed":           runSeed,
+	"import":         runImport,
+	"create-admin":   runCreateAdmin,
+	"purge-attempts": runPurgeAttempts,
+	"resend-email":   runResendEmail,
+}
+
+// RunCommand runs the subcommand named by args[0] instead of the server.
+func RunCommand(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	run, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q\n%s", args[0], commandUsage)
+	}
+
+	return describeFields(run(ctx, cfg, log, args[1:]))
+}
+
+// describeFields spells out the fields of a validation error, which a
+// terminal can't show the way an HTTP client does.
+func describeFields(err error) error {
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) || len(appErr.Fields) == 0 {
+		return err
+	}
+
+	fields := make([]string, 0, len(appErr.Fields))
+	for field, msg := range appErr.Fields {
+		fields = append(fields, field+" "+msg)
+	}
+	slices.Sort(fields)
+
+	return fmt.Errorf("%w: %s", err, strings.Join(fields, "; "))
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+// openClient connects to the database and refuses one that isn't fully
+// migrated. Closing the client closes the connection.
+func openClient(ctx context.Context, cfg *config.Config, log *slog.Logger) (*ent.Client, error) {
+	db, err := openDB(ctx, cfg, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(db), nil
+}
+
+// newClient wraps db in an ent client whose queries are recorded in metrics
+// and traced.
+func newClient(db *sql.DB) *ent.Client {
+	drv := entsql.OpenDB(dialect.Postgres, db)
+	return ent.NewClient(ent.Driver(tracing.NewDriver(metrics.NewDriver(drv))))
+}
+
+// openDB connects to the database and refuses one that isn't fully migrated.
+func openDB(ctx context.Context, cfg *config.Config, log *slog.Logger) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.Database.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
+	}
+
+	// The schema is owned by the versioned migrations, never changed at boot
+	if err := checkMigrations(ctx, log, db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
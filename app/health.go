@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/json"
+)
+
+// pingTimeout bounds the database check of a readiness probe.
+const pingTimeout = 2 * time.Second
+
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+type (
+	healthResponse struct {
+		Status string `json:"status"`
+	}
+
+	readyResponse struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks"`
+	}
+)
+
+// health answers the orchestrator's probes. Liveness only says the process
+// serves requests; readiness also needs the database, and turns false as soon
+// as shutdown starts so no new traffic is routed here while requests drain.
+type health struct {
+	log      *slog.Logger
+	db       *sql.DB
+	cfg      *config.Config
+	draining atomic.Bool
+}
+
+func newHealth(log *slog.Logger, db *sql.DB, cfg *config.Config) *health {
+	return &health{
+		log: log,
+		db:  db,
+		cfg: cfg,
+	}
+}
+
+func (h *health) HandleHealthz(w http.ResponseWriter, r *http.Request) {
+	json.WriteJSON(w, http.StatusOK, &healthResponse{Status: statusOK})
+}
+
+// HandleReadyz reports every check. The email transport, and for SMTP
+// whether it is configured, is reported but doesn't fail the probe: emails
+// wait in the outbox, and a restart wouldn't fix it.
+func (h *health) HandleReadyz(w http.ResponseWriter, r *http.Request) {
+	resp := &readyResponse{
+		Status: statusOK,
+		Checks: map[string]string{
+			"database": statusOK,
+			"email":    h.cfg.Email.Transport,
+		},
+	}
+
+	if h.cfg.Email.Transport == email.TransportSMTP {
+		resp.Checks["smtp"] = "configured"
+		if !h.cfg.SMTP.Configured() {
+			resp.Checks["smtp"] = "not_configured"
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), pingTimeout)
+	defer cancel()
+
+	if err := h.db.PingContext(ctx); err != nil {
+		h.log.Error("failed to db.PingContext", slog.String("error", err.Error()))
+		resp.Status = statusUnavailable
+		resp.Checks["database"] = statusUnavailable
+	}
+
+	if h.draining.Load() {
+		resp.Status = statusUnavailable
+		resp.Checks["shutdown"] = "draining"
+	}
+
+	status := http.StatusOK
+	if resp.Status != statusOK {
+		status = http.StatusServiceUnavailable
+	}
+
+	json.WriteJSON(w, status, resp)
+}
+
+// drain fails readiness from now on.
+func (h *health) drain() {
+	h.draining.Store(true)
+}
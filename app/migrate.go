@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/migrations"
+	"github.com/citizenkz/core/utils/migrate"
+)
+
+const migrateUsage = "usage: migrate up | down [steps] | status | baseline <version>"
+
+// runMigrate applies, reverts or lists the versioned migrations.
+func runMigrate(ctx context.Context, cfg *config.Config, log *slog.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	db, err := sql.Open("postgres", cfg.Database.DSN())
+	if err != nil {
+		return fmt.Errorf("failed opening connection to postgres: %w", err)
+	}
+	defer db.Close()
+
+	migrator, err := migrate.New(log, db, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("failed to migrate.New: %w", err)
+	}
+
+	var done []migrate.Migration
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		done, err = migrator.Up(ctx)
+	case args[0] == "down" && len(args) <= 2:
+		steps := 1
+		if len(args) == 2 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("steps must be a positive number: %q", args[1])
+			}
+		}
+		done, err = migrator.Down(ctx, steps)
+	case args[0] == "baseline" && len(args) == 2:
+		done, err = migrator.Baseline(ctx, args[1])
+	case args[0] == "status" && len(args) == 1:
+		return printMigrationStatus(ctx, migrator)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	for _, migration := range done {
+		log.Info("migration "+args[0],
+			slog.String("version", migration.Version),
+			slog.String("name", migration.Name),
+		)
+	}
+	if err != nil {
+		return err
+	}
+	if len(done) == 0 {
+		log.Info("nothing to migrate")
+	}
+
+	return nil
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil && !errors.Is(err, migrate.ErrUnknownVersion) {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+	for _, status := range statuses {
+		applied := "pending"
+		if status.AppliedAt != nil {
+			applied = status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", status.Version, status.Name, applied)
+	}
+	if flushErr := w.Flush(); flushErr != nil {
+		return flushErr
+	}
+
+	return err
+}
+
+// checkMigrations refuses to serve a database that isn't fully migrated.
+func checkMigrations(ctx context.Context, log *slog.Logger, db *sql.DB) error {
+	migrator, err := migrate.New(log, db, migrations.FS)
+	if err != nil {
+		return fmt.Errorf("failed to migrate.New: %w", err)
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("database is %d migration(s) behind, run `migrate up` first", len(pending))
+	}
+
+	return nil
+}
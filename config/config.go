@@ -4,16 +4,57 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Env       string         `yaml:"env" env-default:"local" env:"ENV"`
-	Database  DatabaseConfig `yaml:"database"`
-	Port      int            `yaml:"port" env-default:"8080" env:"PORT"`
-	JwtSecret string         `yaml:"jwtsecret" env:"JWT_SECRET"`
-	SMTP      SMTPConfig     `yaml:"smtp"`
+	Env       string          `yaml:"env" env-default:"local" env:"ENV"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Port      int             `yaml:"port" env-default:"8080" env:"PORT"`
+	HTTP      HTTPConfig      `yaml:"http"`
+	JwtSecret string          `yaml:"jwtsecret" env:"JWT_SECRET"`
+	Token     TokenConfig     `yaml:"token"`
+	TwoFactor TwoFactorConfig `yaml:"two_factor"`
+	Lockout   LockoutConfig   `yaml:"lockout"`
+	Email     EmailConfig     `yaml:"email"`
+	SMTP      SMTPConfig      `yaml:"smtp"`
+	Outbox    OutboxConfig    `yaml:"outbox"`
+	Tracing   TracingConfig   `yaml:"tracing"`
+}
+
+// HTTPConfig bounds how long a connection may hold the server. On shutdown
+// readiness fails for DrainDelay so load balancers stop routing here, then
+// requests still running after ShutdownTimeout are cut off. TrustProxy takes
+// the client address from True-Client-IP, X-Real-IP or X-Forwarded-For, which
+// only a proxy that overwrites them may be trusted to set.
+type HTTPConfig struct {
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" env-default:"15s"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT" env-default:"5s"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" env-default:"30s"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" env-default:"120s"`
+	DrainDelay        time.Duration `yaml:"drain_delay" env:"HTTP_DRAIN_DELAY" env-default:"5s"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" env-default:"20s"`
+	TrustProxy        bool          `yaml:"trust_proxy" env:"HTTP_TRUST_PROXY" env-default:"false"`
+}
+
+type TokenConfig struct {
+	AccessTTL  time.Duration `yaml:"access_ttl" env:"ACCESS_TOKEN_TTL" env-default:"15m"`
+	RefreshTTL time.Duration `yaml:"refresh_ttl" env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+}
+
+type TwoFactorConfig struct {
+	Issuer       string        `yaml:"issuer" env:"TOTP_ISSUER" env-default:"Citizen"`
+	ChallengeTTL time.Duration `yaml:"challenge_ttl" env:"TOTP_CHALLENGE_TTL" env-default:"5m"`
+}
+
+type LockoutConfig struct {
+	MaxFailures   int           `yaml:"max_failures" env:"LOCKOUT_MAX_FAILURES" env-default:"5"`
+	IPMaxFailures int           `yaml:"ip_max_failures" env:"LOCKOUT_IP_MAX_FAILURES" env-default:"50"`
+	LockDuration  time.Duration `yaml:"lock_duration" env:"LOCKOUT_DURATION" env-default:"15m"`
+	BaseDelay     time.Duration `yaml:"base_delay" env:"LOCKOUT_BASE_DELAY" env-default:"1s"`
+	MaxDelay      time.Duration `yaml:"max_delay" env:"LOCKOUT_MAX_DELAY" env-default:"30s"`
 }
 
 type DatabaseConfig struct {
@@ -25,12 +66,69 @@ type DatabaseConfig struct {
 	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE"`
 }
 
+// DSN is the lib/pq connection string for the database.
+func (c DatabaseConfig) DSN() string {
+	return fmt.Sprintf("host=%s port=%d user=%s dbname=%s password=%s sslmode=%s",
+		c.Host,
+		c.Port,
+		c.User,
+		c.Name,
+		c.Password,
+		c.SSLMode,
+	)
+}
+
+// TracingConfig selects where spans go: "none" drops them, "stdout" prints
+// them and "otlp" sends them over OTLP/HTTP to Endpoint, e.g. a collector.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"`
+	Endpoint    string  `yaml:"endpoint" env:"TRACING_ENDPOINT" env-default:"localhost:4318"`
+	Insecure    bool    `yaml:"insecure" env:"TRACING_INSECURE" env-default:"true"`
+	ServiceName string  `yaml:"service_name" env:"TRACING_SERVICE_NAME" env-default:"citizen"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+// EmailConfig selects how emails leave: "smtp" sends them through SMTP,
+// "file" writes them to a maildir at Dir and "log" only logs them.
+type EmailConfig struct {
+	Transport string `yaml:"transport" env:"EMAIL_TRANSPORT" env-default:"smtp"`
+	Dir       string `yaml:"dir" env:"EMAIL_DIR" env-default:"maildir"`
+}
+
+// SMTPConfig reaches the mail server. TLS is "starttls" to upgrade a plain
+// connection, usually on port 587, "tls" for implicit TLS, usually on port
+// 465, or "none" for local relays.
 type SMTPConfig struct {
-	Host     string `yaml:"host" env:"SMTP_HOST" env-default:"smtp.gmail.com"`
-	Port     int    `yaml:"port" env:"SMTP_PORT" env-default:"587"`
-	Username string `yaml:"username" env:"SMTP_USERNAME"`
-	Password string `yaml:"password" env:"SMTP_PASSWORD"`
-	From     string `yaml:"from" env:"SMTP_FROM"`
+	Host     string        `yaml:"host" env:"SMTP_HOST" env-default:"smtp.gmail.com"`
+	Port     int           `yaml:"port" env:"SMTP_PORT" env-default:"587"`
+	Username string        `yaml:"username" env:"SMTP_USERNAME"`
+	Password string        `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string        `yaml:"from" env:"SMTP_FROM"`
+	TLS      string        `yaml:"tls" env:"SMTP_TLS" env-default:"starttls"`
+	Timeout  time.Duration `yaml:"timeout" env:"SMTP_TIMEOUT" env-default:"30s"`
+}
+
+// OutboxConfig paces the worker that delivers queued emails. A failed email
+// is retried after BaseDelay, doubling up to MaxDelay, and dead-lettered
+// after MaxAttempts. Lease is how long a worker holds a batch before another
+// may pick it up.
+type OutboxConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL" env-default:"5s"`
+	BatchSize    int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE" env-default:"20"`
+	MaxAttempts  int           `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS" env-default:"8"`
+	BaseDelay    time.Duration `yaml:"base_delay" env:"OUTBOX_BASE_DELAY" env-default:"30s"`
+	MaxDelay     time.Duration `yaml:"max_delay" env:"OUTBOX_MAX_DELAY" env-default:"1h"`
+	Lease        time.Duration `yaml:"lease" env:"OUTBOX_LEASE" env-default:"2m"`
+}
+
+// Configured reports whether every setting needed to send mail is set.
+// Relays reached without TLS may go without credentials.
+func (c SMTPConfig) Configured() bool {
+	if c.Host == "" || c.Port == 0 || (c.From == "" && c.Username == "") {
+		return false
+	}
+
+	return c.TLS == "none" || (c.Username != "" && c.Password != "")
 }
 
 func MustLoad() *Config {
@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -18,9 +19,17 @@ type Attempt struct {
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
 	// Otp holds the value of the "otp" field.
-	Otp string `json:"otp,omitempty"`
+	Otp string `json:"-"`
 	// Email holds the value of the "email" field.
-	Email        string `json:"email,omitempty"`
+	Email string `json:"email,omitempty"`
+	// Tries holds the value of the "tries" field.
+	Tries int `json:"tries,omitempty"`
+	// ResetTokenHash holds the value of the "reset_token_hash" field.
+	ResetTokenHash *string `json:"-"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -29,8 +38,12 @@ func (*Attempt) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attempt.FieldOtp, attempt.FieldEmail:
+		case attempt.FieldTries:
+			values[i] = new(sql.NullInt64)
+		case attempt.FieldOtp, attempt.FieldEmail, attempt.FieldResetTokenHash:
 			values[i] = new(sql.NullString)
+		case attempt.FieldVerifiedAt, attempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
 		case attempt.FieldID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -66,6 +79,32 @@ func (_m *Attempt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Email = value.String
 			}
+		case attempt.FieldTries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tries", values[i])
+			} else if value.Valid {
+				_m.Tries = int(value.Int64)
+			}
+		case attempt.FieldResetTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_token_hash", values[i])
+			} else if value.Valid {
+				_m.ResetTokenHash = new(string)
+				*_m.ResetTokenHash = value.String
+			}
+		case attempt.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				_m.VerifiedAt = new(time.Time)
+				*_m.VerifiedAt = value.Time
+			}
+		case attempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -102,11 +141,23 @@ func (_m *Attempt) String() string {
 	var builder strings.Builder
 	builder.WriteString("Attempt(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("otp=")
-	builder.WriteString(_m.Otp)
+	builder.WriteString("otp=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("tries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tries))
+	builder.WriteString(", ")
+	builder.WriteString("reset_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package attempt
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"github.com/citizenkz/core/ent/predicate"
 	"github.com/google/uuid"
@@ -63,6 +65,26 @@ func Email(v string) predicate.Attempt {
 	return predicate.Attempt(sql.FieldEQ(FieldEmail, v))
 }
 
+// Tries applies equality check predicate on the "tries" field. It's identical to TriesEQ.
+func Tries(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldTries, v))
+}
+
+// ResetTokenHash applies equality check predicate on the "reset_token_hash" field. It's identical to ResetTokenHashEQ.
+func ResetTokenHash(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldResetTokenHash, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // OtpEQ applies the EQ predicate on the "otp" field.
 func OtpEQ(v string) predicate.Attempt {
 	return predicate.Attempt(sql.FieldEQ(FieldOtp, v))
@@ -193,6 +215,211 @@ func EmailContainsFold(v string) predicate.Attempt {
 	return predicate.Attempt(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// TriesEQ applies the EQ predicate on the "tries" field.
+func TriesEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldTries, v))
+}
+
+// TriesNEQ applies the NEQ predicate on the "tries" field.
+func TriesNEQ(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldTries, v))
+}
+
+// TriesIn applies the In predicate on the "tries" field.
+func TriesIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldTries, vs...))
+}
+
+// TriesNotIn applies the NotIn predicate on the "tries" field.
+func TriesNotIn(vs ...int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldTries, vs...))
+}
+
+// TriesGT applies the GT predicate on the "tries" field.
+func TriesGT(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldTries, v))
+}
+
+// TriesGTE applies the GTE predicate on the "tries" field.
+func TriesGTE(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldTries, v))
+}
+
+// TriesLT applies the LT predicate on the "tries" field.
+func TriesLT(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldTries, v))
+}
+
+// TriesLTE applies the LTE predicate on the "tries" field.
+func TriesLTE(v int) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldTries, v))
+}
+
+// ResetTokenHashEQ applies the EQ predicate on the "reset_token_hash" field.
+func ResetTokenHashEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashNEQ applies the NEQ predicate on the "reset_token_hash" field.
+func ResetTokenHashNEQ(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashIn applies the In predicate on the "reset_token_hash" field.
+func ResetTokenHashIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldResetTokenHash, vs...))
+}
+
+// ResetTokenHashNotIn applies the NotIn predicate on the "reset_token_hash" field.
+func ResetTokenHashNotIn(vs ...string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldResetTokenHash, vs...))
+}
+
+// ResetTokenHashGT applies the GT predicate on the "reset_token_hash" field.
+func ResetTokenHashGT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashGTE applies the GTE predicate on the "reset_token_hash" field.
+func ResetTokenHashGTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashLT applies the LT predicate on the "reset_token_hash" field.
+func ResetTokenHashLT(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashLTE applies the LTE predicate on the "reset_token_hash" field.
+func ResetTokenHashLTE(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashContains applies the Contains predicate on the "reset_token_hash" field.
+func ResetTokenHashContains(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContains(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashHasPrefix applies the HasPrefix predicate on the "reset_token_hash" field.
+func ResetTokenHashHasPrefix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasPrefix(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashHasSuffix applies the HasSuffix predicate on the "reset_token_hash" field.
+func ResetTokenHashHasSuffix(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldHasSuffix(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashIsNil applies the IsNil predicate on the "reset_token_hash" field.
+func ResetTokenHashIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldResetTokenHash))
+}
+
+// ResetTokenHashNotNil applies the NotNil predicate on the "reset_token_hash" field.
+func ResetTokenHashNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldResetTokenHash))
+}
+
+// ResetTokenHashEqualFold applies the EqualFold predicate on the "reset_token_hash" field.
+func ResetTokenHashEqualFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEqualFold(FieldResetTokenHash, v))
+}
+
+// ResetTokenHashContainsFold applies the ContainsFold predicate on the "reset_token_hash" field.
+func ResetTokenHashContainsFold(v string) predicate.Attempt {
+	return predicate.Attempt(sql.FieldContainsFold(FieldResetTokenHash, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Attempt {
+	return predicate.Attempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Attempt) predicate.Attempt {
 	return predicate.Attempt(sql.AndPredicates(predicates...))
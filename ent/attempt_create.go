@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -32,6 +33,62 @@ func (_c *AttemptCreate) SetEmail(v string) *AttemptCreate {
 	return _c
 }
 
+// SetTries sets the "tries" field.
+func (_c *AttemptCreate) SetTries(v int) *AttemptCreate {
+	_c.mutation.SetTries(v)
+	return _c
+}
+
+// SetNillableTries sets the "tries" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableTries(v *int) *AttemptCreate {
+	if v != nil {
+		_c.SetTries(*v)
+	}
+	return _c
+}
+
+// SetResetTokenHash sets the "reset_token_hash" field.
+func (_c *AttemptCreate) SetResetTokenHash(v string) *AttemptCreate {
+	_c.mutation.SetResetTokenHash(v)
+	return _c
+}
+
+// SetNillableResetTokenHash sets the "reset_token_hash" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableResetTokenHash(v *string) *AttemptCreate {
+	if v != nil {
+		_c.SetResetTokenHash(*v)
+	}
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *AttemptCreate) SetVerifiedAt(v time.Time) *AttemptCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableVerifiedAt(v *time.Time) *AttemptCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AttemptCreate) SetCreatedAt(v time.Time) *AttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AttemptCreate) SetNillableCreatedAt(v *time.Time) *AttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AttemptCreate) SetID(v uuid.UUID) *AttemptCreate {
 	_c.mutation.SetID(v)
@@ -81,6 +138,14 @@ func (_c *AttemptCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AttemptCreate) defaults() {
+	if _, ok := _c.mutation.Tries(); !ok {
+		v := attempt.DefaultTries
+		_c.mutation.SetTries(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := attempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := attempt.DefaultID()
 		_c.mutation.SetID(v)
@@ -105,6 +170,12 @@ func (_c *AttemptCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Attempt.email": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Tries(); !ok {
+		return &ValidationError{Name: "tries", err: errors.New(`ent: missing required field "Attempt.tries"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Attempt.created_at"`)}
+	}
 	return nil
 }
 
@@ -148,6 +219,22 @@ func (_c *AttemptCreate) createSpec() (*Attempt, *sqlgraph.CreateSpec) {
 		_spec.SetField(attempt.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := _c.mutation.Tries(); ok {
+		_spec.SetField(attempt.FieldTries, field.TypeInt, value)
+		_node.Tries = value
+	}
+	if value, ok := _c.mutation.ResetTokenHash(); ok {
+		_spec.SetField(attempt.FieldResetTokenHash, field.TypeString, value)
+		_node.ResetTokenHash = &value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(attempt.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(attempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	return _node, _spec
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -55,6 +56,67 @@ func (_u *AttemptUpdate) SetNillableEmail(v *string) *AttemptUpdate {
 	return _u
 }
 
+// SetTries sets the "tries" field.
+func (_u *AttemptUpdate) SetTries(v int) *AttemptUpdate {
+	_u.mutation.ResetTries()
+	_u.mutation.SetTries(v)
+	return _u
+}
+
+// SetNillableTries sets the "tries" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableTries(v *int) *AttemptUpdate {
+	if v != nil {
+		_u.SetTries(*v)
+	}
+	return _u
+}
+
+// AddTries adds value to the "tries" field.
+func (_u *AttemptUpdate) AddTries(v int) *AttemptUpdate {
+	_u.mutation.AddTries(v)
+	return _u
+}
+
+// SetResetTokenHash sets the "reset_token_hash" field.
+func (_u *AttemptUpdate) SetResetTokenHash(v string) *AttemptUpdate {
+	_u.mutation.SetResetTokenHash(v)
+	return _u
+}
+
+// SetNillableResetTokenHash sets the "reset_token_hash" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableResetTokenHash(v *string) *AttemptUpdate {
+	if v != nil {
+		_u.SetResetTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearResetTokenHash clears the value of the "reset_token_hash" field.
+func (_u *AttemptUpdate) ClearResetTokenHash() *AttemptUpdate {
+	_u.mutation.ClearResetTokenHash()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *AttemptUpdate) SetVerifiedAt(v time.Time) *AttemptUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *AttemptUpdate) SetNillableVerifiedAt(v *time.Time) *AttemptUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *AttemptUpdate) ClearVerifiedAt() *AttemptUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
 // Mutation returns the AttemptMutation object of the builder.
 func (_u *AttemptUpdate) Mutation() *AttemptMutation {
 	return _u.mutation
@@ -120,6 +182,24 @@ func (_u *AttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(attempt.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Tries(); ok {
+		_spec.SetField(attempt.FieldTries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTries(); ok {
+		_spec.AddField(attempt.FieldTries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResetTokenHash(); ok {
+		_spec.SetField(attempt.FieldResetTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ResetTokenHashCleared() {
+		_spec.ClearField(attempt.FieldResetTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(attempt.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(attempt.FieldVerifiedAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{attempt.Label}
@@ -168,6 +248,67 @@ func (_u *AttemptUpdateOne) SetNillableEmail(v *string) *AttemptUpdateOne {
 	return _u
 }
 
+// SetTries sets the "tries" field.
+func (_u *AttemptUpdateOne) SetTries(v int) *AttemptUpdateOne {
+	_u.mutation.ResetTries()
+	_u.mutation.SetTries(v)
+	return _u
+}
+
+// SetNillableTries sets the "tries" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableTries(v *int) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetTries(*v)
+	}
+	return _u
+}
+
+// AddTries adds value to the "tries" field.
+func (_u *AttemptUpdateOne) AddTries(v int) *AttemptUpdateOne {
+	_u.mutation.AddTries(v)
+	return _u
+}
+
+// SetResetTokenHash sets the "reset_token_hash" field.
+func (_u *AttemptUpdateOne) SetResetTokenHash(v string) *AttemptUpdateOne {
+	_u.mutation.SetResetTokenHash(v)
+	return _u
+}
+
+// SetNillableResetTokenHash sets the "reset_token_hash" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableResetTokenHash(v *string) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetResetTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearResetTokenHash clears the value of the "reset_token_hash" field.
+func (_u *AttemptUpdateOne) ClearResetTokenHash() *AttemptUpdateOne {
+	_u.mutation.ClearResetTokenHash()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *AttemptUpdateOne) SetVerifiedAt(v time.Time) *AttemptUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *AttemptUpdateOne) SetNillableVerifiedAt(v *time.Time) *AttemptUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *AttemptUpdateOne) ClearVerifiedAt() *AttemptUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
 // Mutation returns the AttemptMutation object of the builder.
 func (_u *AttemptUpdateOne) Mutation() *AttemptMutation {
 	return _u.mutation
@@ -263,6 +404,24 @@ func (_u *AttemptUpdateOne) sqlSave(ctx context.Context) (_node *Attempt, err er
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(attempt.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Tries(); ok {
+		_spec.SetField(attempt.FieldTries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTries(); ok {
+		_spec.AddField(attempt.FieldTries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResetTokenHash(); ok {
+		_spec.SetField(attempt.FieldResetTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ResetTokenHashCleared() {
+		_spec.ClearField(attempt.FieldResetTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(attempt.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(attempt.FieldVerifiedAt, field.TypeTime)
+	}
 	_node = &Attempt{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
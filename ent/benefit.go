@@ -38,9 +38,13 @@ type BenefitEdges struct {
 	BenefitFilters []*BenefitFilter `json:"benefit_filters,omitempty"`
 	// BenefitCategories holds the value of the benefit_categories edge.
 	BenefitCategories []*BenefitCategory `json:"benefit_categories,omitempty"`
+	// RuleGroups holds the value of the rule_groups edge.
+	RuleGroups []*RuleGroup `json:"rule_groups,omitempty"`
+	// Translations holds the value of the translations edge.
+	Translations []*BenefitTranslation `json:"translations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // BenefitFiltersOrErr returns the BenefitFilters value or an error if the edge
@@ -61,6 +65,24 @@ func (e BenefitEdges) BenefitCategoriesOrErr() ([]*BenefitCategory, error) {
 	return nil, &NotLoadedError{edge: "benefit_categories"}
 }
 
+// RuleGroupsOrErr returns the RuleGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e BenefitEdges) RuleGroupsOrErr() ([]*RuleGroup, error) {
+	if e.loadedTypes[2] {
+		return e.RuleGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "rule_groups"}
+}
+
+// TranslationsOrErr returns the Translations value or an error if the edge
+// was not loaded in eager-loading.
+func (e BenefitEdges) TranslationsOrErr() ([]*BenefitTranslation, error) {
+	if e.loadedTypes[3] {
+		return e.Translations, nil
+	}
+	return nil, &NotLoadedError{edge: "translations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Benefit) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -146,6 +168,16 @@ func (_m *Benefit) QueryBenefitCategories() *BenefitCategoryQuery {
 	return NewBenefitClient(_m.config).QueryBenefitCategories(_m)
 }
 
+// QueryRuleGroups queries the "rule_groups" edge of the Benefit entity.
+func (_m *Benefit) QueryRuleGroups() *RuleGroupQuery {
+	return NewBenefitClient(_m.config).QueryRuleGroups(_m)
+}
+
+// QueryTranslations queries the "translations" edge of the Benefit entity.
+func (_m *Benefit) QueryTranslations() *BenefitTranslationQuery {
+	return NewBenefitClient(_m.config).QueryTranslations(_m)
+}
+
 // Update returns a builder for updating this Benefit.
 // Note that you need to call Benefit.Unwrap() before calling this method if this Benefit
 // was returned from a transaction, and the transaction was committed or rolled back.
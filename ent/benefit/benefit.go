@@ -26,6 +26,10 @@ const (
 	EdgeBenefitFilters = "benefit_filters"
 	// EdgeBenefitCategories holds the string denoting the benefit_categories edge name in mutations.
 	EdgeBenefitCategories = "benefit_categories"
+	// EdgeRuleGroups holds the string denoting the rule_groups edge name in mutations.
+	EdgeRuleGroups = "rule_groups"
+	// EdgeTranslations holds the string denoting the translations edge name in mutations.
+	EdgeTranslations = "translations"
 	// Table holds the table name of the benefit in the database.
 	Table = "benefits"
 	// BenefitFiltersTable is the table that holds the benefit_filters relation/edge.
@@ -42,6 +46,20 @@ const (
 	BenefitCategoriesInverseTable = "benefit_categories"
 	// BenefitCategoriesColumn is the table column denoting the benefit_categories relation/edge.
 	BenefitCategoriesColumn = "benefit_id"
+	// RuleGroupsTable is the table that holds the rule_groups relation/edge.
+	RuleGroupsTable = "rule_groups"
+	// RuleGroupsInverseTable is the table name for the RuleGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "rulegroup" package.
+	RuleGroupsInverseTable = "rule_groups"
+	// RuleGroupsColumn is the table column denoting the rule_groups relation/edge.
+	RuleGroupsColumn = "benefit_id"
+	// TranslationsTable is the table that holds the translations relation/edge.
+	TranslationsTable = "benefit_translations"
+	// TranslationsInverseTable is the table name for the BenefitTranslation entity.
+	// It exists in this package in order to avoid circular dependency with the "benefittranslation" package.
+	TranslationsInverseTable = "benefit_translations"
+	// TranslationsColumn is the table column denoting the translations relation/edge.
+	TranslationsColumn = "benefit_id"
 )
 
 // Columns holds all SQL columns for benefit fields.
@@ -124,6 +142,34 @@ func ByBenefitCategories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newBenefitCategoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRuleGroupsCount orders the results by rule_groups count.
+func ByRuleGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRuleGroupsStep(), opts...)
+	}
+}
+
+// ByRuleGroups orders the results by rule_groups terms.
+func ByRuleGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRuleGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTranslationsCount orders the results by translations count.
+func ByTranslationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranslationsStep(), opts...)
+	}
+}
+
+// ByTranslations orders the results by translations terms.
+func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranslationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBenefitFiltersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -138,3 +184,17 @@ func newBenefitCategoriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, BenefitCategoriesTable, BenefitCategoriesColumn),
 	)
 }
+func newRuleGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RuleGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RuleGroupsTable, RuleGroupsColumn),
+	)
+}
+func newTranslationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranslationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+	)
+}
@@ -469,6 +469,52 @@ func HasBenefitCategoriesWith(preds ...predicate.BenefitCategory) predicate.Bene
 	})
 }
 
+// HasRuleGroups applies the HasEdge predicate on the "rule_groups" edge.
+func HasRuleGroups() predicate.Benefit {
+	return predicate.Benefit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RuleGroupsTable, RuleGroupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRuleGroupsWith applies the HasEdge predicate on the "rule_groups" edge with a given conditions (other predicates).
+func HasRuleGroupsWith(preds ...predicate.RuleGroup) predicate.Benefit {
+	return predicate.Benefit(func(s *sql.Selector) {
+		step := newRuleGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTranslations applies the HasEdge predicate on the "translations" edge.
+func HasTranslations() predicate.Benefit {
+	return predicate.Benefit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranslationsWith applies the HasEdge predicate on the "translations" edge with a given conditions (other predicates).
+func HasTranslationsWith(preds ...predicate.BenefitTranslation) predicate.Benefit {
+	return predicate.Benefit(func(s *sql.Selector) {
+		step := newTranslationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Benefit) predicate.Benefit {
 	return predicate.Benefit(sql.AndPredicates(predicates...))
@@ -12,6 +12,8 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitCreate is the builder for creating a Benefit entity.
@@ -97,6 +99,36 @@ func (_c *BenefitCreate) AddBenefitCategories(v ...*BenefitCategory) *BenefitCre
 	return _c.AddBenefitCategoryIDs(ids...)
 }
 
+// AddRuleGroupIDs adds the "rule_groups" edge to the RuleGroup entity by IDs.
+func (_c *BenefitCreate) AddRuleGroupIDs(ids ...int) *BenefitCreate {
+	_c.mutation.AddRuleGroupIDs(ids...)
+	return _c
+}
+
+// AddRuleGroups adds the "rule_groups" edges to the RuleGroup entity.
+func (_c *BenefitCreate) AddRuleGroups(v ...*RuleGroup) *BenefitCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRuleGroupIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the BenefitTranslation entity by IDs.
+func (_c *BenefitCreate) AddTranslationIDs(ids ...int) *BenefitCreate {
+	_c.mutation.AddTranslationIDs(ids...)
+	return _c
+}
+
+// AddTranslations adds the "translations" edges to the BenefitTranslation entity.
+func (_c *BenefitCreate) AddTranslations(v ...*BenefitTranslation) *BenefitCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the BenefitMutation object of the builder.
 func (_c *BenefitCreate) Mutation() *BenefitMutation {
 	return _c.mutation
@@ -218,6 +250,38 @@ func (_c *BenefitCreate) createSpec() (*Benefit, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RuleGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
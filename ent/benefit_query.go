@@ -15,7 +15,9 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
 	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitQuery is the builder for querying Benefit entities.
@@ -27,6 +29,8 @@ type BenefitQuery struct {
 	predicates            []predicate.Benefit
 	withBenefitFilters    *BenefitFilterQuery
 	withBenefitCategories *BenefitCategoryQuery
+	withRuleGroups        *RuleGroupQuery
+	withTranslations      *BenefitTranslationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,50 @@ func (_q *BenefitQuery) QueryBenefitCategories() *BenefitCategoryQuery {
 	return query
 }
 
+// QueryRuleGroups chains the current query on the "rule_groups" edge.
+func (_q *BenefitQuery) QueryRuleGroups() *RuleGroupQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefit.Table, benefit.FieldID, selector),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, benefit.RuleGroupsTable, benefit.RuleGroupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTranslations chains the current query on the "translations" edge.
+func (_q *BenefitQuery) QueryTranslations() *BenefitTranslationQuery {
+	query := (&BenefitTranslationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefit.Table, benefit.FieldID, selector),
+			sqlgraph.To(benefittranslation.Table, benefittranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, benefit.TranslationsTable, benefit.TranslationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Benefit entity from the query.
 // Returns a *NotFoundError when no Benefit was found.
 func (_q *BenefitQuery) First(ctx context.Context) (*Benefit, error) {
@@ -301,6 +349,8 @@ func (_q *BenefitQuery) Clone() *BenefitQuery {
 		predicates:            append([]predicate.Benefit{}, _q.predicates...),
 		withBenefitFilters:    _q.withBenefitFilters.Clone(),
 		withBenefitCategories: _q.withBenefitCategories.Clone(),
+		withRuleGroups:        _q.withRuleGroups.Clone(),
+		withTranslations:      _q.withTranslations.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +379,28 @@ func (_q *BenefitQuery) WithBenefitCategories(opts ...func(*BenefitCategoryQuery
 	return _q
 }
 
+// WithRuleGroups tells the query-builder to eager-load the nodes that are connected to
+// the "rule_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BenefitQuery) WithRuleGroups(opts ...func(*RuleGroupQuery)) *BenefitQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRuleGroups = query
+	return _q
+}
+
+// WithTranslations tells the query-builder to eager-load the nodes that are connected to
+// the "translations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BenefitQuery) WithTranslations(opts ...func(*BenefitTranslationQuery)) *BenefitQuery {
+	query := (&BenefitTranslationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranslations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +479,11 @@ func (_q *BenefitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bene
 	var (
 		nodes       = []*Benefit{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withBenefitFilters != nil,
 			_q.withBenefitCategories != nil,
+			_q.withRuleGroups != nil,
+			_q.withTranslations != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +518,20 @@ func (_q *BenefitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bene
 			return nil, err
 		}
 	}
+	if query := _q.withRuleGroups; query != nil {
+		if err := _q.loadRuleGroups(ctx, query, nodes,
+			func(n *Benefit) { n.Edges.RuleGroups = []*RuleGroup{} },
+			func(n *Benefit, e *RuleGroup) { n.Edges.RuleGroups = append(n.Edges.RuleGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTranslations; query != nil {
+		if err := _q.loadTranslations(ctx, query, nodes,
+			func(n *Benefit) { n.Edges.Translations = []*BenefitTranslation{} },
+			func(n *Benefit, e *BenefitTranslation) { n.Edges.Translations = append(n.Edges.Translations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +595,66 @@ func (_q *BenefitQuery) loadBenefitCategories(ctx context.Context, query *Benefi
 	}
 	return nil
 }
+func (_q *BenefitQuery) loadRuleGroups(ctx context.Context, query *RuleGroupQuery, nodes []*Benefit, init func(*Benefit), assign func(*Benefit, *RuleGroup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Benefit)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rulegroup.FieldBenefitID)
+	}
+	query.Where(predicate.RuleGroup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(benefit.RuleGroupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BenefitID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "benefit_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BenefitQuery) loadTranslations(ctx context.Context, query *BenefitTranslationQuery, nodes []*Benefit, init func(*Benefit), assign func(*Benefit, *BenefitTranslation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Benefit)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(benefittranslation.FieldBenefitID)
+	}
+	query.Where(predicate.BenefitTranslation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(benefit.TranslationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BenefitID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "benefit_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *BenefitQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
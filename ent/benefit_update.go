@@ -13,7 +13,9 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
 	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitUpdate is the builder for updating Benefit entities.
@@ -141,6 +143,36 @@ func (_u *BenefitUpdate) AddBenefitCategories(v ...*BenefitCategory) *BenefitUpd
 	return _u.AddBenefitCategoryIDs(ids...)
 }
 
+// AddRuleGroupIDs adds the "rule_groups" edge to the RuleGroup entity by IDs.
+func (_u *BenefitUpdate) AddRuleGroupIDs(ids ...int) *BenefitUpdate {
+	_u.mutation.AddRuleGroupIDs(ids...)
+	return _u
+}
+
+// AddRuleGroups adds the "rule_groups" edges to the RuleGroup entity.
+func (_u *BenefitUpdate) AddRuleGroups(v ...*RuleGroup) *BenefitUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleGroupIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the BenefitTranslation entity by IDs.
+func (_u *BenefitUpdate) AddTranslationIDs(ids ...int) *BenefitUpdate {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the BenefitTranslation entity.
+func (_u *BenefitUpdate) AddTranslations(v ...*BenefitTranslation) *BenefitUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the BenefitMutation object of the builder.
 func (_u *BenefitUpdate) Mutation() *BenefitMutation {
 	return _u.mutation
@@ -188,6 +220,48 @@ func (_u *BenefitUpdate) RemoveBenefitCategories(v ...*BenefitCategory) *Benefit
 	return _u.RemoveBenefitCategoryIDs(ids...)
 }
 
+// ClearRuleGroups clears all "rule_groups" edges to the RuleGroup entity.
+func (_u *BenefitUpdate) ClearRuleGroups() *BenefitUpdate {
+	_u.mutation.ClearRuleGroups()
+	return _u
+}
+
+// RemoveRuleGroupIDs removes the "rule_groups" edge to RuleGroup entities by IDs.
+func (_u *BenefitUpdate) RemoveRuleGroupIDs(ids ...int) *BenefitUpdate {
+	_u.mutation.RemoveRuleGroupIDs(ids...)
+	return _u
+}
+
+// RemoveRuleGroups removes "rule_groups" edges to RuleGroup entities.
+func (_u *BenefitUpdate) RemoveRuleGroups(v ...*RuleGroup) *BenefitUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleGroupIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the BenefitTranslation entity.
+func (_u *BenefitUpdate) ClearTranslations() *BenefitUpdate {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to BenefitTranslation entities by IDs.
+func (_u *BenefitUpdate) RemoveTranslationIDs(ids ...int) *BenefitUpdate {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to BenefitTranslation entities.
+func (_u *BenefitUpdate) RemoveTranslations(v ...*BenefitTranslation) *BenefitUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BenefitUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -335,6 +409,96 @@ func (_u *BenefitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RuleGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRuleGroupsIDs(); len(nodes) > 0 && !_u.mutation.RuleGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{benefit.Label}
@@ -467,6 +631,36 @@ func (_u *BenefitUpdateOne) AddBenefitCategories(v ...*BenefitCategory) *Benefit
 	return _u.AddBenefitCategoryIDs(ids...)
 }
 
+// AddRuleGroupIDs adds the "rule_groups" edge to the RuleGroup entity by IDs.
+func (_u *BenefitUpdateOne) AddRuleGroupIDs(ids ...int) *BenefitUpdateOne {
+	_u.mutation.AddRuleGroupIDs(ids...)
+	return _u
+}
+
+// AddRuleGroups adds the "rule_groups" edges to the RuleGroup entity.
+func (_u *BenefitUpdateOne) AddRuleGroups(v ...*RuleGroup) *BenefitUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleGroupIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the BenefitTranslation entity by IDs.
+func (_u *BenefitUpdateOne) AddTranslationIDs(ids ...int) *BenefitUpdateOne {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the BenefitTranslation entity.
+func (_u *BenefitUpdateOne) AddTranslations(v ...*BenefitTranslation) *BenefitUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the BenefitMutation object of the builder.
 func (_u *BenefitUpdateOne) Mutation() *BenefitMutation {
 	return _u.mutation
@@ -514,6 +708,48 @@ func (_u *BenefitUpdateOne) RemoveBenefitCategories(v ...*BenefitCategory) *Bene
 	return _u.RemoveBenefitCategoryIDs(ids...)
 }
 
+// ClearRuleGroups clears all "rule_groups" edges to the RuleGroup entity.
+func (_u *BenefitUpdateOne) ClearRuleGroups() *BenefitUpdateOne {
+	_u.mutation.ClearRuleGroups()
+	return _u
+}
+
+// RemoveRuleGroupIDs removes the "rule_groups" edge to RuleGroup entities by IDs.
+func (_u *BenefitUpdateOne) RemoveRuleGroupIDs(ids ...int) *BenefitUpdateOne {
+	_u.mutation.RemoveRuleGroupIDs(ids...)
+	return _u
+}
+
+// RemoveRuleGroups removes "rule_groups" edges to RuleGroup entities.
+func (_u *BenefitUpdateOne) RemoveRuleGroups(v ...*RuleGroup) *BenefitUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleGroupIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the BenefitTranslation entity.
+func (_u *BenefitUpdateOne) ClearTranslations() *BenefitUpdateOne {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to BenefitTranslation entities by IDs.
+func (_u *BenefitUpdateOne) RemoveTranslationIDs(ids ...int) *BenefitUpdateOne {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to BenefitTranslation entities.
+func (_u *BenefitUpdateOne) RemoveTranslations(v ...*BenefitTranslation) *BenefitUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
 // Where appends a list predicates to the BenefitUpdate builder.
 func (_u *BenefitUpdateOne) Where(ps ...predicate.Benefit) *BenefitUpdateOne {
 	_u.mutation.Where(ps...)
@@ -691,6 +927,96 @@ func (_u *BenefitUpdateOne) sqlSave(ctx context.Context) (_node *Benefit, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RuleGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRuleGroupsIDs(); len(nodes) > 0 && !_u.mutation.RuleGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.RuleGroupsTable,
+			Columns: []string{benefit.RuleGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   benefit.TranslationsTable,
+			Columns: []string{benefit.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Benefit{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -11,6 +11,7 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitFilter is the model entity for the BenefitFilter schema.
@@ -28,6 +29,8 @@ type BenefitFilter struct {
 	From *string `json:"from,omitempty"`
 	// To holds the value of the "to" field.
 	To *string `json:"to,omitempty"`
+	// RuleGroupID holds the value of the "rule_group_id" field.
+	RuleGroupID *int `json:"rule_group_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BenefitFilterQuery when eager-loading is set.
 	Edges        BenefitFilterEdges `json:"edges"`
@@ -40,9 +43,11 @@ type BenefitFilterEdges struct {
 	Benefit *Benefit `json:"benefit,omitempty"`
 	// Filter holds the value of the filter edge.
 	Filter *Filter `json:"filter,omitempty"`
+	// RuleGroup holds the value of the rule_group edge.
+	RuleGroup *RuleGroup `json:"rule_group,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // BenefitOrErr returns the Benefit value or an error if the edge
@@ -67,12 +72,23 @@ func (e BenefitFilterEdges) FilterOrErr() (*Filter, error) {
 	return nil, &NotLoadedError{edge: "filter"}
 }
 
+// RuleGroupOrErr returns the RuleGroup value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BenefitFilterEdges) RuleGroupOrErr() (*RuleGroup, error) {
+	if e.RuleGroup != nil {
+		return e.RuleGroup, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: rulegroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "rule_group"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*BenefitFilter) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case benefitfilter.FieldID, benefitfilter.FieldBenefitID, benefitfilter.FieldFilterID:
+		case benefitfilter.FieldID, benefitfilter.FieldBenefitID, benefitfilter.FieldFilterID, benefitfilter.FieldRuleGroupID:
 			values[i] = new(sql.NullInt64)
 		case benefitfilter.FieldValue, benefitfilter.FieldFrom, benefitfilter.FieldTo:
 			values[i] = new(sql.NullString)
@@ -130,6 +146,13 @@ func (_m *BenefitFilter) assignValues(columns []string, values []any) error {
 				_m.To = new(string)
 				*_m.To = value.String
 			}
+		case benefitfilter.FieldRuleGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_group_id", values[i])
+			} else if value.Valid {
+				_m.RuleGroupID = new(int)
+				*_m.RuleGroupID = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -153,6 +176,11 @@ func (_m *BenefitFilter) QueryFilter() *FilterQuery {
 	return NewBenefitFilterClient(_m.config).QueryFilter(_m)
 }
 
+// QueryRuleGroup queries the "rule_group" edge of the BenefitFilter entity.
+func (_m *BenefitFilter) QueryRuleGroup() *RuleGroupQuery {
+	return NewBenefitFilterClient(_m.config).QueryRuleGroup(_m)
+}
+
 // Update returns a builder for updating this BenefitFilter.
 // Note that you need to call BenefitFilter.Unwrap() before calling this method if this BenefitFilter
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -196,6 +224,11 @@ func (_m *BenefitFilter) String() string {
 		builder.WriteString("to=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.RuleGroupID; v != nil {
+		builder.WriteString("rule_group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,10 +22,14 @@ const (
 	FieldFrom = "from"
 	// FieldTo holds the string denoting the to field in the database.
 	FieldTo = "to"
+	// FieldRuleGroupID holds the string denoting the rule_group_id field in the database.
+	FieldRuleGroupID = "rule_group_id"
 	// EdgeBenefit holds the string denoting the benefit edge name in mutations.
 	EdgeBenefit = "benefit"
 	// EdgeFilter holds the string denoting the filter edge name in mutations.
 	EdgeFilter = "filter"
+	// EdgeRuleGroup holds the string denoting the rule_group edge name in mutations.
+	EdgeRuleGroup = "rule_group"
 	// Table holds the table name of the benefitfilter in the database.
 	Table = "benefit_filters"
 	// BenefitTable is the table that holds the benefit relation/edge.
@@ -42,6 +46,13 @@ const (
 	FilterInverseTable = "filters"
 	// FilterColumn is the table column denoting the filter relation/edge.
 	FilterColumn = "filter_id"
+	// RuleGroupTable is the table that holds the rule_group relation/edge.
+	RuleGroupTable = "benefit_filters"
+	// RuleGroupInverseTable is the table name for the RuleGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "rulegroup" package.
+	RuleGroupInverseTable = "rule_groups"
+	// RuleGroupColumn is the table column denoting the rule_group relation/edge.
+	RuleGroupColumn = "rule_group_id"
 )
 
 // Columns holds all SQL columns for benefitfilter fields.
@@ -52,6 +63,7 @@ var Columns = []string{
 	FieldValue,
 	FieldFrom,
 	FieldTo,
+	FieldRuleGroupID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -97,6 +109,11 @@ func ByTo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTo, opts...).ToFunc()
 }
 
+// ByRuleGroupID orders the results by the rule_group_id field.
+func ByRuleGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleGroupID, opts...).ToFunc()
+}
+
 // ByBenefitField orders the results by benefit field.
 func ByBenefitField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -110,6 +127,13 @@ func ByFilterField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFilterStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRuleGroupField orders the results by rule_group field.
+func ByRuleGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRuleGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newBenefitStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -124,3 +148,10 @@ func newFilterStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, FilterTable, FilterColumn),
 	)
 }
+func newRuleGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RuleGroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RuleGroupTable, RuleGroupColumn),
+	)
+}
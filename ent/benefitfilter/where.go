@@ -78,6 +78,11 @@ func To(v string) predicate.BenefitFilter {
 	return predicate.BenefitFilter(sql.FieldEQ(FieldTo, v))
 }
 
+// RuleGroupID applies equality check predicate on the "rule_group_id" field. It's identical to RuleGroupIDEQ.
+func RuleGroupID(v int) predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldEQ(FieldRuleGroupID, v))
+}
+
 // BenefitIDEQ applies the EQ predicate on the "benefit_id" field.
 func BenefitIDEQ(v int) predicate.BenefitFilter {
 	return predicate.BenefitFilter(sql.FieldEQ(FieldBenefitID, v))
@@ -343,6 +348,36 @@ func ToContainsFold(v string) predicate.BenefitFilter {
 	return predicate.BenefitFilter(sql.FieldContainsFold(FieldTo, v))
 }
 
+// RuleGroupIDEQ applies the EQ predicate on the "rule_group_id" field.
+func RuleGroupIDEQ(v int) predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldEQ(FieldRuleGroupID, v))
+}
+
+// RuleGroupIDNEQ applies the NEQ predicate on the "rule_group_id" field.
+func RuleGroupIDNEQ(v int) predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldNEQ(FieldRuleGroupID, v))
+}
+
+// RuleGroupIDIn applies the In predicate on the "rule_group_id" field.
+func RuleGroupIDIn(vs ...int) predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldIn(FieldRuleGroupID, vs...))
+}
+
+// RuleGroupIDNotIn applies the NotIn predicate on the "rule_group_id" field.
+func RuleGroupIDNotIn(vs ...int) predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldNotIn(FieldRuleGroupID, vs...))
+}
+
+// RuleGroupIDIsNil applies the IsNil predicate on the "rule_group_id" field.
+func RuleGroupIDIsNil() predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldIsNull(FieldRuleGroupID))
+}
+
+// RuleGroupIDNotNil applies the NotNil predicate on the "rule_group_id" field.
+func RuleGroupIDNotNil() predicate.BenefitFilter {
+	return predicate.BenefitFilter(sql.FieldNotNull(FieldRuleGroupID))
+}
+
 // HasBenefit applies the HasEdge predicate on the "benefit" edge.
 func HasBenefit() predicate.BenefitFilter {
 	return predicate.BenefitFilter(func(s *sql.Selector) {
@@ -389,6 +424,29 @@ func HasFilterWith(preds ...predicate.Filter) predicate.BenefitFilter {
 	})
 }
 
+// HasRuleGroup applies the HasEdge predicate on the "rule_group" edge.
+func HasRuleGroup() predicate.BenefitFilter {
+	return predicate.BenefitFilter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RuleGroupTable, RuleGroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRuleGroupWith applies the HasEdge predicate on the "rule_group" edge with a given conditions (other predicates).
+func HasRuleGroupWith(preds ...predicate.RuleGroup) predicate.BenefitFilter {
+	return predicate.BenefitFilter(func(s *sql.Selector) {
+		step := newRuleGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BenefitFilter) predicate.BenefitFilter {
 	return predicate.BenefitFilter(sql.AndPredicates(predicates...))
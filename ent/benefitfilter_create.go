@@ -12,6 +12,7 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitFilterCreate is the builder for creating a BenefitFilter entity.
@@ -75,6 +76,20 @@ func (_c *BenefitFilterCreate) SetNillableTo(v *string) *BenefitFilterCreate {
 	return _c
 }
 
+// SetRuleGroupID sets the "rule_group_id" field.
+func (_c *BenefitFilterCreate) SetRuleGroupID(v int) *BenefitFilterCreate {
+	_c.mutation.SetRuleGroupID(v)
+	return _c
+}
+
+// SetNillableRuleGroupID sets the "rule_group_id" field if the given value is not nil.
+func (_c *BenefitFilterCreate) SetNillableRuleGroupID(v *int) *BenefitFilterCreate {
+	if v != nil {
+		_c.SetRuleGroupID(*v)
+	}
+	return _c
+}
+
 // SetBenefit sets the "benefit" edge to the Benefit entity.
 func (_c *BenefitFilterCreate) SetBenefit(v *Benefit) *BenefitFilterCreate {
 	return _c.SetBenefitID(v.ID)
@@ -85,6 +100,11 @@ func (_c *BenefitFilterCreate) SetFilter(v *Filter) *BenefitFilterCreate {
 	return _c.SetFilterID(v.ID)
 }
 
+// SetRuleGroup sets the "rule_group" edge to the RuleGroup entity.
+func (_c *BenefitFilterCreate) SetRuleGroup(v *RuleGroup) *BenefitFilterCreate {
+	return _c.SetRuleGroupID(v.ID)
+}
+
 // Mutation returns the BenefitFilterMutation object of the builder.
 func (_c *BenefitFilterCreate) Mutation() *BenefitFilterMutation {
 	return _c.mutation
@@ -203,6 +223,23 @@ func (_c *BenefitFilterCreate) createSpec() (*BenefitFilter, *sqlgraph.CreateSpe
 		_node.FilterID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RuleGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefitfilter.RuleGroupTable,
+			Columns: []string{benefitfilter.RuleGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RuleGroupID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
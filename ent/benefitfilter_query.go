@@ -15,17 +15,19 @@ import (
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/filter"
 	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitFilterQuery is the builder for querying BenefitFilter entities.
 type BenefitFilterQuery struct {
 	config
-	ctx         *QueryContext
-	order       []benefitfilter.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.BenefitFilter
-	withBenefit *BenefitQuery
-	withFilter  *FilterQuery
+	ctx           *QueryContext
+	order         []benefitfilter.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.BenefitFilter
+	withBenefit   *BenefitQuery
+	withFilter    *FilterQuery
+	withRuleGroup *RuleGroupQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (_q *BenefitFilterQuery) QueryFilter() *FilterQuery {
 	return query
 }
 
+// QueryRuleGroup chains the current query on the "rule_group" edge.
+func (_q *BenefitFilterQuery) QueryRuleGroup() *RuleGroupQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefitfilter.Table, benefitfilter.FieldID, selector),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, benefitfilter.RuleGroupTable, benefitfilter.RuleGroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first BenefitFilter entity from the query.
 // Returns a *NotFoundError when no BenefitFilter was found.
 func (_q *BenefitFilterQuery) First(ctx context.Context) (*BenefitFilter, error) {
@@ -293,13 +317,14 @@ func (_q *BenefitFilterQuery) Clone() *BenefitFilterQuery {
 		return nil
 	}
 	return &BenefitFilterQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]benefitfilter.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.BenefitFilter{}, _q.predicates...),
-		withBenefit: _q.withBenefit.Clone(),
-		withFilter:  _q.withFilter.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]benefitfilter.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.BenefitFilter{}, _q.predicates...),
+		withBenefit:   _q.withBenefit.Clone(),
+		withFilter:    _q.withFilter.Clone(),
+		withRuleGroup: _q.withRuleGroup.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,6 +353,17 @@ func (_q *BenefitFilterQuery) WithFilter(opts ...func(*FilterQuery)) *BenefitFil
 	return _q
 }
 
+// WithRuleGroup tells the query-builder to eager-load the nodes that are connected to
+// the "rule_group" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BenefitFilterQuery) WithRuleGroup(opts ...func(*RuleGroupQuery)) *BenefitFilterQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRuleGroup = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +442,10 @@ func (_q *BenefitFilterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*BenefitFilter{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withBenefit != nil,
 			_q.withFilter != nil,
+			_q.withRuleGroup != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +478,12 @@ func (_q *BenefitFilterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withRuleGroup; query != nil {
+		if err := _q.loadRuleGroup(ctx, query, nodes, nil,
+			func(n *BenefitFilter, e *RuleGroup) { n.Edges.RuleGroup = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +545,38 @@ func (_q *BenefitFilterQuery) loadFilter(ctx context.Context, query *FilterQuery
 	}
 	return nil
 }
+func (_q *BenefitFilterQuery) loadRuleGroup(ctx context.Context, query *RuleGroupQuery, nodes []*BenefitFilter, init func(*BenefitFilter), assign func(*BenefitFilter, *RuleGroup)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BenefitFilter)
+	for i := range nodes {
+		if nodes[i].RuleGroupID == nil {
+			continue
+		}
+		fk := *nodes[i].RuleGroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(rulegroup.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "rule_group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *BenefitFilterQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -534,6 +609,9 @@ func (_q *BenefitFilterQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withFilter != nil {
 			_spec.Node.AddColumnOnce(benefitfilter.FieldFilterID)
 		}
+		if _q.withRuleGroup != nil {
+			_spec.Node.AddColumnOnce(benefitfilter.FieldRuleGroupID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/filter"
 	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
 )
 
 // BenefitFilterUpdate is the builder for updating BenefitFilter entities.
@@ -117,6 +118,26 @@ func (_u *BenefitFilterUpdate) ClearTo() *BenefitFilterUpdate {
 	return _u
 }
 
+// SetRuleGroupID sets the "rule_group_id" field.
+func (_u *BenefitFilterUpdate) SetRuleGroupID(v int) *BenefitFilterUpdate {
+	_u.mutation.SetRuleGroupID(v)
+	return _u
+}
+
+// SetNillableRuleGroupID sets the "rule_group_id" field if the given value is not nil.
+func (_u *BenefitFilterUpdate) SetNillableRuleGroupID(v *int) *BenefitFilterUpdate {
+	if v != nil {
+		_u.SetRuleGroupID(*v)
+	}
+	return _u
+}
+
+// ClearRuleGroupID clears the value of the "rule_group_id" field.
+func (_u *BenefitFilterUpdate) ClearRuleGroupID() *BenefitFilterUpdate {
+	_u.mutation.ClearRuleGroupID()
+	return _u
+}
+
 // SetBenefit sets the "benefit" edge to the Benefit entity.
 func (_u *BenefitFilterUpdate) SetBenefit(v *Benefit) *BenefitFilterUpdate {
 	return _u.SetBenefitID(v.ID)
@@ -127,6 +148,11 @@ func (_u *BenefitFilterUpdate) SetFilter(v *Filter) *BenefitFilterUpdate {
 	return _u.SetFilterID(v.ID)
 }
 
+// SetRuleGroup sets the "rule_group" edge to the RuleGroup entity.
+func (_u *BenefitFilterUpdate) SetRuleGroup(v *RuleGroup) *BenefitFilterUpdate {
+	return _u.SetRuleGroupID(v.ID)
+}
+
 // Mutation returns the BenefitFilterMutation object of the builder.
 func (_u *BenefitFilterUpdate) Mutation() *BenefitFilterMutation {
 	return _u.mutation
@@ -144,6 +170,12 @@ func (_u *BenefitFilterUpdate) ClearFilter() *BenefitFilterUpdate {
 	return _u
 }
 
+// ClearRuleGroup clears the "rule_group" edge to the RuleGroup entity.
+func (_u *BenefitFilterUpdate) ClearRuleGroup() *BenefitFilterUpdate {
+	_u.mutation.ClearRuleGroup()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BenefitFilterUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -270,6 +302,35 @@ func (_u *BenefitFilterUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RuleGroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefitfilter.RuleGroupTable,
+			Columns: []string{benefitfilter.RuleGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefitfilter.RuleGroupTable,
+			Columns: []string{benefitfilter.RuleGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{benefitfilter.Label}
@@ -378,6 +439,26 @@ func (_u *BenefitFilterUpdateOne) ClearTo() *BenefitFilterUpdateOne {
 	return _u
 }
 
+// SetRuleGroupID sets the "rule_group_id" field.
+func (_u *BenefitFilterUpdateOne) SetRuleGroupID(v int) *BenefitFilterUpdateOne {
+	_u.mutation.SetRuleGroupID(v)
+	return _u
+}
+
+// SetNillableRuleGroupID sets the "rule_group_id" field if the given value is not nil.
+func (_u *BenefitFilterUpdateOne) SetNillableRuleGroupID(v *int) *BenefitFilterUpdateOne {
+	if v != nil {
+		_u.SetRuleGroupID(*v)
+	}
+	return _u
+}
+
+// ClearRuleGroupID clears the value of the "rule_group_id" field.
+func (_u *BenefitFilterUpdateOne) ClearRuleGroupID() *BenefitFilterUpdateOne {
+	_u.mutation.ClearRuleGroupID()
+	return _u
+}
+
 // SetBenefit sets the "benefit" edge to the Benefit entity.
 func (_u *BenefitFilterUpdateOne) SetBenefit(v *Benefit) *BenefitFilterUpdateOne {
 	return _u.SetBenefitID(v.ID)
@@ -388,6 +469,11 @@ func (_u *BenefitFilterUpdateOne) SetFilter(v *Filter) *BenefitFilterUpdateOne {
 	return _u.SetFilterID(v.ID)
 }
 
+// SetRuleGroup sets the "rule_group" edge to the RuleGroup entity.
+func (_u *BenefitFilterUpdateOne) SetRuleGroup(v *RuleGroup) *BenefitFilterUpdateOne {
+	return _u.SetRuleGroupID(v.ID)
+}
+
 // Mutation returns the BenefitFilterMutation object of the builder.
 func (_u *BenefitFilterUpdateOne) Mutation() *BenefitFilterMutation {
 	return _u.mutation
@@ -405,6 +491,12 @@ func (_u *BenefitFilterUpdateOne) ClearFilter() *BenefitFilterUpdateOne {
 	return _u
 }
 
+// ClearRuleGroup clears the "rule_group" edge to the RuleGroup entity.
+func (_u *BenefitFilterUpdateOne) ClearRuleGroup() *BenefitFilterUpdateOne {
+	_u.mutation.ClearRuleGroup()
+	return _u
+}
+
 // Where appends a list predicates to the BenefitFilterUpdate builder.
 func (_u *BenefitFilterUpdateOne) Where(ps ...predicate.BenefitFilter) *BenefitFilterUpdateOne {
 	_u.mutation.Where(ps...)
@@ -561,6 +653,35 @@ func (_u *BenefitFilterUpdateOne) sqlSave(ctx context.Context) (_node *BenefitFi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RuleGroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefitfilter.RuleGroupTable,
+			Columns: []string{benefitfilter.RuleGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefitfilter.RuleGroupTable,
+			Columns: []string{benefitfilter.RuleGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &BenefitFilter{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefittranslation"
+)
+
+// BenefitTranslation is the model entity for the BenefitTranslation schema.
+type BenefitTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BenefitID holds the value of the "benefit_id" field.
+	BenefitID int `json:"benefit_id,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale benefittranslation.Locale `json:"locale,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Bonus holds the value of the "bonus" field.
+	Bonus string `json:"bonus,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BenefitTranslationQuery when eager-loading is set.
+	Edges        BenefitTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BenefitTranslationEdges holds the relations/edges for other nodes in the graph.
+type BenefitTranslationEdges struct {
+	// Benefit holds the value of the benefit edge.
+	Benefit *Benefit `json:"benefit,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BenefitOrErr returns the Benefit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BenefitTranslationEdges) BenefitOrErr() (*Benefit, error) {
+	if e.Benefit != nil {
+		return e.Benefit, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: benefit.Label}
+	}
+	return nil, &NotLoadedError{edge: "benefit"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BenefitTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case benefittranslation.FieldID, benefittranslation.FieldBenefitID:
+			values[i] = new(sql.NullInt64)
+		case benefittranslation.FieldLocale, benefittranslation.FieldTitle, benefittranslation.FieldContent, benefittranslation.FieldBonus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BenefitTranslation fields.
+func (_m *BenefitTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case benefittranslation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case benefittranslation.FieldBenefitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field benefit_id", values[i])
+			} else if value.Valid {
+				_m.BenefitID = int(value.Int64)
+			}
+		case benefittranslation.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = benefittranslation.Locale(value.String)
+			}
+		case benefittranslation.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case benefittranslation.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case benefittranslation.FieldBonus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bonus", values[i])
+			} else if value.Valid {
+				_m.Bonus = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BenefitTranslation.
+// This includes values selected through modifiers, order, etc.
+func (_m *BenefitTranslation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBenefit queries the "benefit" edge of the BenefitTranslation entity.
+func (_m *BenefitTranslation) QueryBenefit() *BenefitQuery {
+	return NewBenefitTranslationClient(_m.config).QueryBenefit(_m)
+}
+
+// Update returns a builder for updating this BenefitTranslation.
+// Note that you need to call BenefitTranslation.Unwrap() before calling this method if this BenefitTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BenefitTranslation) Update() *BenefitTranslationUpdateOne {
+	return NewBenefitTranslationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BenefitTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BenefitTranslation) Unwrap() *BenefitTranslation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BenefitTranslation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BenefitTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("BenefitTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("benefit_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BenefitID))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Locale))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("bonus=")
+	builder.WriteString(_m.Bonus)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BenefitTranslations is a parsable slice of BenefitTranslation.
+type BenefitTranslations []*BenefitTranslation
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package benefittranslation
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the benefittranslation type in the database.
+	Label = "benefit_translation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBenefitID holds the string denoting the benefit_id field in the database.
+	FieldBenefitID = "benefit_id"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldBonus holds the string denoting the bonus field in the database.
+	FieldBonus = "bonus"
+	// EdgeBenefit holds the string denoting the benefit edge name in mutations.
+	EdgeBenefit = "benefit"
+	// Table holds the table name of the benefittranslation in the database.
+	Table = "benefit_translations"
+	// BenefitTable is the table that holds the benefit relation/edge.
+	BenefitTable = "benefit_translations"
+	// BenefitInverseTable is the table name for the Benefit entity.
+	// It exists in this package in order to avoid circular dependency with the "benefit" package.
+	BenefitInverseTable = "benefits"
+	// BenefitColumn is the table column denoting the benefit relation/edge.
+	BenefitColumn = "benefit_id"
+)
+
+// Columns holds all SQL columns for benefittranslation fields.
+var Columns = []string{
+	FieldID,
+	FieldBenefitID,
+	FieldLocale,
+	FieldTitle,
+	FieldContent,
+	FieldBonus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Locale defines the type for the "locale" enum field.
+type Locale string
+
+// Locale values.
+const (
+	LocaleKk Locale = "kk"
+	LocaleRu Locale = "ru"
+)
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+// LocaleValidator is a validator for the "locale" field enum values. It is called by the builders before save.
+func LocaleValidator(l Locale) error {
+	switch l {
+	case LocaleKk, LocaleRu:
+		return nil
+	default:
+		return fmt.Errorf("benefittranslation: invalid enum value for locale field: %q", l)
+	}
+}
+
+// OrderOption defines the ordering options for the BenefitTranslation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBenefitID orders the results by the benefit_id field.
+func ByBenefitID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBenefitID, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByBonus orders the results by the bonus field.
+func ByBonus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBonus, opts...).ToFunc()
+}
+
+// ByBenefitField orders the results by benefit field.
+func ByBenefitField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBenefitStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBenefitStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BenefitInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BenefitTable, BenefitColumn),
+	)
+}
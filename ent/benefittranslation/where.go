@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package benefittranslation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLTE(FieldID, id))
+}
+
+// BenefitID applies equality check predicate on the "benefit_id" field. It's identical to BenefitIDEQ.
+func BenefitID(v int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldBenefitID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldTitle, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldContent, v))
+}
+
+// Bonus applies equality check predicate on the "bonus" field. It's identical to BonusEQ.
+func Bonus(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldBonus, v))
+}
+
+// BenefitIDEQ applies the EQ predicate on the "benefit_id" field.
+func BenefitIDEQ(v int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldBenefitID, v))
+}
+
+// BenefitIDNEQ applies the NEQ predicate on the "benefit_id" field.
+func BenefitIDNEQ(v int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNEQ(FieldBenefitID, v))
+}
+
+// BenefitIDIn applies the In predicate on the "benefit_id" field.
+func BenefitIDIn(vs ...int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIn(FieldBenefitID, vs...))
+}
+
+// BenefitIDNotIn applies the NotIn predicate on the "benefit_id" field.
+func BenefitIDNotIn(vs ...int) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotIn(FieldBenefitID, vs...))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v Locale) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v Locale) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...Locale) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...Locale) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldContainsFold(FieldContent, v))
+}
+
+// BonusEQ applies the EQ predicate on the "bonus" field.
+func BonusEQ(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEQ(FieldBonus, v))
+}
+
+// BonusNEQ applies the NEQ predicate on the "bonus" field.
+func BonusNEQ(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNEQ(FieldBonus, v))
+}
+
+// BonusIn applies the In predicate on the "bonus" field.
+func BonusIn(vs ...string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIn(FieldBonus, vs...))
+}
+
+// BonusNotIn applies the NotIn predicate on the "bonus" field.
+func BonusNotIn(vs ...string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotIn(FieldBonus, vs...))
+}
+
+// BonusGT applies the GT predicate on the "bonus" field.
+func BonusGT(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGT(FieldBonus, v))
+}
+
+// BonusGTE applies the GTE predicate on the "bonus" field.
+func BonusGTE(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldGTE(FieldBonus, v))
+}
+
+// BonusLT applies the LT predicate on the "bonus" field.
+func BonusLT(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLT(FieldBonus, v))
+}
+
+// BonusLTE applies the LTE predicate on the "bonus" field.
+func BonusLTE(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldLTE(FieldBonus, v))
+}
+
+// BonusContains applies the Contains predicate on the "bonus" field.
+func BonusContains(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldContains(FieldBonus, v))
+}
+
+// BonusHasPrefix applies the HasPrefix predicate on the "bonus" field.
+func BonusHasPrefix(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldHasPrefix(FieldBonus, v))
+}
+
+// BonusHasSuffix applies the HasSuffix predicate on the "bonus" field.
+func BonusHasSuffix(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldHasSuffix(FieldBonus, v))
+}
+
+// BonusIsNil applies the IsNil predicate on the "bonus" field.
+func BonusIsNil() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldIsNull(FieldBonus))
+}
+
+// BonusNotNil applies the NotNil predicate on the "bonus" field.
+func BonusNotNil() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldNotNull(FieldBonus))
+}
+
+// BonusEqualFold applies the EqualFold predicate on the "bonus" field.
+func BonusEqualFold(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldEqualFold(FieldBonus, v))
+}
+
+// BonusContainsFold applies the ContainsFold predicate on the "bonus" field.
+func BonusContainsFold(v string) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.FieldContainsFold(FieldBonus, v))
+}
+
+// HasBenefit applies the HasEdge predicate on the "benefit" edge.
+func HasBenefit() predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BenefitTable, BenefitColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBenefitWith applies the HasEdge predicate on the "benefit" edge with a given conditions (other predicates).
+func HasBenefitWith(preds ...predicate.Benefit) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(func(s *sql.Selector) {
+		step := newBenefitStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BenefitTranslation) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BenefitTranslation) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BenefitTranslation) predicate.BenefitTranslation {
+	return predicate.BenefitTranslation(sql.NotPredicates(p))
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefittranslation"
+)
+
+// BenefitTranslationCreate is the builder for creating a BenefitTranslation entity.
+type BenefitTranslationCreate struct {
+	config
+	mutation *BenefitTranslationMutation
+	hooks    []Hook
+}
+
+// SetBenefitID sets the "benefit_id" field.
+func (_c *BenefitTranslationCreate) SetBenefitID(v int) *BenefitTranslationCreate {
+	_c.mutation.SetBenefitID(v)
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *BenefitTranslationCreate) SetLocale(v benefittranslation.Locale) *BenefitTranslationCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *BenefitTranslationCreate) SetTitle(v string) *BenefitTranslationCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *BenefitTranslationCreate) SetNillableTitle(v *string) *BenefitTranslationCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *BenefitTranslationCreate) SetContent(v string) *BenefitTranslationCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *BenefitTranslationCreate) SetNillableContent(v *string) *BenefitTranslationCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetBonus sets the "bonus" field.
+func (_c *BenefitTranslationCreate) SetBonus(v string) *BenefitTranslationCreate {
+	_c.mutation.SetBonus(v)
+	return _c
+}
+
+// SetNillableBonus sets the "bonus" field if the given value is not nil.
+func (_c *BenefitTranslationCreate) SetNillableBonus(v *string) *BenefitTranslationCreate {
+	if v != nil {
+		_c.SetBonus(*v)
+	}
+	return _c
+}
+
+// SetBenefit sets the "benefit" edge to the Benefit entity.
+func (_c *BenefitTranslationCreate) SetBenefit(v *Benefit) *BenefitTranslationCreate {
+	return _c.SetBenefitID(v.ID)
+}
+
+// Mutation returns the BenefitTranslationMutation object of the builder.
+func (_c *BenefitTranslationCreate) Mutation() *BenefitTranslationMutation {
+	return _c.mutation
+}
+
+// Save creates the BenefitTranslation in the database.
+func (_c *BenefitTranslationCreate) Save(ctx context.Context) (*BenefitTranslation, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BenefitTranslationCreate) SaveX(ctx context.Context) *BenefitTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BenefitTranslationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BenefitTranslationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BenefitTranslationCreate) check() error {
+	if _, ok := _c.mutation.BenefitID(); !ok {
+		return &ValidationError{Name: "benefit_id", err: errors.New(`ent: missing required field "BenefitTranslation.benefit_id"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "BenefitTranslation.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := benefittranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "BenefitTranslation.locale": %w`, err)}
+		}
+	}
+	if len(_c.mutation.BenefitIDs()) == 0 {
+		return &ValidationError{Name: "benefit", err: errors.New(`ent: missing required edge "BenefitTranslation.benefit"`)}
+	}
+	return nil
+}
+
+func (_c *BenefitTranslationCreate) sqlSave(ctx context.Context) (*BenefitTranslation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BenefitTranslationCreate) createSpec() (*BenefitTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BenefitTranslation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(benefittranslation.Table, sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(benefittranslation.FieldLocale, field.TypeEnum, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(benefittranslation.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(benefittranslation.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Bonus(); ok {
+		_spec.SetField(benefittranslation.FieldBonus, field.TypeString, value)
+		_node.Bonus = value
+	}
+	if nodes := _c.mutation.BenefitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefittranslation.BenefitTable,
+			Columns: []string{benefittranslation.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BenefitID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BenefitTranslationCreateBulk is the builder for creating many BenefitTranslation entities in bulk.
+type BenefitTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*BenefitTranslationCreate
+}
+
+// Save creates the BenefitTranslation entities in the database.
+func (_c *BenefitTranslationCreateBulk) Save(ctx context.Context) ([]*BenefitTranslation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BenefitTranslation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BenefitTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BenefitTranslationCreateBulk) SaveX(ctx context.Context) []*BenefitTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BenefitTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BenefitTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
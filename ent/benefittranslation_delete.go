@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/benefittranslation"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// BenefitTranslationDelete is the builder for deleting a BenefitTranslation entity.
+type BenefitTranslationDelete struct {
+	config
+	hooks    []Hook
+	mutation *BenefitTranslationMutation
+}
+
+// Where appends a list predicates to the BenefitTranslationDelete builder.
+func (_d *BenefitTranslationDelete) Where(ps ...predicate.BenefitTranslation) *BenefitTranslationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BenefitTranslationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BenefitTranslationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BenefitTranslationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(benefittranslation.Table, sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BenefitTranslationDeleteOne is the builder for deleting a single BenefitTranslation entity.
+type BenefitTranslationDeleteOne struct {
+	_d *BenefitTranslationDelete
+}
+
+// Where appends a list predicates to the BenefitTranslationDelete builder.
+func (_d *BenefitTranslationDeleteOne) Where(ps ...predicate.BenefitTranslation) *BenefitTranslationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BenefitTranslationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{benefittranslation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BenefitTranslationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
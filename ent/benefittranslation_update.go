@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefittranslation"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// BenefitTranslationUpdate is the builder for updating BenefitTranslation entities.
+type BenefitTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BenefitTranslationMutation
+}
+
+// Where appends a list predicates to the BenefitTranslationUpdate builder.
+func (_u *BenefitTranslationUpdate) Where(ps ...predicate.BenefitTranslation) *BenefitTranslationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBenefitID sets the "benefit_id" field.
+func (_u *BenefitTranslationUpdate) SetBenefitID(v int) *BenefitTranslationUpdate {
+	_u.mutation.SetBenefitID(v)
+	return _u
+}
+
+// SetNillableBenefitID sets the "benefit_id" field if the given value is not nil.
+func (_u *BenefitTranslationUpdate) SetNillableBenefitID(v *int) *BenefitTranslationUpdate {
+	if v != nil {
+		_u.SetBenefitID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *BenefitTranslationUpdate) SetLocale(v benefittranslation.Locale) *BenefitTranslationUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *BenefitTranslationUpdate) SetNillableLocale(v *benefittranslation.Locale) *BenefitTranslationUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *BenefitTranslationUpdate) SetTitle(v string) *BenefitTranslationUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *BenefitTranslationUpdate) SetNillableTitle(v *string) *BenefitTranslationUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *BenefitTranslationUpdate) ClearTitle() *BenefitTranslationUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *BenefitTranslationUpdate) SetContent(v string) *BenefitTranslationUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *BenefitTranslationUpdate) SetNillableContent(v *string) *BenefitTranslationUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *BenefitTranslationUpdate) ClearContent() *BenefitTranslationUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetBonus sets the "bonus" field.
+func (_u *BenefitTranslationUpdate) SetBonus(v string) *BenefitTranslationUpdate {
+	_u.mutation.SetBonus(v)
+	return _u
+}
+
+// SetNillableBonus sets the "bonus" field if the given value is not nil.
+func (_u *BenefitTranslationUpdate) SetNillableBonus(v *string) *BenefitTranslationUpdate {
+	if v != nil {
+		_u.SetBonus(*v)
+	}
+	return _u
+}
+
+// ClearBonus clears the value of the "bonus" field.
+func (_u *BenefitTranslationUpdate) ClearBonus() *BenefitTranslationUpdate {
+	_u.mutation.ClearBonus()
+	return _u
+}
+
+// SetBenefit sets the "benefit" edge to the Benefit entity.
+func (_u *BenefitTranslationUpdate) SetBenefit(v *Benefit) *BenefitTranslationUpdate {
+	return _u.SetBenefitID(v.ID)
+}
+
+// Mutation returns the BenefitTranslationMutation object of the builder.
+func (_u *BenefitTranslationUpdate) Mutation() *BenefitTranslationMutation {
+	return _u.mutation
+}
+
+// ClearBenefit clears the "benefit" edge to the Benefit entity.
+func (_u *BenefitTranslationUpdate) ClearBenefit() *BenefitTranslationUpdate {
+	_u.mutation.ClearBenefit()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BenefitTranslationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BenefitTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BenefitTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BenefitTranslationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BenefitTranslationUpdate) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := benefittranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "BenefitTranslation.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.BenefitCleared() && len(_u.mutation.BenefitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BenefitTranslation.benefit"`)
+	}
+	return nil
+}
+
+func (_u *BenefitTranslationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(benefittranslation.Table, benefittranslation.Columns, sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(benefittranslation.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(benefittranslation.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(benefittranslation.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(benefittranslation.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(benefittranslation.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bonus(); ok {
+		_spec.SetField(benefittranslation.FieldBonus, field.TypeString, value)
+	}
+	if _u.mutation.BonusCleared() {
+		_spec.ClearField(benefittranslation.FieldBonus, field.TypeString)
+	}
+	if _u.mutation.BenefitCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefittranslation.BenefitTable,
+			Columns: []string{benefittranslation.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BenefitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefittranslation.BenefitTable,
+			Columns: []string{benefittranslation.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{benefittranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BenefitTranslationUpdateOne is the builder for updating a single BenefitTranslation entity.
+type BenefitTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BenefitTranslationMutation
+}
+
+// SetBenefitID sets the "benefit_id" field.
+func (_u *BenefitTranslationUpdateOne) SetBenefitID(v int) *BenefitTranslationUpdateOne {
+	_u.mutation.SetBenefitID(v)
+	return _u
+}
+
+// SetNillableBenefitID sets the "benefit_id" field if the given value is not nil.
+func (_u *BenefitTranslationUpdateOne) SetNillableBenefitID(v *int) *BenefitTranslationUpdateOne {
+	if v != nil {
+		_u.SetBenefitID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *BenefitTranslationUpdateOne) SetLocale(v benefittranslation.Locale) *BenefitTranslationUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *BenefitTranslationUpdateOne) SetNillableLocale(v *benefittranslation.Locale) *BenefitTranslationUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *BenefitTranslationUpdateOne) SetTitle(v string) *BenefitTranslationUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *BenefitTranslationUpdateOne) SetNillableTitle(v *string) *BenefitTranslationUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *BenefitTranslationUpdateOne) ClearTitle() *BenefitTranslationUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *BenefitTranslationUpdateOne) SetContent(v string) *BenefitTranslationUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *BenefitTranslationUpdateOne) SetNillableContent(v *string) *BenefitTranslationUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *BenefitTranslationUpdateOne) ClearContent() *BenefitTranslationUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetBonus sets the "bonus" field.
+func (_u *BenefitTranslationUpdateOne) SetBonus(v string) *BenefitTranslationUpdateOne {
+	_u.mutation.SetBonus(v)
+	return _u
+}
+
+// SetNillableBonus sets the "bonus" field if the given value is not nil.
+func (_u *BenefitTranslationUpdateOne) SetNillableBonus(v *string) *BenefitTranslationUpdateOne {
+	if v != nil {
+		_u.SetBonus(*v)
+	}
+	return _u
+}
+
+// ClearBonus clears the value of the "bonus" field.
+func (_u *BenefitTranslationUpdateOne) ClearBonus() *BenefitTranslationUpdateOne {
+	_u.mutation.ClearBonus()
+	return _u
+}
+
+// SetBenefit sets the "benefit" edge to the Benefit entity.
+func (_u *BenefitTranslationUpdateOne) SetBenefit(v *Benefit) *BenefitTranslationUpdateOne {
+	return _u.SetBenefitID(v.ID)
+}
+
+// Mutation returns the BenefitTranslationMutation object of the builder.
+func (_u *BenefitTranslationUpdateOne) Mutation() *BenefitTranslationMutation {
+	return _u.mutation
+}
+
+// ClearBenefit clears the "benefit" edge to the Benefit entity.
+func (_u *BenefitTranslationUpdateOne) ClearBenefit() *BenefitTranslationUpdateOne {
+	_u.mutation.ClearBenefit()
+	return _u
+}
+
+// Where appends a list predicates to the BenefitTranslationUpdate builder.
+func (_u *BenefitTranslationUpdateOne) Where(ps ...predicate.BenefitTranslation) *BenefitTranslationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BenefitTranslationUpdateOne) Select(field string, fields ...string) *BenefitTranslationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BenefitTranslation entity.
+func (_u *BenefitTranslationUpdateOne) Save(ctx context.Context) (*BenefitTranslation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BenefitTranslationUpdateOne) SaveX(ctx context.Context) *BenefitTranslation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BenefitTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BenefitTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BenefitTranslationUpdateOne) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := benefittranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "BenefitTranslation.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.BenefitCleared() && len(_u.mutation.BenefitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BenefitTranslation.benefit"`)
+	}
+	return nil
+}
+
+func (_u *BenefitTranslationUpdateOne) sqlSave(ctx context.Context) (_node *BenefitTranslation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(benefittranslation.Table, benefittranslation.Columns, sqlgraph.NewFieldSpec(benefittranslation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BenefitTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, benefittranslation.FieldID)
+		for _, f := range fields {
+			if !benefittranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != benefittranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(benefittranslation.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(benefittranslation.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(benefittranslation.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(benefittranslation.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(benefittranslation.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bonus(); ok {
+		_spec.SetField(benefittranslation.FieldBonus, field.TypeString, value)
+	}
+	if _u.mutation.BonusCleared() {
+		_spec.ClearField(benefittranslation.FieldBonus, field.TypeString)
+	}
+	if _u.mutation.BenefitCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefittranslation.BenefitTable,
+			Columns: []string{benefittranslation.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BenefitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   benefittranslation.BenefitTable,
+			Columns: []string{benefittranslation.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BenefitTranslation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{benefittranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
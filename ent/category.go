@@ -30,9 +30,11 @@ type Category struct {
 type CategoryEdges struct {
 	// BenefitCategories holds the value of the benefit_categories edge.
 	BenefitCategories []*BenefitCategory `json:"benefit_categories,omitempty"`
+	// Translations holds the value of the translations edge.
+	Translations []*CategoryTranslation `json:"translations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // BenefitCategoriesOrErr returns the BenefitCategories value or an error if the edge
@@ -44,6 +46,15 @@ func (e CategoryEdges) BenefitCategoriesOrErr() ([]*BenefitCategory, error) {
 	return nil, &NotLoadedError{edge: "benefit_categories"}
 }
 
+// TranslationsOrErr returns the Translations value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) TranslationsOrErr() ([]*CategoryTranslation, error) {
+	if e.loadedTypes[1] {
+		return e.Translations, nil
+	}
+	return nil, &NotLoadedError{edge: "translations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Category) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -105,6 +116,11 @@ func (_m *Category) QueryBenefitCategories() *BenefitCategoryQuery {
 	return NewCategoryClient(_m.config).QueryBenefitCategories(_m)
 }
 
+// QueryTranslations queries the "translations" edge of the Category entity.
+func (_m *Category) QueryTranslations() *CategoryTranslationQuery {
+	return NewCategoryClient(_m.config).QueryTranslations(_m)
+}
+
 // Update returns a builder for updating this Category.
 // Note that you need to call Category.Unwrap() before calling this method if this Category
 // was returned from a transaction, and the transaction was committed or rolled back.
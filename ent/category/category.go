@@ -18,6 +18,8 @@ const (
 	FieldDescription = "description"
 	// EdgeBenefitCategories holds the string denoting the benefit_categories edge name in mutations.
 	EdgeBenefitCategories = "benefit_categories"
+	// EdgeTranslations holds the string denoting the translations edge name in mutations.
+	EdgeTranslations = "translations"
 	// Table holds the table name of the category in the database.
 	Table = "categories"
 	// BenefitCategoriesTable is the table that holds the benefit_categories relation/edge.
@@ -27,6 +29,13 @@ const (
 	BenefitCategoriesInverseTable = "benefit_categories"
 	// BenefitCategoriesColumn is the table column denoting the benefit_categories relation/edge.
 	BenefitCategoriesColumn = "category_id"
+	// TranslationsTable is the table that holds the translations relation/edge.
+	TranslationsTable = "category_translations"
+	// TranslationsInverseTable is the table name for the CategoryTranslation entity.
+	// It exists in this package in order to avoid circular dependency with the "categorytranslation" package.
+	TranslationsInverseTable = "category_translations"
+	// TranslationsColumn is the table column denoting the translations relation/edge.
+	TranslationsColumn = "category_id"
 )
 
 // Columns holds all SQL columns for category fields.
@@ -77,6 +86,20 @@ func ByBenefitCategories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newBenefitCategoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTranslationsCount orders the results by translations count.
+func ByTranslationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranslationsStep(), opts...)
+	}
+}
+
+// ByTranslations orders the results by translations terms.
+func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranslationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBenefitCategoriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -84,3 +107,10 @@ func newBenefitCategoriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, BenefitCategoriesTable, BenefitCategoriesColumn),
 	)
 }
+func newTranslationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranslationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
 )
 
 // CategoryCreate is the builder for creating a Category entity.
@@ -55,6 +56,21 @@ func (_c *CategoryCreate) AddBenefitCategories(v ...*BenefitCategory) *CategoryC
 	return _c.AddBenefitCategoryIDs(ids...)
 }
 
+// AddTranslationIDs adds the "translations" edge to the CategoryTranslation entity by IDs.
+func (_c *CategoryCreate) AddTranslationIDs(ids ...int) *CategoryCreate {
+	_c.mutation.AddTranslationIDs(ids...)
+	return _c
+}
+
+// AddTranslations adds the "translations" edges to the CategoryTranslation entity.
+func (_c *CategoryCreate) AddTranslations(v ...*CategoryTranslation) *CategoryCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_c *CategoryCreate) Mutation() *CategoryMutation {
 	return _c.mutation
@@ -142,6 +158,22 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.TranslationsTable,
+			Columns: []string{category.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorytranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
 	"github.com/citizenkz/core/ent/predicate"
 )
 
@@ -25,6 +26,7 @@ type CategoryQuery struct {
 	inters                []Interceptor
 	predicates            []predicate.Category
 	withBenefitCategories *BenefitCategoryQuery
+	withTranslations      *CategoryTranslationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (_q *CategoryQuery) QueryBenefitCategories() *BenefitCategoryQuery {
 	return query
 }
 
+// QueryTranslations chains the current query on the "translations" edge.
+func (_q *CategoryQuery) QueryTranslations() *CategoryTranslationQuery {
+	query := (&CategoryTranslationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(categorytranslation.Table, categorytranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.TranslationsTable, category.TranslationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Category entity from the query.
 // Returns a *NotFoundError when no Category was found.
 func (_q *CategoryQuery) First(ctx context.Context) (*Category, error) {
@@ -276,6 +300,7 @@ func (_q *CategoryQuery) Clone() *CategoryQuery {
 		inters:                append([]Interceptor{}, _q.inters...),
 		predicates:            append([]predicate.Category{}, _q.predicates...),
 		withBenefitCategories: _q.withBenefitCategories.Clone(),
+		withTranslations:      _q.withTranslations.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +318,17 @@ func (_q *CategoryQuery) WithBenefitCategories(opts ...func(*BenefitCategoryQuer
 	return _q
 }
 
+// WithTranslations tells the query-builder to eager-load the nodes that are connected to
+// the "translations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CategoryQuery) WithTranslations(opts ...func(*CategoryTranslationQuery)) *CategoryQuery {
+	query := (&CategoryTranslationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranslations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (_q *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 	var (
 		nodes       = []*Category{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withBenefitCategories != nil,
+			_q.withTranslations != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -402,6 +439,13 @@ func (_q *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 			return nil, err
 		}
 	}
+	if query := _q.withTranslations; query != nil {
+		if err := _q.loadTranslations(ctx, query, nodes,
+			func(n *Category) { n.Edges.Translations = []*CategoryTranslation{} },
+			func(n *Category, e *CategoryTranslation) { n.Edges.Translations = append(n.Edges.Translations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +479,36 @@ func (_q *CategoryQuery) loadBenefitCategories(ctx context.Context, query *Benef
 	}
 	return nil
 }
+func (_q *CategoryQuery) loadTranslations(ctx context.Context, query *CategoryTranslationQuery, nodes []*Category, init func(*Category), assign func(*Category, *CategoryTranslation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Category)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(categorytranslation.FieldCategoryID)
+	}
+	query.Where(predicate.CategoryTranslation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(category.TranslationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CategoryID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "category_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CategoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
+)
+
+// CategoryTranslation is the model entity for the CategoryTranslation schema.
+type CategoryTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID int `json:"category_id,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale categorytranslation.Locale `json:"locale,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryTranslationQuery when eager-loading is set.
+	Edges        CategoryTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CategoryTranslationEdges holds the relations/edges for other nodes in the graph.
+type CategoryTranslationEdges struct {
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryTranslationEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorytranslation.FieldID, categorytranslation.FieldCategoryID:
+			values[i] = new(sql.NullInt64)
+		case categorytranslation.FieldLocale, categorytranslation.FieldName, categorytranslation.FieldDescription:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryTranslation fields.
+func (_m *CategoryTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorytranslation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case categorytranslation.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = int(value.Int64)
+			}
+		case categorytranslation.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = categorytranslation.Locale(value.String)
+			}
+		case categorytranslation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case categorytranslation.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryTranslation.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryTranslation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCategory queries the "category" edge of the CategoryTranslation entity.
+func (_m *CategoryTranslation) QueryCategory() *CategoryQuery {
+	return NewCategoryTranslationClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this CategoryTranslation.
+// Note that you need to call CategoryTranslation.Unwrap() before calling this method if this CategoryTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryTranslation) Update() *CategoryTranslationUpdateOne {
+	return NewCategoryTranslationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryTranslation) Unwrap() *CategoryTranslation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryTranslation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Locale))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryTranslations is a parsable slice of CategoryTranslation.
+type CategoryTranslations []*CategoryTranslation
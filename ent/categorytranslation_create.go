@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
+)
+
+// CategoryTranslationCreate is the builder for creating a CategoryTranslation entity.
+type CategoryTranslationCreate struct {
+	config
+	mutation *CategoryTranslationMutation
+	hooks    []Hook
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CategoryTranslationCreate) SetCategoryID(v int) *CategoryTranslationCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *CategoryTranslationCreate) SetLocale(v categorytranslation.Locale) *CategoryTranslationCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CategoryTranslationCreate) SetName(v string) *CategoryTranslationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *CategoryTranslationCreate) SetNillableName(v *string) *CategoryTranslationCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CategoryTranslationCreate) SetDescription(v string) *CategoryTranslationCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CategoryTranslationCreate) SetNillableDescription(v *string) *CategoryTranslationCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *CategoryTranslationCreate) SetCategory(v *Category) *CategoryTranslationCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryTranslationMutation object of the builder.
+func (_c *CategoryTranslationCreate) Mutation() *CategoryTranslationMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryTranslation in the database.
+func (_c *CategoryTranslationCreate) Save(ctx context.Context) (*CategoryTranslation, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryTranslationCreate) SaveX(ctx context.Context) *CategoryTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryTranslationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryTranslationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryTranslationCreate) check() error {
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "CategoryTranslation.category_id"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "CategoryTranslation.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := categorytranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.locale": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "CategoryTranslation.category"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryTranslationCreate) sqlSave(ctx context.Context) (*CategoryTranslation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryTranslationCreate) createSpec() (*CategoryTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryTranslation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorytranslation.Table, sqlgraph.NewFieldSpec(categorytranslation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(categorytranslation.FieldLocale, field.TypeEnum, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(categorytranslation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(categorytranslation.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorytranslation.CategoryTable,
+			Columns: []string{categorytranslation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CategoryTranslationCreateBulk is the builder for creating many CategoryTranslation entities in bulk.
+type CategoryTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryTranslationCreate
+}
+
+// Save creates the CategoryTranslation entities in the database.
+func (_c *CategoryTranslationCreateBulk) Save(ctx context.Context) ([]*CategoryTranslation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryTranslation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryTranslationCreateBulk) SaveX(ctx context.Context) []*CategoryTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// CategoryTranslationUpdate is the builder for updating CategoryTranslation entities.
+type CategoryTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryTranslationMutation
+}
+
+// Where appends a list predicates to the CategoryTranslationUpdate builder.
+func (_u *CategoryTranslationUpdate) Where(ps ...predicate.CategoryTranslation) *CategoryTranslationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryTranslationUpdate) SetCategoryID(v int) *CategoryTranslationUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableCategoryID(v *int) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *CategoryTranslationUpdate) SetLocale(v categorytranslation.Locale) *CategoryTranslationUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableLocale(v *categorytranslation.Locale) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CategoryTranslationUpdate) SetName(v string) *CategoryTranslationUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableName(v *string) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CategoryTranslationUpdate) ClearName() *CategoryTranslationUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CategoryTranslationUpdate) SetDescription(v string) *CategoryTranslationUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableDescription(v *string) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CategoryTranslationUpdate) ClearDescription() *CategoryTranslationUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CategoryTranslationUpdate) SetCategory(v *Category) *CategoryTranslationUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryTranslationMutation object of the builder.
+func (_u *CategoryTranslationUpdate) Mutation() *CategoryTranslationMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CategoryTranslationUpdate) ClearCategory() *CategoryTranslationUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryTranslationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryTranslationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryTranslationUpdate) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := categorytranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryTranslation.category"`)
+	}
+	return nil
+}
+
+func (_u *CategoryTranslationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorytranslation.Table, categorytranslation.Columns, sqlgraph.NewFieldSpec(categorytranslation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(categorytranslation.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(categorytranslation.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(categorytranslation.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(categorytranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categorytranslation.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorytranslation.CategoryTable,
+			Columns: []string{categorytranslation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorytranslation.CategoryTable,
+			Columns: []string{categorytranslation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorytranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryTranslationUpdateOne is the builder for updating a single CategoryTranslation entity.
+type CategoryTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryTranslationMutation
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryTranslationUpdateOne) SetCategoryID(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableCategoryID(v *int) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *CategoryTranslationUpdateOne) SetLocale(v categorytranslation.Locale) *CategoryTranslationUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableLocale(v *categorytranslation.Locale) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CategoryTranslationUpdateOne) SetName(v string) *CategoryTranslationUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableName(v *string) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CategoryTranslationUpdateOne) ClearName() *CategoryTranslationUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CategoryTranslationUpdateOne) SetDescription(v string) *CategoryTranslationUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableDescription(v *string) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CategoryTranslationUpdateOne) ClearDescription() *CategoryTranslationUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CategoryTranslationUpdateOne) SetCategory(v *Category) *CategoryTranslationUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryTranslationMutation object of the builder.
+func (_u *CategoryTranslationUpdateOne) Mutation() *CategoryTranslationMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CategoryTranslationUpdateOne) ClearCategory() *CategoryTranslationUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the CategoryTranslationUpdate builder.
+func (_u *CategoryTranslationUpdateOne) Where(ps ...predicate.CategoryTranslation) *CategoryTranslationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryTranslationUpdateOne) Select(field string, fields ...string) *CategoryTranslationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryTranslation entity.
+func (_u *CategoryTranslationUpdateOne) Save(ctx context.Context) (*CategoryTranslation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryTranslationUpdateOne) SaveX(ctx context.Context) *CategoryTranslation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryTranslationUpdateOne) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := categorytranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryTranslation.category"`)
+	}
+	return nil
+}
+
+func (_u *CategoryTranslationUpdateOne) sqlSave(ctx context.Context) (_node *CategoryTranslation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorytranslation.Table, categorytranslation.Columns, sqlgraph.NewFieldSpec(categorytranslation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorytranslation.FieldID)
+		for _, f := range fields {
+			if !categorytranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorytranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(categorytranslation.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(categorytranslation.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(categorytranslation.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(categorytranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categorytranslation.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorytranslation.CategoryTable,
+			Columns: []string{categorytranslation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorytranslation.CategoryTable,
+			Columns: []string{categorytranslation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CategoryTranslation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorytranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
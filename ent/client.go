@@ -20,10 +20,18 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
 	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
 	"github.com/citizenkz/core/ent/child"
 	"github.com/citizenkz/core/ent/childfilter"
+	"github.com/citizenkz/core/ent/emailverification"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+	"github.com/citizenkz/core/ent/outboxemail"
+	"github.com/citizenkz/core/ent/recoverycode"
+	"github.com/citizenkz/core/ent/refreshtoken"
+	"github.com/citizenkz/core/ent/rulegroup"
 	"github.com/citizenkz/core/ent/user"
 	"github.com/citizenkz/core/ent/userfilter"
 )
@@ -41,14 +49,30 @@ type Client struct {
 	BenefitCategory *BenefitCategoryClient
 	// BenefitFilter is the client for interacting with the BenefitFilter builders.
 	BenefitFilter *BenefitFilterClient
+	// BenefitTranslation is the client for interacting with the BenefitTranslation builders.
+	BenefitTranslation *BenefitTranslationClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategoryTranslation is the client for interacting with the CategoryTranslation builders.
+	CategoryTranslation *CategoryTranslationClient
 	// Child is the client for interacting with the Child builders.
 	Child *ChildClient
 	// ChildFilter is the client for interacting with the ChildFilter builders.
 	ChildFilter *ChildFilterClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
 	// Filter is the client for interacting with the Filter builders.
 	Filter *FilterClient
+	// FilterTranslation is the client for interacting with the FilterTranslation builders.
+	FilterTranslation *FilterTranslationClient
+	// OutboxEmail is the client for interacting with the OutboxEmail builders.
+	OutboxEmail *OutboxEmailClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// RuleGroup is the client for interacting with the RuleGroup builders.
+	RuleGroup *RuleGroupClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserFilter is the client for interacting with the UserFilter builders.
@@ -68,10 +92,18 @@ func (c *Client) init() {
 	c.Benefit = NewBenefitClient(c.config)
 	c.BenefitCategory = NewBenefitCategoryClient(c.config)
 	c.BenefitFilter = NewBenefitFilterClient(c.config)
+	c.BenefitTranslation = NewBenefitTranslationClient(c.config)
 	c.Category = NewCategoryClient(c.config)
+	c.CategoryTranslation = NewCategoryTranslationClient(c.config)
 	c.Child = NewChildClient(c.config)
 	c.ChildFilter = NewChildFilterClient(c.config)
+	c.EmailVerification = NewEmailVerificationClient(c.config)
 	c.Filter = NewFilterClient(c.config)
+	c.FilterTranslation = NewFilterTranslationClient(c.config)
+	c.OutboxEmail = NewOutboxEmailClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.RuleGroup = NewRuleGroupClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserFilter = NewUserFilterClient(c.config)
 }
@@ -164,18 +196,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		Attempt:         NewAttemptClient(cfg),
-		Benefit:         NewBenefitClient(cfg),
-		BenefitCategory: NewBenefitCategoryClient(cfg),
-		BenefitFilter:   NewBenefitFilterClient(cfg),
-		Category:        NewCategoryClient(cfg),
-		Child:           NewChildClient(cfg),
-		ChildFilter:     NewChildFilterClient(cfg),
-		Filter:          NewFilterClient(cfg),
-		User:            NewUserClient(cfg),
-		UserFilter:      NewUserFilterClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Attempt:             NewAttemptClient(cfg),
+		Benefit:             NewBenefitClient(cfg),
+		BenefitCategory:     NewBenefitCategoryClient(cfg),
+		BenefitFilter:       NewBenefitFilterClient(cfg),
+		BenefitTranslation:  NewBenefitTranslationClient(cfg),
+		Category:            NewCategoryClient(cfg),
+		CategoryTranslation: NewCategoryTranslationClient(cfg),
+		Child:               NewChildClient(cfg),
+		ChildFilter:         NewChildFilterClient(cfg),
+		EmailVerification:   NewEmailVerificationClient(cfg),
+		Filter:              NewFilterClient(cfg),
+		FilterTranslation:   NewFilterTranslationClient(cfg),
+		OutboxEmail:         NewOutboxEmailClient(cfg),
+		RecoveryCode:        NewRecoveryCodeClient(cfg),
+		RefreshToken:        NewRefreshTokenClient(cfg),
+		RuleGroup:           NewRuleGroupClient(cfg),
+		User:                NewUserClient(cfg),
+		UserFilter:          NewUserFilterClient(cfg),
 	}, nil
 }
 
@@ -193,18 +233,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		Attempt:         NewAttemptClient(cfg),
-		Benefit:         NewBenefitClient(cfg),
-		BenefitCategory: NewBenefitCategoryClient(cfg),
-		BenefitFilter:   NewBenefitFilterClient(cfg),
-		Category:        NewCategoryClient(cfg),
-		Child:           NewChildClient(cfg),
-		ChildFilter:     NewChildFilterClient(cfg),
-		Filter:          NewFilterClient(cfg),
-		User:            NewUserClient(cfg),
-		UserFilter:      NewUserFilterClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Attempt:             NewAttemptClient(cfg),
+		Benefit:             NewBenefitClient(cfg),
+		BenefitCategory:     NewBenefitCategoryClient(cfg),
+		BenefitFilter:       NewBenefitFilterClient(cfg),
+		BenefitTranslation:  NewBenefitTranslationClient(cfg),
+		Category:            NewCategoryClient(cfg),
+		CategoryTranslation: NewCategoryTranslationClient(cfg),
+		Child:               NewChildClient(cfg),
+		ChildFilter:         NewChildFilterClient(cfg),
+		EmailVerification:   NewEmailVerificationClient(cfg),
+		Filter:              NewFilterClient(cfg),
+		FilterTranslation:   NewFilterTranslationClient(cfg),
+		OutboxEmail:         NewOutboxEmailClient(cfg),
+		RecoveryCode:        NewRecoveryCodeClient(cfg),
+		RefreshToken:        NewRefreshTokenClient(cfg),
+		RuleGroup:           NewRuleGroupClient(cfg),
+		User:                NewUserClient(cfg),
+		UserFilter:          NewUserFilterClient(cfg),
 	}, nil
 }
 
@@ -234,8 +282,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Attempt, c.Benefit, c.BenefitCategory, c.BenefitFilter, c.Category, c.Child,
-		c.ChildFilter, c.Filter, c.User, c.UserFilter,
+		c.Attempt, c.Benefit, c.BenefitCategory, c.BenefitFilter, c.BenefitTranslation,
+		c.Category, c.CategoryTranslation, c.Child, c.ChildFilter, c.EmailVerification,
+		c.Filter, c.FilterTranslation, c.OutboxEmail, c.RecoveryCode, c.RefreshToken,
+		c.RuleGroup, c.User, c.UserFilter,
 	} {
 		n.Use(hooks...)
 	}
@@ -245,8 +295,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Attempt, c.Benefit, c.BenefitCategory, c.BenefitFilter, c.Category, c.Child,
-		c.ChildFilter, c.Filter, c.User, c.UserFilter,
+		c.Attempt, c.Benefit, c.BenefitCategory, c.BenefitFilter, c.BenefitTranslation,
+		c.Category, c.CategoryTranslation, c.Child, c.ChildFilter, c.EmailVerification,
+		c.Filter, c.FilterTranslation, c.OutboxEmail, c.RecoveryCode, c.RefreshToken,
+		c.RuleGroup, c.User, c.UserFilter,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -263,14 +315,30 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BenefitCategory.mutate(ctx, m)
 	case *BenefitFilterMutation:
 		return c.BenefitFilter.mutate(ctx, m)
+	case *BenefitTranslationMutation:
+		return c.BenefitTranslation.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *CategoryTranslationMutation:
+		return c.CategoryTranslation.mutate(ctx, m)
 	case *ChildMutation:
 		return c.Child.mutate(ctx, m)
 	case *ChildFilterMutation:
 		return c.ChildFilter.mutate(ctx, m)
+	case *EmailVerificationMutation:
+		return c.EmailVerification.mutate(ctx, m)
 	case *FilterMutation:
 		return c.Filter.mutate(ctx, m)
+	case *FilterTranslationMutation:
+		return c.FilterTranslation.mutate(ctx, m)
+	case *OutboxEmailMutation:
+		return c.OutboxEmail.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *RuleGroupMutation:
+		return c.RuleGroup.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserFilterMutation:
@@ -553,6 +621,38 @@ func (c *BenefitClient) QueryBenefitCategories(_m *Benefit) *BenefitCategoryQuer
 	return query
 }
 
+// QueryRuleGroups queries the rule_groups edge of a Benefit.
+func (c *BenefitClient) QueryRuleGroups(_m *Benefit) *RuleGroupQuery {
+	query := (&RuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefit.Table, benefit.FieldID, id),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, benefit.RuleGroupsTable, benefit.RuleGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranslations queries the translations edge of a Benefit.
+func (c *BenefitClient) QueryTranslations(_m *Benefit) *BenefitTranslationQuery {
+	query := (&BenefitTranslationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefit.Table, benefit.FieldID, id),
+			sqlgraph.To(benefittranslation.Table, benefittranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, benefit.TranslationsTable, benefit.TranslationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BenefitClient) Hooks() []Hook {
 	return c.hooks.Benefit
@@ -883,6 +983,22 @@ func (c *BenefitFilterClient) QueryFilter(_m *BenefitFilter) *FilterQuery {
 	return query
 }
 
+// QueryRuleGroup queries the rule_group edge of a BenefitFilter.
+func (c *BenefitFilterClient) QueryRuleGroup(_m *BenefitFilter) *RuleGroupQuery {
+	query := (&RuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefitfilter.Table, benefitfilter.FieldID, id),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, benefitfilter.RuleGroupTable, benefitfilter.RuleGroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BenefitFilterClient) Hooks() []Hook {
 	return c.hooks.BenefitFilter
@@ -908,6 +1024,155 @@ func (c *BenefitFilterClient) mutate(ctx context.Context, m *BenefitFilterMutati
 	}
 }
 
+// BenefitTranslationClient is a client for the BenefitTranslation schema.
+type BenefitTranslationClient struct {
+	config
+}
+
+// NewBenefitTranslationClient returns a client for the BenefitTranslation from the given config.
+func NewBenefitTranslationClient(c config) *BenefitTranslationClient {
+	return &BenefitTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `benefittranslation.Hooks(f(g(h())))`.
+func (c *BenefitTranslationClient) Use(hooks ...Hook) {
+	c.hooks.BenefitTranslation = append(c.hooks.BenefitTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `benefittranslation.Intercept(f(g(h())))`.
+func (c *BenefitTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BenefitTranslation = append(c.inters.BenefitTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a BenefitTranslation entity.
+func (c *BenefitTranslationClient) Create() *BenefitTranslationCreate {
+	mutation := newBenefitTranslationMutation(c.config, OpCreate)
+	return &BenefitTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BenefitTranslation entities.
+func (c *BenefitTranslationClient) CreateBulk(builders ...*BenefitTranslationCreate) *BenefitTranslationCreateBulk {
+	return &BenefitTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BenefitTranslationClient) MapCreateBulk(slice any, setFunc func(*BenefitTranslationCreate, int)) *BenefitTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BenefitTranslationCreateBulk{err: fmt.Errorf("calling to BenefitTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BenefitTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BenefitTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BenefitTranslation.
+func (c *BenefitTranslationClient) Update() *BenefitTranslationUpdate {
+	mutation := newBenefitTranslationMutation(c.config, OpUpdate)
+	return &BenefitTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BenefitTranslationClient) UpdateOne(_m *BenefitTranslation) *BenefitTranslationUpdateOne {
+	mutation := newBenefitTranslationMutation(c.config, OpUpdateOne, withBenefitTranslation(_m))
+	return &BenefitTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BenefitTranslationClient) UpdateOneID(id int) *BenefitTranslationUpdateOne {
+	mutation := newBenefitTranslationMutation(c.config, OpUpdateOne, withBenefitTranslationID(id))
+	return &BenefitTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BenefitTranslation.
+func (c *BenefitTranslationClient) Delete() *BenefitTranslationDelete {
+	mutation := newBenefitTranslationMutation(c.config, OpDelete)
+	return &BenefitTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BenefitTranslationClient) DeleteOne(_m *BenefitTranslation) *BenefitTranslationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BenefitTranslationClient) DeleteOneID(id int) *BenefitTranslationDeleteOne {
+	builder := c.Delete().Where(benefittranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BenefitTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for BenefitTranslation.
+func (c *BenefitTranslationClient) Query() *BenefitTranslationQuery {
+	return &BenefitTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBenefitTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BenefitTranslation entity by its id.
+func (c *BenefitTranslationClient) Get(ctx context.Context, id int) (*BenefitTranslation, error) {
+	return c.Query().Where(benefittranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BenefitTranslationClient) GetX(ctx context.Context, id int) *BenefitTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBenefit queries the benefit edge of a BenefitTranslation.
+func (c *BenefitTranslationClient) QueryBenefit(_m *BenefitTranslation) *BenefitQuery {
+	query := (&BenefitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(benefittranslation.Table, benefittranslation.FieldID, id),
+			sqlgraph.To(benefit.Table, benefit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, benefittranslation.BenefitTable, benefittranslation.BenefitColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BenefitTranslationClient) Hooks() []Hook {
+	return c.hooks.BenefitTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *BenefitTranslationClient) Interceptors() []Interceptor {
+	return c.inters.BenefitTranslation
+}
+
+func (c *BenefitTranslationClient) mutate(ctx context.Context, m *BenefitTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BenefitTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BenefitTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BenefitTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BenefitTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BenefitTranslation mutation op: %q", m.Op())
+	}
+}
+
 // CategoryClient is a client for the Category schema.
 type CategoryClient struct {
 	config
@@ -1032,6 +1297,22 @@ func (c *CategoryClient) QueryBenefitCategories(_m *Category) *BenefitCategoryQu
 	return query
 }
 
+// QueryTranslations queries the translations edge of a Category.
+func (c *CategoryClient) QueryTranslations(_m *Category) *CategoryTranslationQuery {
+	query := (&CategoryTranslationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(categorytranslation.Table, categorytranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.TranslationsTable, category.TranslationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CategoryClient) Hooks() []Hook {
 	return c.hooks.Category
@@ -1057,6 +1338,155 @@ func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value
 	}
 }
 
+// CategoryTranslationClient is a client for the CategoryTranslation schema.
+type CategoryTranslationClient struct {
+	config
+}
+
+// NewCategoryTranslationClient returns a client for the CategoryTranslation from the given config.
+func NewCategoryTranslationClient(c config) *CategoryTranslationClient {
+	return &CategoryTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categorytranslation.Hooks(f(g(h())))`.
+func (c *CategoryTranslationClient) Use(hooks ...Hook) {
+	c.hooks.CategoryTranslation = append(c.hooks.CategoryTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categorytranslation.Intercept(f(g(h())))`.
+func (c *CategoryTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryTranslation = append(c.inters.CategoryTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryTranslation entity.
+func (c *CategoryTranslationClient) Create() *CategoryTranslationCreate {
+	mutation := newCategoryTranslationMutation(c.config, OpCreate)
+	return &CategoryTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryTranslation entities.
+func (c *CategoryTranslationClient) CreateBulk(builders ...*CategoryTranslationCreate) *CategoryTranslationCreateBulk {
+	return &CategoryTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryTranslationClient) MapCreateBulk(slice any, setFunc func(*CategoryTranslationCreate, int)) *CategoryTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryTranslationCreateBulk{err: fmt.Errorf("calling to CategoryTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryTranslation.
+func (c *CategoryTranslationClient) Update() *CategoryTranslationUpdate {
+	mutation := newCategoryTranslationMutation(c.config, OpUpdate)
+	return &CategoryTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryTranslationClient) UpdateOne(_m *CategoryTranslation) *CategoryTranslationUpdateOne {
+	mutation := newCategoryTranslationMutation(c.config, OpUpdateOne, withCategoryTranslation(_m))
+	return &CategoryTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryTranslationClient) UpdateOneID(id int) *CategoryTranslationUpdateOne {
+	mutation := newCategoryTranslationMutation(c.config, OpUpdateOne, withCategoryTranslationID(id))
+	return &CategoryTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryTranslation.
+func (c *CategoryTranslationClient) Delete() *CategoryTranslationDelete {
+	mutation := newCategoryTranslationMutation(c.config, OpDelete)
+	return &CategoryTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryTranslationClient) DeleteOne(_m *CategoryTranslation) *CategoryTranslationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryTranslationClient) DeleteOneID(id int) *CategoryTranslationDeleteOne {
+	builder := c.Delete().Where(categorytranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryTranslation.
+func (c *CategoryTranslationClient) Query() *CategoryTranslationQuery {
+	return &CategoryTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryTranslation entity by its id.
+func (c *CategoryTranslationClient) Get(ctx context.Context, id int) (*CategoryTranslation, error) {
+	return c.Query().Where(categorytranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryTranslationClient) GetX(ctx context.Context, id int) *CategoryTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCategory queries the category edge of a CategoryTranslation.
+func (c *CategoryTranslationClient) QueryCategory(_m *CategoryTranslation) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(categorytranslation.Table, categorytranslation.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, categorytranslation.CategoryTable, categorytranslation.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryTranslationClient) Hooks() []Hook {
+	return c.hooks.CategoryTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryTranslationClient) Interceptors() []Interceptor {
+	return c.inters.CategoryTranslation
+}
+
+func (c *CategoryTranslationClient) mutate(ctx context.Context, m *CategoryTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryTranslation mutation op: %q", m.Op())
+	}
+}
+
 // ChildClient is a client for the Child schema.
 type ChildClient struct {
 	config
@@ -1387,107 +1817,884 @@ func (c *ChildFilterClient) mutate(ctx context.Context, m *ChildFilterMutation)
 	}
 }
 
-// FilterClient is a client for the Filter schema.
-type FilterClient struct {
+// EmailVerificationClient is a client for the EmailVerification schema.
+type EmailVerificationClient struct {
 	config
 }
 
-// NewFilterClient returns a client for the Filter from the given config.
-func NewFilterClient(c config) *FilterClient {
-	return &FilterClient{config: c}
+// NewEmailVerificationClient returns a client for the EmailVerification from the given config.
+func NewEmailVerificationClient(c config) *EmailVerificationClient {
+	return &EmailVerificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `filter.Hooks(f(g(h())))`.
-func (c *FilterClient) Use(hooks ...Hook) {
-	c.hooks.Filter = append(c.hooks.Filter, hooks...)
+// A call to `Use(f, g, h)` equals to `emailverification.Hooks(f(g(h())))`.
+func (c *EmailVerificationClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerification = append(c.hooks.EmailVerification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `filter.Intercept(f(g(h())))`.
-func (c *FilterClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Filter = append(c.inters.Filter, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailverification.Intercept(f(g(h())))`.
+func (c *EmailVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailVerification = append(c.inters.EmailVerification, interceptors...)
 }
 
-// Create returns a builder for creating a Filter entity.
-func (c *FilterClient) Create() *FilterCreate {
-	mutation := newFilterMutation(c.config, OpCreate)
-	return &FilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailVerification entity.
+func (c *EmailVerificationClient) Create() *EmailVerificationCreate {
+	mutation := newEmailVerificationMutation(c.config, OpCreate)
+	return &EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Filter entities.
-func (c *FilterClient) CreateBulk(builders ...*FilterCreate) *FilterCreateBulk {
-	return &FilterCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailVerification entities.
+func (c *EmailVerificationClient) CreateBulk(builders ...*EmailVerificationCreate) *EmailVerificationCreateBulk {
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FilterClient) MapCreateBulk(slice any, setFunc func(*FilterCreate, int)) *FilterCreateBulk {
+func (c *EmailVerificationClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationCreate, int)) *EmailVerificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FilterCreateBulk{err: fmt.Errorf("calling to FilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailVerificationCreateBulk{err: fmt.Errorf("calling to EmailVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FilterCreate, rv.Len())
+	builders := make([]*EmailVerificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FilterCreateBulk{config: c.config, builders: builders}
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Filter.
-func (c *FilterClient) Update() *FilterUpdate {
-	mutation := newFilterMutation(c.config, OpUpdate)
-	return &FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailVerification.
+func (c *EmailVerificationClient) Update() *EmailVerificationUpdate {
+	mutation := newEmailVerificationMutation(c.config, OpUpdate)
+	return &EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FilterClient) UpdateOne(_m *Filter) *FilterUpdateOne {
-	mutation := newFilterMutation(c.config, OpUpdateOne, withFilter(_m))
-	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailVerificationClient) UpdateOne(_m *EmailVerification) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerification(_m))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FilterClient) UpdateOneID(id int) *FilterUpdateOne {
-	mutation := newFilterMutation(c.config, OpUpdateOne, withFilterID(id))
-	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailVerificationClient) UpdateOneID(id int) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerificationID(id))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Filter.
-func (c *FilterClient) Delete() *FilterDelete {
-	mutation := newFilterMutation(c.config, OpDelete)
-	return &FilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailVerification.
+func (c *EmailVerificationClient) Delete() *EmailVerificationDelete {
+	mutation := newEmailVerificationMutation(c.config, OpDelete)
+	return &EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FilterClient) DeleteOne(_m *Filter) *FilterDeleteOne {
+func (c *EmailVerificationClient) DeleteOne(_m *EmailVerification) *EmailVerificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FilterClient) DeleteOneID(id int) *FilterDeleteOne {
+func (c *EmailVerificationClient) DeleteOneID(id int) *EmailVerificationDeleteOne {
+	builder := c.Delete().Where(emailverification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailVerificationDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailVerification.
+func (c *EmailVerificationClient) Query() *EmailVerificationQuery {
+	return &EmailVerificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailVerification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailVerification entity by its id.
+func (c *EmailVerificationClient) Get(ctx context.Context, id int) (*EmailVerification, error) {
+	return c.Query().Where(emailverification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailVerificationClient) GetX(ctx context.Context, id int) *EmailVerification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailVerification.
+func (c *EmailVerificationClient) QueryUser(_m *EmailVerification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailverification.Table, emailverification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailverification.UserTable, emailverification.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailVerificationClient) Hooks() []Hook {
+	return c.hooks.EmailVerification
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailVerificationClient) Interceptors() []Interceptor {
+	return c.inters.EmailVerification
+}
+
+func (c *EmailVerificationClient) mutate(ctx context.Context, m *EmailVerificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailVerification mutation op: %q", m.Op())
+	}
+}
+
+// FilterClient is a client for the Filter schema.
+type FilterClient struct {
+	config
+}
+
+// NewFilterClient returns a client for the Filter from the given config.
+func NewFilterClient(c config) *FilterClient {
+	return &FilterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filter.Hooks(f(g(h())))`.
+func (c *FilterClient) Use(hooks ...Hook) {
+	c.hooks.Filter = append(c.hooks.Filter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filter.Intercept(f(g(h())))`.
+func (c *FilterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Filter = append(c.inters.Filter, interceptors...)
+}
+
+// Create returns a builder for creating a Filter entity.
+func (c *FilterClient) Create() *FilterCreate {
+	mutation := newFilterMutation(c.config, OpCreate)
+	return &FilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Filter entities.
+func (c *FilterClient) CreateBulk(builders ...*FilterCreate) *FilterCreateBulk {
+	return &FilterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FilterClient) MapCreateBulk(slice any, setFunc func(*FilterCreate, int)) *FilterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FilterCreateBulk{err: fmt.Errorf("calling to FilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FilterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FilterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Filter.
+func (c *FilterClient) Update() *FilterUpdate {
+	mutation := newFilterMutation(c.config, OpUpdate)
+	return &FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FilterClient) UpdateOne(_m *Filter) *FilterUpdateOne {
+	mutation := newFilterMutation(c.config, OpUpdateOne, withFilter(_m))
+	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FilterClient) UpdateOneID(id int) *FilterUpdateOne {
+	mutation := newFilterMutation(c.config, OpUpdateOne, withFilterID(id))
+	return &FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Filter.
+func (c *FilterClient) Delete() *FilterDelete {
+	mutation := newFilterMutation(c.config, OpDelete)
+	return &FilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FilterClient) DeleteOne(_m *Filter) *FilterDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FilterClient) DeleteOneID(id int) *FilterDeleteOne {
 	builder := c.Delete().Where(filter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FilterDeleteOne{builder}
+	return &FilterDeleteOne{builder}
+}
+
+// Query returns a query builder for Filter.
+func (c *FilterClient) Query() *FilterQuery {
+	return &FilterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFilter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Filter entity by its id.
+func (c *FilterClient) Get(ctx context.Context, id int) (*Filter, error) {
+	return c.Query().Where(filter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FilterClient) GetX(ctx context.Context, id int) *Filter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUserFilters queries the user_filters edge of a Filter.
+func (c *FilterClient) QueryUserFilters(_m *Filter) *UserFilterQuery {
+	query := (&UserFilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filter.Table, filter.FieldID, id),
+			sqlgraph.To(userfilter.Table, userfilter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, filter.UserFiltersTable, filter.UserFiltersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBenefitFilters queries the benefit_filters edge of a Filter.
+func (c *FilterClient) QueryBenefitFilters(_m *Filter) *BenefitFilterQuery {
+	query := (&BenefitFilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filter.Table, filter.FieldID, id),
+			sqlgraph.To(benefitfilter.Table, benefitfilter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, filter.BenefitFiltersTable, filter.BenefitFiltersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildFilters queries the child_filters edge of a Filter.
+func (c *FilterClient) QueryChildFilters(_m *Filter) *ChildFilterQuery {
+	query := (&ChildFilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filter.Table, filter.FieldID, id),
+			sqlgraph.To(childfilter.Table, childfilter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, filter.ChildFiltersTable, filter.ChildFiltersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranslations queries the translations edge of a Filter.
+func (c *FilterClient) QueryTranslations(_m *Filter) *FilterTranslationQuery {
+	query := (&FilterTranslationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filter.Table, filter.FieldID, id),
+			sqlgraph.To(filtertranslation.Table, filtertranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, filter.TranslationsTable, filter.TranslationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FilterClient) Hooks() []Hook {
+	return c.hooks.Filter
+}
+
+// Interceptors returns the client interceptors.
+func (c *FilterClient) Interceptors() []Interceptor {
+	return c.inters.Filter
+}
+
+func (c *FilterClient) mutate(ctx context.Context, m *FilterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Filter mutation op: %q", m.Op())
+	}
+}
+
+// FilterTranslationClient is a client for the FilterTranslation schema.
+type FilterTranslationClient struct {
+	config
+}
+
+// NewFilterTranslationClient returns a client for the FilterTranslation from the given config.
+func NewFilterTranslationClient(c config) *FilterTranslationClient {
+	return &FilterTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filtertranslation.Hooks(f(g(h())))`.
+func (c *FilterTranslationClient) Use(hooks ...Hook) {
+	c.hooks.FilterTranslation = append(c.hooks.FilterTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filtertranslation.Intercept(f(g(h())))`.
+func (c *FilterTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FilterTranslation = append(c.inters.FilterTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a FilterTranslation entity.
+func (c *FilterTranslationClient) Create() *FilterTranslationCreate {
+	mutation := newFilterTranslationMutation(c.config, OpCreate)
+	return &FilterTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FilterTranslation entities.
+func (c *FilterTranslationClient) CreateBulk(builders ...*FilterTranslationCreate) *FilterTranslationCreateBulk {
+	return &FilterTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FilterTranslationClient) MapCreateBulk(slice any, setFunc func(*FilterTranslationCreate, int)) *FilterTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FilterTranslationCreateBulk{err: fmt.Errorf("calling to FilterTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FilterTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FilterTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FilterTranslation.
+func (c *FilterTranslationClient) Update() *FilterTranslationUpdate {
+	mutation := newFilterTranslationMutation(c.config, OpUpdate)
+	return &FilterTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FilterTranslationClient) UpdateOne(_m *FilterTranslation) *FilterTranslationUpdateOne {
+	mutation := newFilterTranslationMutation(c.config, OpUpdateOne, withFilterTranslation(_m))
+	return &FilterTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FilterTranslationClient) UpdateOneID(id int) *FilterTranslationUpdateOne {
+	mutation := newFilterTranslationMutation(c.config, OpUpdateOne, withFilterTranslationID(id))
+	return &FilterTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FilterTranslation.
+func (c *FilterTranslationClient) Delete() *FilterTranslationDelete {
+	mutation := newFilterTranslationMutation(c.config, OpDelete)
+	return &FilterTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FilterTranslationClient) DeleteOne(_m *FilterTranslation) *FilterTranslationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FilterTranslationClient) DeleteOneID(id int) *FilterTranslationDeleteOne {
+	builder := c.Delete().Where(filtertranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FilterTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for FilterTranslation.
+func (c *FilterTranslationClient) Query() *FilterTranslationQuery {
+	return &FilterTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFilterTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FilterTranslation entity by its id.
+func (c *FilterTranslationClient) Get(ctx context.Context, id int) (*FilterTranslation, error) {
+	return c.Query().Where(filtertranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FilterTranslationClient) GetX(ctx context.Context, id int) *FilterTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFilter queries the filter edge of a FilterTranslation.
+func (c *FilterTranslationClient) QueryFilter(_m *FilterTranslation) *FilterQuery {
+	query := (&FilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filtertranslation.Table, filtertranslation.FieldID, id),
+			sqlgraph.To(filter.Table, filter.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, filtertranslation.FilterTable, filtertranslation.FilterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FilterTranslationClient) Hooks() []Hook {
+	return c.hooks.FilterTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *FilterTranslationClient) Interceptors() []Interceptor {
+	return c.inters.FilterTranslation
+}
+
+func (c *FilterTranslationClient) mutate(ctx context.Context, m *FilterTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FilterTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FilterTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FilterTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FilterTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FilterTranslation mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEmailClient is a client for the OutboxEmail schema.
+type OutboxEmailClient struct {
+	config
+}
+
+// NewOutboxEmailClient returns a client for the OutboxEmail from the given config.
+func NewOutboxEmailClient(c config) *OutboxEmailClient {
+	return &OutboxEmailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxemail.Hooks(f(g(h())))`.
+func (c *OutboxEmailClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEmail = append(c.hooks.OutboxEmail, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxemail.Intercept(f(g(h())))`.
+func (c *OutboxEmailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEmail = append(c.inters.OutboxEmail, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEmail entity.
+func (c *OutboxEmailClient) Create() *OutboxEmailCreate {
+	mutation := newOutboxEmailMutation(c.config, OpCreate)
+	return &OutboxEmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEmail entities.
+func (c *OutboxEmailClient) CreateBulk(builders ...*OutboxEmailCreate) *OutboxEmailCreateBulk {
+	return &OutboxEmailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEmailClient) MapCreateBulk(slice any, setFunc func(*OutboxEmailCreate, int)) *OutboxEmailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEmailCreateBulk{err: fmt.Errorf("calling to OutboxEmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEmailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEmailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEmail.
+func (c *OutboxEmailClient) Update() *OutboxEmailUpdate {
+	mutation := newOutboxEmailMutation(c.config, OpUpdate)
+	return &OutboxEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEmailClient) UpdateOne(_m *OutboxEmail) *OutboxEmailUpdateOne {
+	mutation := newOutboxEmailMutation(c.config, OpUpdateOne, withOutboxEmail(_m))
+	return &OutboxEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEmailClient) UpdateOneID(id int) *OutboxEmailUpdateOne {
+	mutation := newOutboxEmailMutation(c.config, OpUpdateOne, withOutboxEmailID(id))
+	return &OutboxEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEmail.
+func (c *OutboxEmailClient) Delete() *OutboxEmailDelete {
+	mutation := newOutboxEmailMutation(c.config, OpDelete)
+	return &OutboxEmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEmailClient) DeleteOne(_m *OutboxEmail) *OutboxEmailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEmailClient) DeleteOneID(id int) *OutboxEmailDeleteOne {
+	builder := c.Delete().Where(outboxemail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEmailDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEmail.
+func (c *OutboxEmailClient) Query() *OutboxEmailQuery {
+	return &OutboxEmailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEmail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEmail entity by its id.
+func (c *OutboxEmailClient) Get(ctx context.Context, id int) (*OutboxEmail, error) {
+	return c.Query().Where(outboxemail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEmailClient) GetX(ctx context.Context, id int) *OutboxEmail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEmailClient) Hooks() []Hook {
+	return c.hooks.OutboxEmail
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEmailClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEmail
+}
+
+func (c *OutboxEmailClient) mutate(ctx context.Context, m *OutboxEmailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEmail mutation op: %q", m.Op())
+	}
+}
+
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(_m *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(_m))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id int) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(_m *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id int) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id int) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id int) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(_m *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for Filter.
-func (c *FilterClient) Query() *FilterQuery {
-	return &FilterQuery{
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFilter},
+		ctx:    &QueryContext{Type: TypeRefreshToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Filter entity by its id.
-func (c *FilterClient) Get(ctx context.Context, id int) (*Filter, error) {
-	return c.Query().Where(filter.ID(id)).Only(ctx)
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FilterClient) GetX(ctx context.Context, id int) *Filter {
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int) *RefreshToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1495,15 +2702,15 @@ func (c *FilterClient) GetX(ctx context.Context, id int) *Filter {
 	return obj
 }
 
-// QueryUserFilters queries the user_filters edge of a Filter.
-func (c *FilterClient) QueryUserFilters(_m *Filter) *UserFilterQuery {
-	query := (&UserFilterClient{config: c.config}).Query()
+// QueryUser queries the user edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryUser(_m *RefreshToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(filter.Table, filter.FieldID, id),
-			sqlgraph.To(userfilter.Table, userfilter.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, filter.UserFiltersTable, filter.UserFiltersColumn),
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1511,15 +2718,148 @@ func (c *FilterClient) QueryUserFilters(_m *Filter) *UserFilterQuery {
 	return query
 }
 
-// QueryBenefitFilters queries the benefit_filters edge of a Filter.
-func (c *FilterClient) QueryBenefitFilters(_m *Filter) *BenefitFilterQuery {
-	query := (&BenefitFilterClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// RuleGroupClient is a client for the RuleGroup schema.
+type RuleGroupClient struct {
+	config
+}
+
+// NewRuleGroupClient returns a client for the RuleGroup from the given config.
+func NewRuleGroupClient(c config) *RuleGroupClient {
+	return &RuleGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rulegroup.Hooks(f(g(h())))`.
+func (c *RuleGroupClient) Use(hooks ...Hook) {
+	c.hooks.RuleGroup = append(c.hooks.RuleGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rulegroup.Intercept(f(g(h())))`.
+func (c *RuleGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RuleGroup = append(c.inters.RuleGroup, interceptors...)
+}
+
+// Create returns a builder for creating a RuleGroup entity.
+func (c *RuleGroupClient) Create() *RuleGroupCreate {
+	mutation := newRuleGroupMutation(c.config, OpCreate)
+	return &RuleGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RuleGroup entities.
+func (c *RuleGroupClient) CreateBulk(builders ...*RuleGroupCreate) *RuleGroupCreateBulk {
+	return &RuleGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RuleGroupClient) MapCreateBulk(slice any, setFunc func(*RuleGroupCreate, int)) *RuleGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RuleGroupCreateBulk{err: fmt.Errorf("calling to RuleGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RuleGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RuleGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RuleGroup.
+func (c *RuleGroupClient) Update() *RuleGroupUpdate {
+	mutation := newRuleGroupMutation(c.config, OpUpdate)
+	return &RuleGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RuleGroupClient) UpdateOne(_m *RuleGroup) *RuleGroupUpdateOne {
+	mutation := newRuleGroupMutation(c.config, OpUpdateOne, withRuleGroup(_m))
+	return &RuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RuleGroupClient) UpdateOneID(id int) *RuleGroupUpdateOne {
+	mutation := newRuleGroupMutation(c.config, OpUpdateOne, withRuleGroupID(id))
+	return &RuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RuleGroup.
+func (c *RuleGroupClient) Delete() *RuleGroupDelete {
+	mutation := newRuleGroupMutation(c.config, OpDelete)
+	return &RuleGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RuleGroupClient) DeleteOne(_m *RuleGroup) *RuleGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RuleGroupClient) DeleteOneID(id int) *RuleGroupDeleteOne {
+	builder := c.Delete().Where(rulegroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RuleGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for RuleGroup.
+func (c *RuleGroupClient) Query() *RuleGroupQuery {
+	return &RuleGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRuleGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RuleGroup entity by its id.
+func (c *RuleGroupClient) Get(ctx context.Context, id int) (*RuleGroup, error) {
+	return c.Query().Where(rulegroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RuleGroupClient) GetX(ctx context.Context, id int) *RuleGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBenefit queries the benefit edge of a RuleGroup.
+func (c *RuleGroupClient) QueryBenefit(_m *RuleGroup) *BenefitQuery {
+	query := (&BenefitClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(filter.Table, filter.FieldID, id),
-			sqlgraph.To(benefitfilter.Table, benefitfilter.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, filter.BenefitFiltersTable, filter.BenefitFiltersColumn),
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, id),
+			sqlgraph.To(benefit.Table, benefit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rulegroup.BenefitTable, rulegroup.BenefitColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1527,15 +2867,47 @@ func (c *FilterClient) QueryBenefitFilters(_m *Filter) *BenefitFilterQuery {
 	return query
 }
 
-// QueryChildFilters queries the child_filters edge of a Filter.
-func (c *FilterClient) QueryChildFilters(_m *Filter) *ChildFilterQuery {
-	query := (&ChildFilterClient{config: c.config}).Query()
+// QueryParent queries the parent edge of a RuleGroup.
+func (c *RuleGroupClient) QueryParent(_m *RuleGroup) *RuleGroupQuery {
+	query := (&RuleGroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(filter.Table, filter.FieldID, id),
-			sqlgraph.To(childfilter.Table, childfilter.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, filter.ChildFiltersTable, filter.ChildFiltersColumn),
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, id),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rulegroup.ParentTable, rulegroup.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a RuleGroup.
+func (c *RuleGroupClient) QueryChildren(_m *RuleGroup) *RuleGroupQuery {
+	query := (&RuleGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, id),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rulegroup.ChildrenTable, rulegroup.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBenefitFilters queries the benefit_filters edge of a RuleGroup.
+func (c *RuleGroupClient) QueryBenefitFilters(_m *RuleGroup) *BenefitFilterQuery {
+	query := (&BenefitFilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, id),
+			sqlgraph.To(benefitfilter.Table, benefitfilter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rulegroup.BenefitFiltersTable, rulegroup.BenefitFiltersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1544,27 +2916,27 @@ func (c *FilterClient) QueryChildFilters(_m *Filter) *ChildFilterQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *FilterClient) Hooks() []Hook {
-	return c.hooks.Filter
+func (c *RuleGroupClient) Hooks() []Hook {
+	return c.hooks.RuleGroup
 }
 
 // Interceptors returns the client interceptors.
-func (c *FilterClient) Interceptors() []Interceptor {
-	return c.inters.Filter
+func (c *RuleGroupClient) Interceptors() []Interceptor {
+	return c.inters.RuleGroup
 }
 
-func (c *FilterClient) mutate(ctx context.Context, m *FilterMutation) (Value, error) {
+func (c *RuleGroupClient) mutate(ctx context.Context, m *RuleGroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RuleGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RuleGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RuleGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RuleGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Filter mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RuleGroup mutation op: %q", m.Op())
 	}
 }
 
@@ -1708,6 +3080,54 @@ func (c *UserClient) QueryChildren(_m *User) *ChildQuery {
 	return query
 }
 
+// QueryRefreshTokens queries the refresh_tokens edge of a User.
+func (c *UserClient) QueryRefreshTokens(_m *User) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmailVerifications queries the email_verifications edge of a User.
+func (c *UserClient) QueryEmailVerifications(_m *User) *EmailVerificationQuery {
+	query := (&EmailVerificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(emailverification.Table, emailverification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailVerificationsTable, user.EmailVerificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(_m *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1901,11 +3321,15 @@ func (c *UserFilterClient) mutate(ctx context.Context, m *UserFilterMutation) (V
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Attempt, Benefit, BenefitCategory, BenefitFilter, Category, Child, ChildFilter,
-		Filter, User, UserFilter []ent.Hook
+		Attempt, Benefit, BenefitCategory, BenefitFilter, BenefitTranslation, Category,
+		CategoryTranslation, Child, ChildFilter, EmailVerification, Filter,
+		FilterTranslation, OutboxEmail, RecoveryCode, RefreshToken, RuleGroup, User,
+		UserFilter []ent.Hook
 	}
 	inters struct {
-		Attempt, Benefit, BenefitCategory, BenefitFilter, Category, Child, ChildFilter,
-		Filter, User, UserFilter []ent.Interceptor
+		Attempt, Benefit, BenefitCategory, BenefitFilter, BenefitTranslation, Category,
+		CategoryTranslation, Child, ChildFilter, EmailVerification, Filter,
+		FilterTranslation, OutboxEmail, RecoveryCode, RefreshToken, RuleGroup, User,
+		UserFilter []ent.Interceptor
 	}
 )
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailverification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldUserID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldEmail, v))
+}
+
+// Otp applies equality check predicate on the "otp" field. It's identical to OtpEQ.
+func Otp(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldOtp, v))
+}
+
+// Tries applies equality check predicate on the "tries" field. It's identical to TriesEQ.
+func Tries(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldTries, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// OtpEQ applies the EQ predicate on the "otp" field.
+func OtpEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldOtp, v))
+}
+
+// OtpNEQ applies the NEQ predicate on the "otp" field.
+func OtpNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldOtp, v))
+}
+
+// OtpIn applies the In predicate on the "otp" field.
+func OtpIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldOtp, vs...))
+}
+
+// OtpNotIn applies the NotIn predicate on the "otp" field.
+func OtpNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldOtp, vs...))
+}
+
+// OtpGT applies the GT predicate on the "otp" field.
+func OtpGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldOtp, v))
+}
+
+// OtpGTE applies the GTE predicate on the "otp" field.
+func OtpGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldOtp, v))
+}
+
+// OtpLT applies the LT predicate on the "otp" field.
+func OtpLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldOtp, v))
+}
+
+// OtpLTE applies the LTE predicate on the "otp" field.
+func OtpLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldOtp, v))
+}
+
+// OtpContains applies the Contains predicate on the "otp" field.
+func OtpContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldOtp, v))
+}
+
+// OtpHasPrefix applies the HasPrefix predicate on the "otp" field.
+func OtpHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldOtp, v))
+}
+
+// OtpHasSuffix applies the HasSuffix predicate on the "otp" field.
+func OtpHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldOtp, v))
+}
+
+// OtpEqualFold applies the EqualFold predicate on the "otp" field.
+func OtpEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldOtp, v))
+}
+
+// OtpContainsFold applies the ContainsFold predicate on the "otp" field.
+func OtpContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldOtp, v))
+}
+
+// TriesEQ applies the EQ predicate on the "tries" field.
+func TriesEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldTries, v))
+}
+
+// TriesNEQ applies the NEQ predicate on the "tries" field.
+func TriesNEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldTries, v))
+}
+
+// TriesIn applies the In predicate on the "tries" field.
+func TriesIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldTries, vs...))
+}
+
+// TriesNotIn applies the NotIn predicate on the "tries" field.
+func TriesNotIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldTries, vs...))
+}
+
+// TriesGT applies the GT predicate on the "tries" field.
+func TriesGT(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldTries, v))
+}
+
+// TriesGTE applies the GTE predicate on the "tries" field.
+func TriesGTE(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldTries, v))
+}
+
+// TriesLT applies the LT predicate on the "tries" field.
+func TriesLT(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldTries, v))
+}
+
+// TriesLTE applies the LTE predicate on the "tries" field.
+func TriesLTE(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldTries, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldSentAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EmailVerification {
+	return predicate.EmailVerification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EmailVerification {
+	return predicate.EmailVerification(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.NotPredicates(p))
+}
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/emailverification"
+	"github.com/citizenkz/core/ent/user"
+)
+
+// EmailVerificationCreate is the builder for creating a EmailVerification entity.
+type EmailVerificationCreate struct {
+	config
+	mutation *EmailVerificationMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmailVerificationCreate) SetUserID(v int) *EmailVerificationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *EmailVerificationCreate) SetEmail(v string) *EmailVerificationCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetOtp sets the "otp" field.
+func (_c *EmailVerificationCreate) SetOtp(v string) *EmailVerificationCreate {
+	_c.mutation.SetOtp(v)
+	return _c
+}
+
+// SetTries sets the "tries" field.
+func (_c *EmailVerificationCreate) SetTries(v int) *EmailVerificationCreate {
+	_c.mutation.SetTries(v)
+	return _c
+}
+
+// SetNillableTries sets the "tries" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableTries(v *int) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetTries(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *EmailVerificationCreate) SetSentAt(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableSentAt(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailVerificationCreate) SetCreatedAt(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableCreatedAt(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EmailVerificationCreate) SetUser(v *User) *EmailVerificationCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (_c *EmailVerificationCreate) Mutation() *EmailVerificationMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailVerification in the database.
+func (_c *EmailVerificationCreate) Save(ctx context.Context) (*EmailVerification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailVerificationCreate) SaveX(ctx context.Context) *EmailVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailVerificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailVerificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailVerificationCreate) defaults() {
+	if _, ok := _c.mutation.Tries(); !ok {
+		v := emailverification.DefaultTries
+		_c.mutation.SetTries(v)
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		v := emailverification.DefaultSentAt()
+		_c.mutation.SetSentAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailverification.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailVerificationCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailVerification.user_id"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "EmailVerification.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := emailverification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Otp(); !ok {
+		return &ValidationError{Name: "otp", err: errors.New(`ent: missing required field "EmailVerification.otp"`)}
+	}
+	if v, ok := _c.mutation.Otp(); ok {
+		if err := emailverification.OtpValidator(v); err != nil {
+			return &ValidationError{Name: "otp", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.otp": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Tries(); !ok {
+		return &ValidationError{Name: "tries", err: errors.New(`ent: missing required field "EmailVerification.tries"`)}
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`ent: missing required field "EmailVerification.sent_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailVerification.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailVerification.user"`)}
+	}
+	return nil
+}
+
+func (_c *EmailVerificationCreate) sqlSave(ctx context.Context) (*EmailVerification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailVerificationCreate) createSpec() (*EmailVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailVerification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailverification.Table, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(emailverification.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Otp(); ok {
+		_spec.SetField(emailverification.FieldOtp, field.TypeString, value)
+		_node.Otp = value
+	}
+	if value, ok := _c.mutation.Tries(); ok {
+		_spec.SetField(emailverification.FieldTries, field.TypeInt, value)
+		_node.Tries = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(emailverification.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailverification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverification.UserTable,
+			Columns: []string{emailverification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailVerificationCreateBulk is the builder for creating many EmailVerification entities in bulk.
+type EmailVerificationCreateBulk struct {
+	config
+	err      error
+	builders []*EmailVerificationCreate
+}
+
+// Save creates the EmailVerification entities in the database.
+func (_c *EmailVerificationCreateBulk) Save(ctx context.Context) ([]*EmailVerification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailVerification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailVerificationCreateBulk) SaveX(ctx context.Context) []*EmailVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
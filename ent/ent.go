@@ -16,10 +16,18 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
 	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
 	"github.com/citizenkz/core/ent/child"
 	"github.com/citizenkz/core/ent/childfilter"
+	"github.com/citizenkz/core/ent/emailverification"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+	"github.com/citizenkz/core/ent/outboxemail"
+	"github.com/citizenkz/core/ent/recoverycode"
+	"github.com/citizenkz/core/ent/refreshtoken"
+	"github.com/citizenkz/core/ent/rulegroup"
 	"github.com/citizenkz/core/ent/user"
 	"github.com/citizenkz/core/ent/userfilter"
 )
@@ -82,16 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			attempt.Table:         attempt.ValidColumn,
-			benefit.Table:         benefit.ValidColumn,
-			benefitcategory.Table: benefitcategory.ValidColumn,
-			benefitfilter.Table:   benefitfilter.ValidColumn,
-			category.Table:        category.ValidColumn,
-			child.Table:           child.ValidColumn,
-			childfilter.Table:     childfilter.ValidColumn,
-			filter.Table:          filter.ValidColumn,
-			user.Table:            user.ValidColumn,
-			userfilter.Table:      userfilter.ValidColumn,
+			attempt.Table:             attempt.ValidColumn,
+			benefit.Table:             benefit.ValidColumn,
+			benefitcategory.Table:     benefitcategory.ValidColumn,
+			benefitfilter.Table:       benefitfilter.ValidColumn,
+			benefittranslation.Table:  benefittranslation.ValidColumn,
+			category.Table:            category.ValidColumn,
+			categorytranslation.Table: categorytranslation.ValidColumn,
+			child.Table:               child.ValidColumn,
+			childfilter.Table:         childfilter.ValidColumn,
+			emailverification.Table:   emailverification.ValidColumn,
+			filter.Table:              filter.ValidColumn,
+			filtertranslation.Table:   filtertranslation.ValidColumn,
+			outboxemail.Table:         outboxemail.ValidColumn,
+			recoverycode.Table:        recoverycode.ValidColumn,
+			refreshtoken.Table:        refreshtoken.ValidColumn,
+			rulegroup.Table:           rulegroup.ValidColumn,
+			user.Table:                user.ValidColumn,
+			userfilter.Table:          userfilter.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -25,6 +25,8 @@ type Filter struct {
 	Type filter.Type `json:"type,omitempty"`
 	// Values holds the value of the "values" field.
 	Values []string `json:"values,omitempty"`
+	// IsAge holds the value of the "is_age" field.
+	IsAge bool `json:"is_age,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FilterQuery when eager-loading is set.
 	Edges        FilterEdges `json:"edges"`
@@ -39,9 +41,11 @@ type FilterEdges struct {
 	BenefitFilters []*BenefitFilter `json:"benefit_filters,omitempty"`
 	// ChildFilters holds the value of the child_filters edge.
 	ChildFilters []*ChildFilter `json:"child_filters,omitempty"`
+	// Translations holds the value of the translations edge.
+	Translations []*FilterTranslation `json:"translations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // UserFiltersOrErr returns the UserFilters value or an error if the edge
@@ -71,6 +75,15 @@ func (e FilterEdges) ChildFiltersOrErr() ([]*ChildFilter, error) {
 	return nil, &NotLoadedError{edge: "child_filters"}
 }
 
+// TranslationsOrErr returns the Translations value or an error if the edge
+// was not loaded in eager-loading.
+func (e FilterEdges) TranslationsOrErr() ([]*FilterTranslation, error) {
+	if e.loadedTypes[3] {
+		return e.Translations, nil
+	}
+	return nil, &NotLoadedError{edge: "translations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Filter) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -78,6 +91,8 @@ func (*Filter) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case filter.FieldValues:
 			values[i] = new([]byte)
+		case filter.FieldIsAge:
+			values[i] = new(sql.NullBool)
 		case filter.FieldID:
 			values[i] = new(sql.NullInt64)
 		case filter.FieldName, filter.FieldHint, filter.FieldType:
@@ -130,6 +145,12 @@ func (_m *Filter) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field values: %w", err)
 				}
 			}
+		case filter.FieldIsAge:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_age", values[i])
+			} else if value.Valid {
+				_m.IsAge = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +179,11 @@ func (_m *Filter) QueryChildFilters() *ChildFilterQuery {
 	return NewFilterClient(_m.config).QueryChildFilters(_m)
 }
 
+// QueryTranslations queries the "translations" edge of the Filter entity.
+func (_m *Filter) QueryTranslations() *FilterTranslationQuery {
+	return NewFilterClient(_m.config).QueryTranslations(_m)
+}
+
 // Update returns a builder for updating this Filter.
 // Note that you need to call Filter.Unwrap() before calling this method if this Filter
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -194,6 +220,9 @@ func (_m *Filter) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("values=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Values))
+	builder.WriteString(", ")
+	builder.WriteString("is_age=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAge))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,12 +22,16 @@ const (
 	FieldType = "type"
 	// FieldValues holds the string denoting the values field in the database.
 	FieldValues = "values"
+	// FieldIsAge holds the string denoting the is_age field in the database.
+	FieldIsAge = "is_age"
 	// EdgeUserFilters holds the string denoting the user_filters edge name in mutations.
 	EdgeUserFilters = "user_filters"
 	// EdgeBenefitFilters holds the string denoting the benefit_filters edge name in mutations.
 	EdgeBenefitFilters = "benefit_filters"
 	// EdgeChildFilters holds the string denoting the child_filters edge name in mutations.
 	EdgeChildFilters = "child_filters"
+	// EdgeTranslations holds the string denoting the translations edge name in mutations.
+	EdgeTranslations = "translations"
 	// Table holds the table name of the filter in the database.
 	Table = "filters"
 	// UserFiltersTable is the table that holds the user_filters relation/edge.
@@ -51,6 +55,13 @@ const (
 	ChildFiltersInverseTable = "child_filters"
 	// ChildFiltersColumn is the table column denoting the child_filters relation/edge.
 	ChildFiltersColumn = "filter_id"
+	// TranslationsTable is the table that holds the translations relation/edge.
+	TranslationsTable = "filter_translations"
+	// TranslationsInverseTable is the table name for the FilterTranslation entity.
+	// It exists in this package in order to avoid circular dependency with the "filtertranslation" package.
+	TranslationsInverseTable = "filter_translations"
+	// TranslationsColumn is the table column denoting the translations relation/edge.
+	TranslationsColumn = "filter_id"
 )
 
 // Columns holds all SQL columns for filter fields.
@@ -60,6 +71,7 @@ var Columns = []string{
 	FieldHint,
 	FieldType,
 	FieldValues,
+	FieldIsAge,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,6 +87,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultIsAge holds the default value on creation for the "is_age" field.
+	DefaultIsAge bool
 )
 
 // Type defines the type for the "type" enum field.
@@ -124,6 +138,11 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByIsAge orders the results by the is_age field.
+func ByIsAge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAge, opts...).ToFunc()
+}
+
 // ByUserFiltersCount orders the results by user_filters count.
 func ByUserFiltersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -165,6 +184,20 @@ func ByChildFilters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChildFiltersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTranslationsCount orders the results by translations count.
+func ByTranslationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranslationsStep(), opts...)
+	}
+}
+
+// ByTranslations orders the results by translations terms.
+func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranslationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserFiltersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -186,3 +219,10 @@ func newChildFiltersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChildFiltersTable, ChildFiltersColumn),
 	)
 }
+func newTranslationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranslationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+	)
+}
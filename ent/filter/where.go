@@ -63,6 +63,11 @@ func Hint(v string) predicate.Filter {
 	return predicate.Filter(sql.FieldEQ(FieldHint, v))
 }
 
+// IsAge applies equality check predicate on the "is_age" field. It's identical to IsAgeEQ.
+func IsAge(v bool) predicate.Filter {
+	return predicate.Filter(sql.FieldEQ(FieldIsAge, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Filter {
 	return predicate.Filter(sql.FieldEQ(FieldName, v))
@@ -223,6 +228,16 @@ func TypeNotIn(vs ...Type) predicate.Filter {
 	return predicate.Filter(sql.FieldNotIn(FieldType, vs...))
 }
 
+// IsAgeEQ applies the EQ predicate on the "is_age" field.
+func IsAgeEQ(v bool) predicate.Filter {
+	return predicate.Filter(sql.FieldEQ(FieldIsAge, v))
+}
+
+// IsAgeNEQ applies the NEQ predicate on the "is_age" field.
+func IsAgeNEQ(v bool) predicate.Filter {
+	return predicate.Filter(sql.FieldNEQ(FieldIsAge, v))
+}
+
 // HasUserFilters applies the HasEdge predicate on the "user_filters" edge.
 func HasUserFilters() predicate.Filter {
 	return predicate.Filter(func(s *sql.Selector) {
@@ -292,6 +307,29 @@ func HasChildFiltersWith(preds ...predicate.ChildFilter) predicate.Filter {
 	})
 }
 
+// HasTranslations applies the HasEdge predicate on the "translations" edge.
+func HasTranslations() predicate.Filter {
+	return predicate.Filter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranslationsWith applies the HasEdge predicate on the "translations" edge with a given conditions (other predicates).
+func HasTranslationsWith(preds ...predicate.FilterTranslation) predicate.Filter {
+	return predicate.Filter(func(s *sql.Selector) {
+		step := newTranslationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Filter) predicate.Filter {
 	return predicate.Filter(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/childfilter"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
 	"github.com/citizenkz/core/ent/userfilter"
 )
 
@@ -54,6 +55,20 @@ func (_c *FilterCreate) SetValues(v []string) *FilterCreate {
 	return _c
 }
 
+// SetIsAge sets the "is_age" field.
+func (_c *FilterCreate) SetIsAge(v bool) *FilterCreate {
+	_c.mutation.SetIsAge(v)
+	return _c
+}
+
+// SetNillableIsAge sets the "is_age" field if the given value is not nil.
+func (_c *FilterCreate) SetNillableIsAge(v *bool) *FilterCreate {
+	if v != nil {
+		_c.SetIsAge(*v)
+	}
+	return _c
+}
+
 // AddUserFilterIDs adds the "user_filters" edge to the UserFilter entity by IDs.
 func (_c *FilterCreate) AddUserFilterIDs(ids ...int) *FilterCreate {
 	_c.mutation.AddUserFilterIDs(ids...)
@@ -99,6 +114,21 @@ func (_c *FilterCreate) AddChildFilters(v ...*ChildFilter) *FilterCreate {
 	return _c.AddChildFilterIDs(ids...)
 }
 
+// AddTranslationIDs adds the "translations" edge to the FilterTranslation entity by IDs.
+func (_c *FilterCreate) AddTranslationIDs(ids ...int) *FilterCreate {
+	_c.mutation.AddTranslationIDs(ids...)
+	return _c
+}
+
+// AddTranslations adds the "translations" edges to the FilterTranslation entity.
+func (_c *FilterCreate) AddTranslations(v ...*FilterTranslation) *FilterCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the FilterMutation object of the builder.
 func (_c *FilterCreate) Mutation() *FilterMutation {
 	return _c.mutation
@@ -106,6 +136,7 @@ func (_c *FilterCreate) Mutation() *FilterMutation {
 
 // Save creates the Filter in the database.
 func (_c *FilterCreate) Save(ctx context.Context) (*Filter, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -131,6 +162,14 @@ func (_c *FilterCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *FilterCreate) defaults() {
+	if _, ok := _c.mutation.IsAge(); !ok {
+		v := filter.DefaultIsAge
+		_c.mutation.SetIsAge(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *FilterCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
@@ -152,6 +191,9 @@ func (_c *FilterCreate) check() error {
 	if _, ok := _c.mutation.Values(); !ok {
 		return &ValidationError{Name: "values", err: errors.New(`ent: missing required field "Filter.values"`)}
 	}
+	if _, ok := _c.mutation.IsAge(); !ok {
+		return &ValidationError{Name: "is_age", err: errors.New(`ent: missing required field "Filter.is_age"`)}
+	}
 	return nil
 }
 
@@ -194,6 +236,10 @@ func (_c *FilterCreate) createSpec() (*Filter, *sqlgraph.CreateSpec) {
 		_spec.SetField(filter.FieldValues, field.TypeJSON, value)
 		_node.Values = value
 	}
+	if value, ok := _c.mutation.IsAge(); ok {
+		_spec.SetField(filter.FieldIsAge, field.TypeBool, value)
+		_node.IsAge = value
+	}
 	if nodes := _c.mutation.UserFiltersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -242,6 +288,22 @@ func (_c *FilterCreate) createSpec() (*Filter, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -263,6 +325,7 @@ func (_c *FilterCreateBulk) Save(ctx context.Context) ([]*Filter, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*FilterMutation)
 				if !ok {
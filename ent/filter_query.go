@@ -15,6 +15,7 @@ import (
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/childfilter"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
 	"github.com/citizenkz/core/ent/predicate"
 	"github.com/citizenkz/core/ent/userfilter"
 )
@@ -29,6 +30,7 @@ type FilterQuery struct {
 	withUserFilters    *UserFilterQuery
 	withBenefitFilters *BenefitFilterQuery
 	withChildFilters   *ChildFilterQuery
+	withTranslations   *FilterTranslationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +133,28 @@ func (_q *FilterQuery) QueryChildFilters() *ChildFilterQuery {
 	return query
 }
 
+// QueryTranslations chains the current query on the "translations" edge.
+func (_q *FilterQuery) QueryTranslations() *FilterTranslationQuery {
+	query := (&FilterTranslationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filter.Table, filter.FieldID, selector),
+			sqlgraph.To(filtertranslation.Table, filtertranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, filter.TranslationsTable, filter.TranslationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Filter entity from the query.
 // Returns a *NotFoundError when no Filter was found.
 func (_q *FilterQuery) First(ctx context.Context) (*Filter, error) {
@@ -326,6 +350,7 @@ func (_q *FilterQuery) Clone() *FilterQuery {
 		withUserFilters:    _q.withUserFilters.Clone(),
 		withBenefitFilters: _q.withBenefitFilters.Clone(),
 		withChildFilters:   _q.withChildFilters.Clone(),
+		withTranslations:   _q.withTranslations.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +390,17 @@ func (_q *FilterQuery) WithChildFilters(opts ...func(*ChildFilterQuery)) *Filter
 	return _q
 }
 
+// WithTranslations tells the query-builder to eager-load the nodes that are connected to
+// the "translations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FilterQuery) WithTranslations(opts ...func(*FilterTranslationQuery)) *FilterQuery {
+	query := (&FilterTranslationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranslations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +479,11 @@ func (_q *FilterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Filte
 	var (
 		nodes       = []*Filter{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withUserFilters != nil,
 			_q.withBenefitFilters != nil,
 			_q.withChildFilters != nil,
+			_q.withTranslations != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +525,13 @@ func (_q *FilterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Filte
 			return nil, err
 		}
 	}
+	if query := _q.withTranslations; query != nil {
+		if err := _q.loadTranslations(ctx, query, nodes,
+			func(n *Filter) { n.Edges.Translations = []*FilterTranslation{} },
+			func(n *Filter, e *FilterTranslation) { n.Edges.Translations = append(n.Edges.Translations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -581,6 +625,36 @@ func (_q *FilterQuery) loadChildFilters(ctx context.Context, query *ChildFilterQ
 	}
 	return nil
 }
+func (_q *FilterQuery) loadTranslations(ctx context.Context, query *FilterTranslationQuery, nodes []*Filter, init func(*Filter), assign func(*Filter, *FilterTranslation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Filter)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(filtertranslation.FieldFilterID)
+	}
+	query.Where(predicate.FilterTranslation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(filter.TranslationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FilterID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "filter_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *FilterQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
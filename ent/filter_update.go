@@ -14,6 +14,7 @@ import (
 	"github.com/citizenkz/core/ent/benefitfilter"
 	"github.com/citizenkz/core/ent/childfilter"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
 	"github.com/citizenkz/core/ent/predicate"
 	"github.com/citizenkz/core/ent/userfilter"
 )
@@ -91,6 +92,20 @@ func (_u *FilterUpdate) AppendValues(v []string) *FilterUpdate {
 	return _u
 }
 
+// SetIsAge sets the "is_age" field.
+func (_u *FilterUpdate) SetIsAge(v bool) *FilterUpdate {
+	_u.mutation.SetIsAge(v)
+	return _u
+}
+
+// SetNillableIsAge sets the "is_age" field if the given value is not nil.
+func (_u *FilterUpdate) SetNillableIsAge(v *bool) *FilterUpdate {
+	if v != nil {
+		_u.SetIsAge(*v)
+	}
+	return _u
+}
+
 // AddUserFilterIDs adds the "user_filters" edge to the UserFilter entity by IDs.
 func (_u *FilterUpdate) AddUserFilterIDs(ids ...int) *FilterUpdate {
 	_u.mutation.AddUserFilterIDs(ids...)
@@ -136,6 +151,21 @@ func (_u *FilterUpdate) AddChildFilters(v ...*ChildFilter) *FilterUpdate {
 	return _u.AddChildFilterIDs(ids...)
 }
 
+// AddTranslationIDs adds the "translations" edge to the FilterTranslation entity by IDs.
+func (_u *FilterUpdate) AddTranslationIDs(ids ...int) *FilterUpdate {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the FilterTranslation entity.
+func (_u *FilterUpdate) AddTranslations(v ...*FilterTranslation) *FilterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the FilterMutation object of the builder.
 func (_u *FilterUpdate) Mutation() *FilterMutation {
 	return _u.mutation
@@ -204,6 +234,27 @@ func (_u *FilterUpdate) RemoveChildFilters(v ...*ChildFilter) *FilterUpdate {
 	return _u.RemoveChildFilterIDs(ids...)
 }
 
+// ClearTranslations clears all "translations" edges to the FilterTranslation entity.
+func (_u *FilterUpdate) ClearTranslations() *FilterUpdate {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to FilterTranslation entities by IDs.
+func (_u *FilterUpdate) RemoveTranslationIDs(ids ...int) *FilterUpdate {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to FilterTranslation entities.
+func (_u *FilterUpdate) RemoveTranslations(v ...*FilterTranslation) *FilterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FilterUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -278,6 +329,9 @@ func (_u *FilterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, filter.FieldValues, value)
 		})
 	}
+	if value, ok := _u.mutation.IsAge(); ok {
+		_spec.SetField(filter.FieldIsAge, field.TypeBool, value)
+	}
 	if _u.mutation.UserFiltersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -413,6 +467,51 @@ func (_u *FilterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{filter.Label}
@@ -493,6 +592,20 @@ func (_u *FilterUpdateOne) AppendValues(v []string) *FilterUpdateOne {
 	return _u
 }
 
+// SetIsAge sets the "is_age" field.
+func (_u *FilterUpdateOne) SetIsAge(v bool) *FilterUpdateOne {
+	_u.mutation.SetIsAge(v)
+	return _u
+}
+
+// SetNillableIsAge sets the "is_age" field if the given value is not nil.
+func (_u *FilterUpdateOne) SetNillableIsAge(v *bool) *FilterUpdateOne {
+	if v != nil {
+		_u.SetIsAge(*v)
+	}
+	return _u
+}
+
 // AddUserFilterIDs adds the "user_filters" edge to the UserFilter entity by IDs.
 func (_u *FilterUpdateOne) AddUserFilterIDs(ids ...int) *FilterUpdateOne {
 	_u.mutation.AddUserFilterIDs(ids...)
@@ -538,6 +651,21 @@ func (_u *FilterUpdateOne) AddChildFilters(v ...*ChildFilter) *FilterUpdateOne {
 	return _u.AddChildFilterIDs(ids...)
 }
 
+// AddTranslationIDs adds the "translations" edge to the FilterTranslation entity by IDs.
+func (_u *FilterUpdateOne) AddTranslationIDs(ids ...int) *FilterUpdateOne {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the FilterTranslation entity.
+func (_u *FilterUpdateOne) AddTranslations(v ...*FilterTranslation) *FilterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the FilterMutation object of the builder.
 func (_u *FilterUpdateOne) Mutation() *FilterMutation {
 	return _u.mutation
@@ -606,6 +734,27 @@ func (_u *FilterUpdateOne) RemoveChildFilters(v ...*ChildFilter) *FilterUpdateOn
 	return _u.RemoveChildFilterIDs(ids...)
 }
 
+// ClearTranslations clears all "translations" edges to the FilterTranslation entity.
+func (_u *FilterUpdateOne) ClearTranslations() *FilterUpdateOne {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to FilterTranslation entities by IDs.
+func (_u *FilterUpdateOne) RemoveTranslationIDs(ids ...int) *FilterUpdateOne {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to FilterTranslation entities.
+func (_u *FilterUpdateOne) RemoveTranslations(v ...*FilterTranslation) *FilterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
 // Where appends a list predicates to the FilterUpdate builder.
 func (_u *FilterUpdateOne) Where(ps ...predicate.Filter) *FilterUpdateOne {
 	_u.mutation.Where(ps...)
@@ -710,6 +859,9 @@ func (_u *FilterUpdateOne) sqlSave(ctx context.Context) (_node *Filter, err erro
 			sqljson.Append(u, filter.FieldValues, value)
 		})
 	}
+	if value, ok := _u.mutation.IsAge(); ok {
+		_spec.SetField(filter.FieldIsAge, field.TypeBool, value)
+	}
 	if _u.mutation.UserFiltersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -845,6 +997,51 @@ func (_u *FilterUpdateOne) sqlSave(ctx context.Context) (_node *Filter, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filter.TranslationsTable,
+			Columns: []string{filter.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Filter{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
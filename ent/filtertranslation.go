@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+)
+
+// FilterTranslation is the model entity for the FilterTranslation schema.
+type FilterTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FilterID holds the value of the "filter_id" field.
+	FilterID int `json:"filter_id,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale filtertranslation.Locale `json:"locale,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Hint holds the value of the "hint" field.
+	Hint string `json:"hint,omitempty"`
+	// Values holds the value of the "values" field.
+	Values []string `json:"values,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FilterTranslationQuery when eager-loading is set.
+	Edges        FilterTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FilterTranslationEdges holds the relations/edges for other nodes in the graph.
+type FilterTranslationEdges struct {
+	// Filter holds the value of the filter edge.
+	Filter *Filter `json:"filter,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FilterOrErr returns the Filter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FilterTranslationEdges) FilterOrErr() (*Filter, error) {
+	if e.Filter != nil {
+		return e.Filter, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: filter.Label}
+	}
+	return nil, &NotLoadedError{edge: "filter"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FilterTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case filtertranslation.FieldValues:
+			values[i] = new([]byte)
+		case filtertranslation.FieldID, filtertranslation.FieldFilterID:
+			values[i] = new(sql.NullInt64)
+		case filtertranslation.FieldLocale, filtertranslation.FieldName, filtertranslation.FieldHint:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FilterTranslation fields.
+func (_m *FilterTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case filtertranslation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case filtertranslation.FieldFilterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field filter_id", values[i])
+			} else if value.Valid {
+				_m.FilterID = int(value.Int64)
+			}
+		case filtertranslation.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = filtertranslation.Locale(value.String)
+			}
+		case filtertranslation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case filtertranslation.FieldHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hint", values[i])
+			} else if value.Valid {
+				_m.Hint = value.String
+			}
+		case filtertranslation.FieldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Values); err != nil {
+					return fmt.Errorf("unmarshal field values: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FilterTranslation.
+// This includes values selected through modifiers, order, etc.
+func (_m *FilterTranslation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFilter queries the "filter" edge of the FilterTranslation entity.
+func (_m *FilterTranslation) QueryFilter() *FilterQuery {
+	return NewFilterTranslationClient(_m.config).QueryFilter(_m)
+}
+
+// Update returns a builder for updating this FilterTranslation.
+// Note that you need to call FilterTranslation.Unwrap() before calling this method if this FilterTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FilterTranslation) Update() *FilterTranslationUpdateOne {
+	return NewFilterTranslationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FilterTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FilterTranslation) Unwrap() *FilterTranslation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FilterTranslation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FilterTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("FilterTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("filter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FilterID))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Locale))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("hint=")
+	builder.WriteString(_m.Hint)
+	builder.WriteString(", ")
+	builder.WriteString("values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Values))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FilterTranslations is a parsable slice of FilterTranslation.
+type FilterTranslations []*FilterTranslation
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filtertranslation
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the filtertranslation type in the database.
+	Label = "filter_translation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFilterID holds the string denoting the filter_id field in the database.
+	FieldFilterID = "filter_id"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldHint holds the string denoting the hint field in the database.
+	FieldHint = "hint"
+	// FieldValues holds the string denoting the values field in the database.
+	FieldValues = "values"
+	// EdgeFilter holds the string denoting the filter edge name in mutations.
+	EdgeFilter = "filter"
+	// Table holds the table name of the filtertranslation in the database.
+	Table = "filter_translations"
+	// FilterTable is the table that holds the filter relation/edge.
+	FilterTable = "filter_translations"
+	// FilterInverseTable is the table name for the Filter entity.
+	// It exists in this package in order to avoid circular dependency with the "filter" package.
+	FilterInverseTable = "filters"
+	// FilterColumn is the table column denoting the filter relation/edge.
+	FilterColumn = "filter_id"
+)
+
+// Columns holds all SQL columns for filtertranslation fields.
+var Columns = []string{
+	FieldID,
+	FieldFilterID,
+	FieldLocale,
+	FieldName,
+	FieldHint,
+	FieldValues,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Locale defines the type for the "locale" enum field.
+type Locale string
+
+// Locale values.
+const (
+	LocaleKk Locale = "kk"
+	LocaleRu Locale = "ru"
+)
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+// LocaleValidator is a validator for the "locale" field enum values. It is called by the builders before save.
+func LocaleValidator(l Locale) error {
+	switch l {
+	case LocaleKk, LocaleRu:
+		return nil
+	default:
+		return fmt.Errorf("filtertranslation: invalid enum value for locale field: %q", l)
+	}
+}
+
+// OrderOption defines the ordering options for the FilterTranslation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFilterID orders the results by the filter_id field.
+func ByFilterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilterID, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByHint orders the results by the hint field.
+func ByHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHint, opts...).ToFunc()
+}
+
+// ByFilterField orders the results by filter field.
+func ByFilterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFilterStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFilterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FilterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FilterTable, FilterColumn),
+	)
+}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filtertranslation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldLTE(FieldID, id))
+}
+
+// FilterID applies equality check predicate on the "filter_id" field. It's identical to FilterIDEQ.
+func FilterID(v int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldFilterID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldName, v))
+}
+
+// Hint applies equality check predicate on the "hint" field. It's identical to HintEQ.
+func Hint(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldHint, v))
+}
+
+// FilterIDEQ applies the EQ predicate on the "filter_id" field.
+func FilterIDEQ(v int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldFilterID, v))
+}
+
+// FilterIDNEQ applies the NEQ predicate on the "filter_id" field.
+func FilterIDNEQ(v int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNEQ(FieldFilterID, v))
+}
+
+// FilterIDIn applies the In predicate on the "filter_id" field.
+func FilterIDIn(vs ...int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIn(FieldFilterID, vs...))
+}
+
+// FilterIDNotIn applies the NotIn predicate on the "filter_id" field.
+func FilterIDNotIn(vs ...int) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotIn(FieldFilterID, vs...))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v Locale) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v Locale) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...Locale) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...Locale) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldContainsFold(FieldName, v))
+}
+
+// HintEQ applies the EQ predicate on the "hint" field.
+func HintEQ(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEQ(FieldHint, v))
+}
+
+// HintNEQ applies the NEQ predicate on the "hint" field.
+func HintNEQ(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNEQ(FieldHint, v))
+}
+
+// HintIn applies the In predicate on the "hint" field.
+func HintIn(vs ...string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIn(FieldHint, vs...))
+}
+
+// HintNotIn applies the NotIn predicate on the "hint" field.
+func HintNotIn(vs ...string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotIn(FieldHint, vs...))
+}
+
+// HintGT applies the GT predicate on the "hint" field.
+func HintGT(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldGT(FieldHint, v))
+}
+
+// HintGTE applies the GTE predicate on the "hint" field.
+func HintGTE(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldGTE(FieldHint, v))
+}
+
+// HintLT applies the LT predicate on the "hint" field.
+func HintLT(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldLT(FieldHint, v))
+}
+
+// HintLTE applies the LTE predicate on the "hint" field.
+func HintLTE(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldLTE(FieldHint, v))
+}
+
+// HintContains applies the Contains predicate on the "hint" field.
+func HintContains(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldContains(FieldHint, v))
+}
+
+// HintHasPrefix applies the HasPrefix predicate on the "hint" field.
+func HintHasPrefix(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldHasPrefix(FieldHint, v))
+}
+
+// HintHasSuffix applies the HasSuffix predicate on the "hint" field.
+func HintHasSuffix(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldHasSuffix(FieldHint, v))
+}
+
+// HintIsNil applies the IsNil predicate on the "hint" field.
+func HintIsNil() predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIsNull(FieldHint))
+}
+
+// HintNotNil applies the NotNil predicate on the "hint" field.
+func HintNotNil() predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotNull(FieldHint))
+}
+
+// HintEqualFold applies the EqualFold predicate on the "hint" field.
+func HintEqualFold(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldEqualFold(FieldHint, v))
+}
+
+// HintContainsFold applies the ContainsFold predicate on the "hint" field.
+func HintContainsFold(v string) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldContainsFold(FieldHint, v))
+}
+
+// ValuesIsNil applies the IsNil predicate on the "values" field.
+func ValuesIsNil() predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldIsNull(FieldValues))
+}
+
+// ValuesNotNil applies the NotNil predicate on the "values" field.
+func ValuesNotNil() predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.FieldNotNull(FieldValues))
+}
+
+// HasFilter applies the HasEdge predicate on the "filter" edge.
+func HasFilter() predicate.FilterTranslation {
+	return predicate.FilterTranslation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FilterTable, FilterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilterWith applies the HasEdge predicate on the "filter" edge with a given conditions (other predicates).
+func HasFilterWith(preds ...predicate.Filter) predicate.FilterTranslation {
+	return predicate.FilterTranslation(func(s *sql.Selector) {
+		step := newFilterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FilterTranslation) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FilterTranslation) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FilterTranslation) predicate.FilterTranslation {
+	return predicate.FilterTranslation(sql.NotPredicates(p))
+}
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+)
+
+// FilterTranslationCreate is the builder for creating a FilterTranslation entity.
+type FilterTranslationCreate struct {
+	config
+	mutation *FilterTranslationMutation
+	hooks    []Hook
+}
+
+// SetFilterID sets the "filter_id" field.
+func (_c *FilterTranslationCreate) SetFilterID(v int) *FilterTranslationCreate {
+	_c.mutation.SetFilterID(v)
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *FilterTranslationCreate) SetLocale(v filtertranslation.Locale) *FilterTranslationCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *FilterTranslationCreate) SetName(v string) *FilterTranslationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *FilterTranslationCreate) SetNillableName(v *string) *FilterTranslationCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetHint sets the "hint" field.
+func (_c *FilterTranslationCreate) SetHint(v string) *FilterTranslationCreate {
+	_c.mutation.SetHint(v)
+	return _c
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_c *FilterTranslationCreate) SetNillableHint(v *string) *FilterTranslationCreate {
+	if v != nil {
+		_c.SetHint(*v)
+	}
+	return _c
+}
+
+// SetValues sets the "values" field.
+func (_c *FilterTranslationCreate) SetValues(v []string) *FilterTranslationCreate {
+	_c.mutation.SetValues(v)
+	return _c
+}
+
+// SetFilter sets the "filter" edge to the Filter entity.
+func (_c *FilterTranslationCreate) SetFilter(v *Filter) *FilterTranslationCreate {
+	return _c.SetFilterID(v.ID)
+}
+
+// Mutation returns the FilterTranslationMutation object of the builder.
+func (_c *FilterTranslationCreate) Mutation() *FilterTranslationMutation {
+	return _c.mutation
+}
+
+// Save creates the FilterTranslation in the database.
+func (_c *FilterTranslationCreate) Save(ctx context.Context) (*FilterTranslation, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FilterTranslationCreate) SaveX(ctx context.Context) *FilterTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FilterTranslationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FilterTranslationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FilterTranslationCreate) check() error {
+	if _, ok := _c.mutation.FilterID(); !ok {
+		return &ValidationError{Name: "filter_id", err: errors.New(`ent: missing required field "FilterTranslation.filter_id"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "FilterTranslation.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := filtertranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "FilterTranslation.locale": %w`, err)}
+		}
+	}
+	if len(_c.mutation.FilterIDs()) == 0 {
+		return &ValidationError{Name: "filter", err: errors.New(`ent: missing required edge "FilterTranslation.filter"`)}
+	}
+	return nil
+}
+
+func (_c *FilterTranslationCreate) sqlSave(ctx context.Context) (*FilterTranslation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FilterTranslationCreate) createSpec() (*FilterTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FilterTranslation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(filtertranslation.Table, sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(filtertranslation.FieldLocale, field.TypeEnum, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(filtertranslation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Hint(); ok {
+		_spec.SetField(filtertranslation.FieldHint, field.TypeString, value)
+		_node.Hint = value
+	}
+	if value, ok := _c.mutation.Values(); ok {
+		_spec.SetField(filtertranslation.FieldValues, field.TypeJSON, value)
+		_node.Values = value
+	}
+	if nodes := _c.mutation.FilterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filtertranslation.FilterTable,
+			Columns: []string{filtertranslation.FilterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FilterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FilterTranslationCreateBulk is the builder for creating many FilterTranslation entities in bulk.
+type FilterTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*FilterTranslationCreate
+}
+
+// Save creates the FilterTranslation entities in the database.
+func (_c *FilterTranslationCreateBulk) Save(ctx context.Context) ([]*FilterTranslation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FilterTranslation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FilterTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FilterTranslationCreateBulk) SaveX(ctx context.Context) []*FilterTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FilterTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FilterTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// FilterTranslationUpdate is the builder for updating FilterTranslation entities.
+type FilterTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FilterTranslationMutation
+}
+
+// Where appends a list predicates to the FilterTranslationUpdate builder.
+func (_u *FilterTranslationUpdate) Where(ps ...predicate.FilterTranslation) *FilterTranslationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFilterID sets the "filter_id" field.
+func (_u *FilterTranslationUpdate) SetFilterID(v int) *FilterTranslationUpdate {
+	_u.mutation.SetFilterID(v)
+	return _u
+}
+
+// SetNillableFilterID sets the "filter_id" field if the given value is not nil.
+func (_u *FilterTranslationUpdate) SetNillableFilterID(v *int) *FilterTranslationUpdate {
+	if v != nil {
+		_u.SetFilterID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *FilterTranslationUpdate) SetLocale(v filtertranslation.Locale) *FilterTranslationUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *FilterTranslationUpdate) SetNillableLocale(v *filtertranslation.Locale) *FilterTranslationUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FilterTranslationUpdate) SetName(v string) *FilterTranslationUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FilterTranslationUpdate) SetNillableName(v *string) *FilterTranslationUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *FilterTranslationUpdate) ClearName() *FilterTranslationUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetHint sets the "hint" field.
+func (_u *FilterTranslationUpdate) SetHint(v string) *FilterTranslationUpdate {
+	_u.mutation.SetHint(v)
+	return _u
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_u *FilterTranslationUpdate) SetNillableHint(v *string) *FilterTranslationUpdate {
+	if v != nil {
+		_u.SetHint(*v)
+	}
+	return _u
+}
+
+// ClearHint clears the value of the "hint" field.
+func (_u *FilterTranslationUpdate) ClearHint() *FilterTranslationUpdate {
+	_u.mutation.ClearHint()
+	return _u
+}
+
+// SetValues sets the "values" field.
+func (_u *FilterTranslationUpdate) SetValues(v []string) *FilterTranslationUpdate {
+	_u.mutation.SetValues(v)
+	return _u
+}
+
+// AppendValues appends value to the "values" field.
+func (_u *FilterTranslationUpdate) AppendValues(v []string) *FilterTranslationUpdate {
+	_u.mutation.AppendValues(v)
+	return _u
+}
+
+// ClearValues clears the value of the "values" field.
+func (_u *FilterTranslationUpdate) ClearValues() *FilterTranslationUpdate {
+	_u.mutation.ClearValues()
+	return _u
+}
+
+// SetFilter sets the "filter" edge to the Filter entity.
+func (_u *FilterTranslationUpdate) SetFilter(v *Filter) *FilterTranslationUpdate {
+	return _u.SetFilterID(v.ID)
+}
+
+// Mutation returns the FilterTranslationMutation object of the builder.
+func (_u *FilterTranslationUpdate) Mutation() *FilterTranslationMutation {
+	return _u.mutation
+}
+
+// ClearFilter clears the "filter" edge to the Filter entity.
+func (_u *FilterTranslationUpdate) ClearFilter() *FilterTranslationUpdate {
+	_u.mutation.ClearFilter()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FilterTranslationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FilterTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FilterTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FilterTranslationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FilterTranslationUpdate) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := filtertranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "FilterTranslation.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.FilterCleared() && len(_u.mutation.FilterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FilterTranslation.filter"`)
+	}
+	return nil
+}
+
+func (_u *FilterTranslationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filtertranslation.Table, filtertranslation.Columns, sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(filtertranslation.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(filtertranslation.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(filtertranslation.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hint(); ok {
+		_spec.SetField(filtertranslation.FieldHint, field.TypeString, value)
+	}
+	if _u.mutation.HintCleared() {
+		_spec.ClearField(filtertranslation.FieldHint, field.TypeString)
+	}
+	if value, ok := _u.mutation.Values(); ok {
+		_spec.SetField(filtertranslation.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filtertranslation.FieldValues, value)
+		})
+	}
+	if _u.mutation.ValuesCleared() {
+		_spec.ClearField(filtertranslation.FieldValues, field.TypeJSON)
+	}
+	if _u.mutation.FilterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filtertranslation.FilterTable,
+			Columns: []string{filtertranslation.FilterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filtertranslation.FilterTable,
+			Columns: []string{filtertranslation.FilterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filtertranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FilterTranslationUpdateOne is the builder for updating a single FilterTranslation entity.
+type FilterTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FilterTranslationMutation
+}
+
+// SetFilterID sets the "filter_id" field.
+func (_u *FilterTranslationUpdateOne) SetFilterID(v int) *FilterTranslationUpdateOne {
+	_u.mutation.SetFilterID(v)
+	return _u
+}
+
+// SetNillableFilterID sets the "filter_id" field if the given value is not nil.
+func (_u *FilterTranslationUpdateOne) SetNillableFilterID(v *int) *FilterTranslationUpdateOne {
+	if v != nil {
+		_u.SetFilterID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *FilterTranslationUpdateOne) SetLocale(v filtertranslation.Locale) *FilterTranslationUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *FilterTranslationUpdateOne) SetNillableLocale(v *filtertranslation.Locale) *FilterTranslationUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FilterTranslationUpdateOne) SetName(v string) *FilterTranslationUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FilterTranslationUpdateOne) SetNillableName(v *string) *FilterTranslationUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *FilterTranslationUpdateOne) ClearName() *FilterTranslationUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetHint sets the "hint" field.
+func (_u *FilterTranslationUpdateOne) SetHint(v string) *FilterTranslationUpdateOne {
+	_u.mutation.SetHint(v)
+	return _u
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_u *FilterTranslationUpdateOne) SetNillableHint(v *string) *FilterTranslationUpdateOne {
+	if v != nil {
+		_u.SetHint(*v)
+	}
+	return _u
+}
+
+// ClearHint clears the value of the "hint" field.
+func (_u *FilterTranslationUpdateOne) ClearHint() *FilterTranslationUpdateOne {
+	_u.mutation.ClearHint()
+	return _u
+}
+
+// SetValues sets the "values" field.
+func (_u *FilterTranslationUpdateOne) SetValues(v []string) *FilterTranslationUpdateOne {
+	_u.mutation.SetValues(v)
+	return _u
+}
+
+// AppendValues appends value to the "values" field.
+func (_u *FilterTranslationUpdateOne) AppendValues(v []string) *FilterTranslationUpdateOne {
+	_u.mutation.AppendValues(v)
+	return _u
+}
+
+// ClearValues clears the value of the "values" field.
+func (_u *FilterTranslationUpdateOne) ClearValues() *FilterTranslationUpdateOne {
+	_u.mutation.ClearValues()
+	return _u
+}
+
+// SetFilter sets the "filter" edge to the Filter entity.
+func (_u *FilterTranslationUpdateOne) SetFilter(v *Filter) *FilterTranslationUpdateOne {
+	return _u.SetFilterID(v.ID)
+}
+
+// Mutation returns the FilterTranslationMutation object of the builder.
+func (_u *FilterTranslationUpdateOne) Mutation() *FilterTranslationMutation {
+	return _u.mutation
+}
+
+// ClearFilter clears the "filter" edge to the Filter entity.
+func (_u *FilterTranslationUpdateOne) ClearFilter() *FilterTranslationUpdateOne {
+	_u.mutation.ClearFilter()
+	return _u
+}
+
+// Where appends a list predicates to the FilterTranslationUpdate builder.
+func (_u *FilterTranslationUpdateOne) Where(ps ...predicate.FilterTranslation) *FilterTranslationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FilterTranslationUpdateOne) Select(field string, fields ...string) *FilterTranslationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FilterTranslation entity.
+func (_u *FilterTranslationUpdateOne) Save(ctx context.Context) (*FilterTranslation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FilterTranslationUpdateOne) SaveX(ctx context.Context) *FilterTranslation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FilterTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FilterTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FilterTranslationUpdateOne) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := filtertranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "FilterTranslation.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.FilterCleared() && len(_u.mutation.FilterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FilterTranslation.filter"`)
+	}
+	return nil
+}
+
+func (_u *FilterTranslationUpdateOne) sqlSave(ctx context.Context) (_node *FilterTranslation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filtertranslation.Table, filtertranslation.Columns, sqlgraph.NewFieldSpec(filtertranslation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FilterTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, filtertranslation.FieldID)
+		for _, f := range fields {
+			if !filtertranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != filtertranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(filtertranslation.FieldLocale, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(filtertranslation.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(filtertranslation.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hint(); ok {
+		_spec.SetField(filtertranslation.FieldHint, field.TypeString, value)
+	}
+	if _u.mutation.HintCleared() {
+		_spec.ClearField(filtertranslation.FieldHint, field.TypeString)
+	}
+	if value, ok := _u.mutation.Values(); ok {
+		_spec.SetField(filtertranslation.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filtertranslation.FieldValues, value)
+		})
+	}
+	if _u.mutation.ValuesCleared() {
+		_spec.ClearField(filtertranslation.FieldValues, field.TypeJSON)
+	}
+	if _u.mutation.FilterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filtertranslation.FilterTable,
+			Columns: []string{filtertranslation.FilterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filtertranslation.FilterTable,
+			Columns: []string{filtertranslation.FilterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FilterTranslation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filtertranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -57,6 +57,18 @@ func (f BenefitFilterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BenefitFilterMutation", m)
 }
 
+// The BenefitTranslationFunc type is an adapter to allow the use of ordinary
+// function as BenefitTranslation mutator.
+type BenefitTranslationFunc func(context.Context, *ent.BenefitTranslationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BenefitTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BenefitTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BenefitTranslationMutation", m)
+}
+
 // The CategoryFunc type is an adapter to allow the use of ordinary
 // function as Category mutator.
 type CategoryFunc func(context.Context, *ent.CategoryMutation) (ent.Value, error)
@@ -69,6 +81,18 @@ func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
 }
 
+// The CategoryTranslationFunc type is an adapter to allow the use of ordinary
+// function as CategoryTranslation mutator.
+type CategoryTranslationFunc func(context.Context, *ent.CategoryTranslationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryTranslationMutation", m)
+}
+
 // The ChildFunc type is an adapter to allow the use of ordinary
 // function as Child mutator.
 type ChildFunc func(context.Context, *ent.ChildMutation) (ent.Value, error)
@@ -93,6 +117,18 @@ func (f ChildFilterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChildFilterMutation", m)
 }
 
+// The EmailVerificationFunc type is an adapter to allow the use of ordinary
+// function as EmailVerification mutator.
+type EmailVerificationFunc func(context.Context, *ent.EmailVerificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailVerificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailVerificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailVerificationMutation", m)
+}
+
 // The FilterFunc type is an adapter to allow the use of ordinary
 // function as Filter mutator.
 type FilterFunc func(context.Context, *ent.FilterMutation) (ent.Value, error)
@@ -105,6 +141,66 @@ func (f FilterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FilterMutation", m)
 }
 
+// The FilterTranslationFunc type is an adapter to allow the use of ordinary
+// function as FilterTranslation mutator.
+type FilterTranslationFunc func(context.Context, *ent.FilterTranslationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FilterTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FilterTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FilterTranslationMutation", m)
+}
+
+// The OutboxEmailFunc type is an adapter to allow the use of ordinary
+// function as OutboxEmail mutator.
+type OutboxEmailFunc func(context.Context, *ent.OutboxEmailMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxEmailFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutboxEmailMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxEmailMutation", m)
+}
+
+// The RecoveryCodeFunc type is an adapter to allow the use of ordinary
+// function as RecoveryCode mutator.
+type RecoveryCodeFunc func(context.Context, *ent.RecoveryCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecoveryCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecoveryCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecoveryCodeMutation", m)
+}
+
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RefreshToken mutator.
+type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefreshTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefreshTokenMutation", m)
+}
+
+// The RuleGroupFunc type is an adapter to allow the use of ordinary
+// function as RuleGroup mutator.
+type RuleGroupFunc func(context.Context, *ent.RuleGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RuleGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RuleGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RuleGroupMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -13,6 +13,10 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "otp", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString},
+		{Name: "tries", Type: field.TypeInt, Default: 0},
+		{Name: "reset_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
 	}
 	// AttemptsTable holds the schema information for the "attempts" table.
 	AttemptsTable = &schema.Table{
@@ -69,6 +73,7 @@ var (
 		{Name: "to", Type: field.TypeString, Nullable: true},
 		{Name: "benefit_id", Type: field.TypeInt},
 		{Name: "filter_id", Type: field.TypeInt},
+		{Name: "rule_group_id", Type: field.TypeInt, Nullable: true},
 	}
 	// BenefitFiltersTable holds the schema information for the "benefit_filters" table.
 	BenefitFiltersTable = &schema.Table{
@@ -88,6 +93,42 @@ var (
 				RefColumns: []*schema.Column{FiltersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "benefit_filters_rule_groups_benefit_filters",
+				Columns:    []*schema.Column{BenefitFiltersColumns[6]},
+				RefColumns: []*schema.Column{RuleGroupsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// BenefitTranslationsColumns holds the columns for the "benefit_translations" table.
+	BenefitTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "locale", Type: field.TypeEnum, Enums: []string{"kk", "ru"}},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "bonus", Type: field.TypeString, Nullable: true},
+		{Name: "benefit_id", Type: field.TypeInt},
+	}
+	// BenefitTranslationsTable holds the schema information for the "benefit_translations" table.
+	BenefitTranslationsTable = &schema.Table{
+		Name:       "benefit_translations",
+		Columns:    BenefitTranslationsColumns,
+		PrimaryKey: []*schema.Column{BenefitTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "benefit_translations_benefits_translations",
+				Columns:    []*schema.Column{BenefitTranslationsColumns[5]},
+				RefColumns: []*schema.Column{BenefitsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "benefittranslation_benefit_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{BenefitTranslationsColumns[5], BenefitTranslationsColumns[1]},
+			},
 		},
 	}
 	// CategoriesColumns holds the columns for the "categories" table.
@@ -102,6 +143,35 @@ var (
 		Columns:    CategoriesColumns,
 		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
 	}
+	// CategoryTranslationsColumns holds the columns for the "category_translations" table.
+	CategoryTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "locale", Type: field.TypeEnum, Enums: []string{"kk", "ru"}},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "category_id", Type: field.TypeInt},
+	}
+	// CategoryTranslationsTable holds the schema information for the "category_translations" table.
+	CategoryTranslationsTable = &schema.Table{
+		Name:       "category_translations",
+		Columns:    CategoryTranslationsColumns,
+		PrimaryKey: []*schema.Column{CategoryTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_translations_categories_translations",
+				Columns:    []*schema.Column{CategoryTranslationsColumns[4]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorytranslation_category_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryTranslationsColumns[4], CategoryTranslationsColumns[1]},
+			},
+		},
+	}
 	// ChildsColumns holds the columns for the "childs" table.
 	ChildsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -152,6 +222,30 @@ var (
 			},
 		},
 	}
+	// EmailVerificationsColumns holds the columns for the "email_verifications" table.
+	EmailVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "otp", Type: field.TypeString},
+		{Name: "tries", Type: field.TypeInt, Default: 0},
+		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// EmailVerificationsTable holds the schema information for the "email_verifications" table.
+	EmailVerificationsTable = &schema.Table{
+		Name:       "email_verifications",
+		Columns:    EmailVerificationsColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_verifications_users_email_verifications",
+				Columns:    []*schema.Column{EmailVerificationsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// FiltersColumns holds the columns for the "filters" table.
 	FiltersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -159,6 +253,7 @@ var (
 		{Name: "hint", Type: field.TypeString, Nullable: true},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"DATE_RANGE", "NUMBER_RANGE", "STRING_RANGE"}},
 		{Name: "values", Type: field.TypeJSON},
+		{Name: "is_age", Type: field.TypeBool, Default: false},
 	}
 	// FiltersTable holds the schema information for the "filters" table.
 	FiltersTable = &schema.Table{
@@ -166,6 +261,138 @@ var (
 		Columns:    FiltersColumns,
 		PrimaryKey: []*schema.Column{FiltersColumns[0]},
 	}
+	// FilterTranslationsColumns holds the columns for the "filter_translations" table.
+	FilterTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "locale", Type: field.TypeEnum, Enums: []string{"kk", "ru"}},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "hint", Type: field.TypeString, Nullable: true},
+		{Name: "values", Type: field.TypeJSON, Nullable: true},
+		{Name: "filter_id", Type: field.TypeInt},
+	}
+	// FilterTranslationsTable holds the schema information for the "filter_translations" table.
+	FilterTranslationsTable = &schema.Table{
+		Name:       "filter_translations",
+		Columns:    FilterTranslationsColumns,
+		PrimaryKey: []*schema.Column{FilterTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "filter_translations_filters_translations",
+				Columns:    []*schema.Column{FilterTranslationsColumns[5]},
+				RefColumns: []*schema.Column{FiltersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filtertranslation_filter_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{FilterTranslationsColumns[5], FilterTranslationsColumns[1]},
+			},
+		},
+	}
+	// OutboxEmailsColumns holds the columns for the "outbox_emails" table.
+	OutboxEmailsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "recipient", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "text_body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEmailsTable holds the schema information for the "outbox_emails" table.
+	OutboxEmailsTable = &schema.Table{
+		Name:       "outbox_emails",
+		Columns:    OutboxEmailsColumns,
+		PrimaryKey: []*schema.Column{OutboxEmailsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxemail_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEmailsColumns[6], OutboxEmailsColumns[8]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "family_id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RuleGroupsColumns holds the columns for the "rule_groups" table.
+	RuleGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "operator", Type: field.TypeEnum, Enums: []string{"AND", "OR", "NOT"}},
+		{Name: "benefit_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// RuleGroupsTable holds the schema information for the "rule_groups" table.
+	RuleGroupsTable = &schema.Table{
+		Name:       "rule_groups",
+		Columns:    RuleGroupsColumns,
+		PrimaryKey: []*schema.Column{RuleGroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rule_groups_benefits_rule_groups",
+				Columns:    []*schema.Column{RuleGroupsColumns[2]},
+				RefColumns: []*schema.Column{BenefitsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "rule_groups_rule_groups_children",
+				Columns:    []*schema.Column{RuleGroupsColumns[3]},
+				RefColumns: []*schema.Column{RuleGroupsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -173,7 +400,15 @@ var (
 		{Name: "last_name", Type: field.TypeString, Size: 100},
 		{Name: "birth_date", Type: field.TypeTime, Nullable: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "pending_email", Type: field.TypeString, Nullable: true},
 		{Name: "password", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"citizen", "editor", "admin"}, Default: "citizen"},
+		{Name: "locale", Type: field.TypeEnum, Enums: []string{"en", "kk", "ru"}, Default: "en"},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_required", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -215,10 +450,18 @@ var (
 		BenefitsTable,
 		BenefitCategoriesTable,
 		BenefitFiltersTable,
+		BenefitTranslationsTable,
 		CategoriesTable,
+		CategoryTranslationsTable,
 		ChildsTable,
 		ChildFiltersTable,
+		EmailVerificationsTable,
 		FiltersTable,
+		FilterTranslationsTable,
+		OutboxEmailsTable,
+		RecoveryCodesTable,
+		RefreshTokensTable,
+		RuleGroupsTable,
 		UsersTable,
 		UserFiltersTable,
 	}
@@ -229,9 +472,18 @@ func init() {
 	BenefitCategoriesTable.ForeignKeys[1].RefTable = CategoriesTable
 	BenefitFiltersTable.ForeignKeys[0].RefTable = BenefitsTable
 	BenefitFiltersTable.ForeignKeys[1].RefTable = FiltersTable
+	BenefitFiltersTable.ForeignKeys[2].RefTable = RuleGroupsTable
+	BenefitTranslationsTable.ForeignKeys[0].RefTable = BenefitsTable
+	CategoryTranslationsTable.ForeignKeys[0].RefTable = CategoriesTable
 	ChildsTable.ForeignKeys[0].RefTable = UsersTable
 	ChildFiltersTable.ForeignKeys[0].RefTable = ChildsTable
 	ChildFiltersTable.ForeignKeys[1].RefTable = FiltersTable
+	EmailVerificationsTable.ForeignKeys[0].RefTable = UsersTable
+	FilterTranslationsTable.ForeignKeys[0].RefTable = FiltersTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	RuleGroupsTable.ForeignKeys[0].RefTable = BenefitsTable
+	RuleGroupsTable.ForeignKeys[1].RefTable = RuleGroupsTable
 	UserFiltersTable.ForeignKeys[0].RefTable = FiltersTable
 	UserFiltersTable.ForeignKeys[1].RefTable = UsersTable
 }
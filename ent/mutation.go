@@ -15,11 +15,19 @@ import (
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
 	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
 	"github.com/citizenkz/core/ent/child"
 	"github.com/citizenkz/core/ent/childfilter"
+	"github.com/citizenkz/core/ent/emailverification"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+	"github.com/citizenkz/core/ent/outboxemail"
 	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/recoverycode"
+	"github.com/citizenkz/core/ent/refreshtoken"
+	"github.com/citizenkz/core/ent/rulegroup"
 	"github.com/citizenkz/core/ent/user"
 	"github.com/citizenkz/core/ent/userfilter"
 	"github.com/google/uuid"
@@ -34,30 +42,43 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAttempt         = "Attempt"
-	TypeBenefit         = "Benefit"
-	TypeBenefitCategory = "BenefitCategory"
-	TypeBenefitFilter   = "BenefitFilter"
-	TypeCategory        = "Category"
-	TypeChild           = "Child"
-	TypeChildFilter     = "ChildFilter"
-	TypeFilter          = "Filter"
-	TypeUser            = "User"
-	TypeUserFilter      = "UserFilter"
+	TypeAttempt             = "Attempt"
+	TypeBenefit             = "Benefit"
+	TypeBenefitCategory     = "BenefitCategory"
+	TypeBenefitFilter       = "BenefitFilter"
+	TypeBenefitTranslation  = "BenefitTranslation"
+	TypeCategory            = "Category"
+	TypeCategoryTranslation = "CategoryTranslation"
+	TypeChild               = "Child"
+	TypeChildFilter         = "ChildFilter"
+	TypeEmailVerification   = "EmailVerification"
+	TypeFilter              = "Filter"
+	TypeFilterTranslation   = "FilterTranslation"
+	TypeOutboxEmail         = "OutboxEmail"
+	TypeRecoveryCode        = "RecoveryCode"
+	TypeRefreshToken        = "RefreshToken"
+	TypeRuleGroup           = "RuleGroup"
+	TypeUser                = "User"
+	TypeUserFilter          = "UserFilter"
 )
 
 // AttemptMutation represents an operation that mutates the Attempt nodes in the graph.
 type AttemptMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	otp           *string
-	email         *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Attempt, error)
-	predicates    []predicate.Attempt
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	otp              *string
+	email            *string
+	tries            *int
+	addtries         *int
+	reset_token_hash *string
+	verified_at      *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Attempt, error)
+	predicates       []predicate.Attempt
 }
 
 var _ ent.Mutation = (*AttemptMutation)(nil)
@@ -236,6 +257,196 @@ func (m *AttemptMutation) ResetEmail() {
 	m.email = nil
 }
 
+// SetTries sets the "tries" field.
+func (m *AttemptMutation) SetTries(i int) {
+	m.tries = &i
+	m.addtries = nil
+}
+
+// Tries returns the value of the "tries" field in the mutation.
+func (m *AttemptMutation) Tries() (r int, exists bool) {
+	v := m.tries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTries returns the old "tries" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldTries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTries: %w", err)
+	}
+	return oldValue.Tries, nil
+}
+
+// AddTries adds i to the "tries" field.
+func (m *AttemptMutation) AddTries(i int) {
+	if m.addtries != nil {
+		*m.addtries += i
+	} else {
+		m.addtries = &i
+	}
+}
+
+// AddedTries returns the value that was added to the "tries" field in this mutation.
+func (m *AttemptMutation) AddedTries() (r int, exists bool) {
+	v := m.addtries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTries resets all changes to the "tries" field.
+func (m *AttemptMutation) ResetTries() {
+	m.tries = nil
+	m.addtries = nil
+}
+
+// SetResetTokenHash sets the "reset_token_hash" field.
+func (m *AttemptMutation) SetResetTokenHash(s string) {
+	m.reset_token_hash = &s
+}
+
+// ResetTokenHash returns the value of the "reset_token_hash" field in the mutation.
+func (m *AttemptMutation) ResetTokenHash() (r string, exists bool) {
+	v := m.reset_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResetTokenHash returns the old "reset_token_hash" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldResetTokenHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResetTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResetTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResetTokenHash: %w", err)
+	}
+	return oldValue.ResetTokenHash, nil
+}
+
+// ClearResetTokenHash clears the value of the "reset_token_hash" field.
+func (m *AttemptMutation) ClearResetTokenHash() {
+	m.reset_token_hash = nil
+	m.clearedFields[attempt.FieldResetTokenHash] = struct{}{}
+}
+
+// ResetTokenHashCleared returns if the "reset_token_hash" field was cleared in this mutation.
+func (m *AttemptMutation) ResetTokenHashCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldResetTokenHash]
+	return ok
+}
+
+// ResetResetTokenHash resets all changes to the "reset_token_hash" field.
+func (m *AttemptMutation) ResetResetTokenHash() {
+	m.reset_token_hash = nil
+	delete(m.clearedFields, attempt.FieldResetTokenHash)
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (m *AttemptMutation) SetVerifiedAt(t time.Time) {
+	m.verified_at = &t
+}
+
+// VerifiedAt returns the value of the "verified_at" field in the mutation.
+func (m *AttemptMutation) VerifiedAt() (r time.Time, exists bool) {
+	v := m.verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedAt returns the old "verified_at" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedAt: %w", err)
+	}
+	return oldValue.VerifiedAt, nil
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (m *AttemptMutation) ClearVerifiedAt() {
+	m.verified_at = nil
+	m.clearedFields[attempt.FieldVerifiedAt] = struct{}{}
+}
+
+// VerifiedAtCleared returns if the "verified_at" field was cleared in this mutation.
+func (m *AttemptMutation) VerifiedAtCleared() bool {
+	_, ok := m.clearedFields[attempt.FieldVerifiedAt]
+	return ok
+}
+
+// ResetVerifiedAt resets all changes to the "verified_at" field.
+func (m *AttemptMutation) ResetVerifiedAt() {
+	m.verified_at = nil
+	delete(m.clearedFields, attempt.FieldVerifiedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AttemptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AttemptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Attempt entity.
+// If the Attempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttemptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AttemptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
 // Where appends a list predicates to the AttemptMutation builder.
 func (m *AttemptMutation) Where(ps ...predicate.Attempt) {
 	m.predicates = append(m.predicates, ps...)
@@ -270,13 +481,25 @@ func (m *AttemptMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AttemptMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 6)
 	if m.otp != nil {
 		fields = append(fields, attempt.FieldOtp)
 	}
 	if m.email != nil {
 		fields = append(fields, attempt.FieldEmail)
 	}
+	if m.tries != nil {
+		fields = append(fields, attempt.FieldTries)
+	}
+	if m.reset_token_hash != nil {
+		fields = append(fields, attempt.FieldResetTokenHash)
+	}
+	if m.verified_at != nil {
+		fields = append(fields, attempt.FieldVerifiedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, attempt.FieldCreatedAt)
+	}
 	return fields
 }
 
@@ -289,6 +512,14 @@ func (m *AttemptMutation) Field(name string) (ent.Value, bool) {
 		return m.Otp()
 	case attempt.FieldEmail:
 		return m.Email()
+	case attempt.FieldTries:
+		return m.Tries()
+	case attempt.FieldResetTokenHash:
+		return m.ResetTokenHash()
+	case attempt.FieldVerifiedAt:
+		return m.VerifiedAt()
+	case attempt.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -302,6 +533,14 @@ func (m *AttemptMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldOtp(ctx)
 	case attempt.FieldEmail:
 		return m.OldEmail(ctx)
+	case attempt.FieldTries:
+		return m.OldTries(ctx)
+	case attempt.FieldResetTokenHash:
+		return m.OldResetTokenHash(ctx)
+	case attempt.FieldVerifiedAt:
+		return m.OldVerifiedAt(ctx)
+	case attempt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Attempt field %s", name)
 }
@@ -325,6 +564,34 @@ func (m *AttemptMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEmail(v)
 		return nil
+	case attempt.FieldTries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTries(v)
+		return nil
+	case attempt.FieldResetTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResetTokenHash(v)
+		return nil
+	case attempt.FieldVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedAt(v)
+		return nil
+	case attempt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt field %s", name)
 }
@@ -332,13 +599,21 @@ func (m *AttemptMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *AttemptMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addtries != nil {
+		fields = append(fields, attempt.FieldTries)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *AttemptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attempt.FieldTries:
+		return m.AddedTries()
+	}
 	return nil, false
 }
 
@@ -347,6 +622,13 @@ func (m *AttemptMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *AttemptMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case attempt.FieldTries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTries(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt numeric field %s", name)
 }
@@ -354,7 +636,14 @@ func (m *AttemptMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *AttemptMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(attempt.FieldResetTokenHash) {
+		fields = append(fields, attempt.FieldResetTokenHash)
+	}
+	if m.FieldCleared(attempt.FieldVerifiedAt) {
+		fields = append(fields, attempt.FieldVerifiedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -367,6 +656,14 @@ func (m *AttemptMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *AttemptMutation) ClearField(name string) error {
+	switch name {
+	case attempt.FieldResetTokenHash:
+		m.ClearResetTokenHash()
+		return nil
+	case attempt.FieldVerifiedAt:
+		m.ClearVerifiedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Attempt nullable field %s", name)
 }
 
@@ -380,6 +677,18 @@ func (m *AttemptMutation) ResetField(name string) error {
 	case attempt.FieldEmail:
 		m.ResetEmail()
 		return nil
+	case attempt.FieldTries:
+		m.ResetTries()
+		return nil
+	case attempt.FieldResetTokenHash:
+		m.ResetResetTokenHash()
+		return nil
+	case attempt.FieldVerifiedAt:
+		m.ResetVerifiedAt()
+		return nil
+	case attempt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Attempt field %s", name)
 }
@@ -450,6 +759,12 @@ type BenefitMutation struct {
 	benefit_categories        map[int]struct{}
 	removedbenefit_categories map[int]struct{}
 	clearedbenefit_categories bool
+	rule_groups               map[int]struct{}
+	removedrule_groups        map[int]struct{}
+	clearedrule_groups        bool
+	translations              map[int]struct{}
+	removedtranslations       map[int]struct{}
+	clearedtranslations       bool
 	done                      bool
 	oldValue                  func(context.Context) (*Benefit, error)
 	predicates                []predicate.Benefit
@@ -867,6 +1182,114 @@ func (m *BenefitMutation) ResetBenefitCategories() {
 	m.removedbenefit_categories = nil
 }
 
+// AddRuleGroupIDs adds the "rule_groups" edge to the RuleGroup entity by ids.
+func (m *BenefitMutation) AddRuleGroupIDs(ids ...int) {
+	if m.rule_groups == nil {
+		m.rule_groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rule_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRuleGroups clears the "rule_groups" edge to the RuleGroup entity.
+func (m *BenefitMutation) ClearRuleGroups() {
+	m.clearedrule_groups = true
+}
+
+// RuleGroupsCleared reports if the "rule_groups" edge to the RuleGroup entity was cleared.
+func (m *BenefitMutation) RuleGroupsCleared() bool {
+	return m.clearedrule_groups
+}
+
+// RemoveRuleGroupIDs removes the "rule_groups" edge to the RuleGroup entity by IDs.
+func (m *BenefitMutation) RemoveRuleGroupIDs(ids ...int) {
+	if m.removedrule_groups == nil {
+		m.removedrule_groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rule_groups, ids[i])
+		m.removedrule_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuleGroups returns the removed IDs of the "rule_groups" edge to the RuleGroup entity.
+func (m *BenefitMutation) RemovedRuleGroupsIDs() (ids []int) {
+	for id := range m.removedrule_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RuleGroupsIDs returns the "rule_groups" edge IDs in the mutation.
+func (m *BenefitMutation) RuleGroupsIDs() (ids []int) {
+	for id := range m.rule_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuleGroups resets all changes to the "rule_groups" edge.
+func (m *BenefitMutation) ResetRuleGroups() {
+	m.rule_groups = nil
+	m.clearedrule_groups = false
+	m.removedrule_groups = nil
+}
+
+// AddTranslationIDs adds the "translations" edge to the BenefitTranslation entity by ids.
+func (m *BenefitMutation) AddTranslationIDs(ids ...int) {
+	if m.translations == nil {
+		m.translations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.translations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranslations clears the "translations" edge to the BenefitTranslation entity.
+func (m *BenefitMutation) ClearTranslations() {
+	m.clearedtranslations = true
+}
+
+// TranslationsCleared reports if the "translations" edge to the BenefitTranslation entity was cleared.
+func (m *BenefitMutation) TranslationsCleared() bool {
+	return m.clearedtranslations
+}
+
+// RemoveTranslationIDs removes the "translations" edge to the BenefitTranslation entity by IDs.
+func (m *BenefitMutation) RemoveTranslationIDs(ids ...int) {
+	if m.removedtranslations == nil {
+		m.removedtranslations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.translations, ids[i])
+		m.removedtranslations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranslations returns the removed IDs of the "translations" edge to the BenefitTranslation entity.
+func (m *BenefitMutation) RemovedTranslationsIDs() (ids []int) {
+	for id := range m.removedtranslations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranslationsIDs returns the "translations" edge IDs in the mutation.
+func (m *BenefitMutation) TranslationsIDs() (ids []int) {
+	for id := range m.translations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranslations resets all changes to the "translations" edge.
+func (m *BenefitMutation) ResetTranslations() {
+	m.translations = nil
+	m.clearedtranslations = false
+	m.removedtranslations = nil
+}
+
 // Where appends a list predicates to the BenefitMutation builder.
 func (m *BenefitMutation) Where(ps ...predicate.Benefit) {
 	m.predicates = append(m.predicates, ps...)
@@ -1083,13 +1506,19 @@ func (m *BenefitMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *BenefitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.benefit_filters != nil {
 		edges = append(edges, benefit.EdgeBenefitFilters)
 	}
 	if m.benefit_categories != nil {
 		edges = append(edges, benefit.EdgeBenefitCategories)
 	}
+	if m.rule_groups != nil {
+		edges = append(edges, benefit.EdgeRuleGroups)
+	}
+	if m.translations != nil {
+		edges = append(edges, benefit.EdgeTranslations)
+	}
 	return edges
 }
 
@@ -1109,19 +1538,37 @@ func (m *BenefitMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case benefit.EdgeRuleGroups:
+		ids := make([]ent.Value, 0, len(m.rule_groups))
+		for id := range m.rule_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case benefit.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.translations))
+		for id := range m.translations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *BenefitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.removedbenefit_filters != nil {
 		edges = append(edges, benefit.EdgeBenefitFilters)
 	}
 	if m.removedbenefit_categories != nil {
 		edges = append(edges, benefit.EdgeBenefitCategories)
 	}
+	if m.removedrule_groups != nil {
+		edges = append(edges, benefit.EdgeRuleGroups)
+	}
+	if m.removedtranslations != nil {
+		edges = append(edges, benefit.EdgeTranslations)
+	}
 	return edges
 }
 
@@ -1141,19 +1588,37 @@ func (m *BenefitMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case benefit.EdgeRuleGroups:
+		ids := make([]ent.Value, 0, len(m.removedrule_groups))
+		for id := range m.removedrule_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case benefit.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.removedtranslations))
+		for id := range m.removedtranslations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *BenefitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.clearedbenefit_filters {
 		edges = append(edges, benefit.EdgeBenefitFilters)
 	}
 	if m.clearedbenefit_categories {
 		edges = append(edges, benefit.EdgeBenefitCategories)
 	}
+	if m.clearedrule_groups {
+		edges = append(edges, benefit.EdgeRuleGroups)
+	}
+	if m.clearedtranslations {
+		edges = append(edges, benefit.EdgeTranslations)
+	}
 	return edges
 }
 
@@ -1165,6 +1630,10 @@ func (m *BenefitMutation) EdgeCleared(name string) bool {
 		return m.clearedbenefit_filters
 	case benefit.EdgeBenefitCategories:
 		return m.clearedbenefit_categories
+	case benefit.EdgeRuleGroups:
+		return m.clearedrule_groups
+	case benefit.EdgeTranslations:
+		return m.clearedtranslations
 	}
 	return false
 }
@@ -1187,6 +1656,12 @@ func (m *BenefitMutation) ResetEdge(name string) error {
 	case benefit.EdgeBenefitCategories:
 		m.ResetBenefitCategories()
 		return nil
+	case benefit.EdgeRuleGroups:
+		m.ResetRuleGroups()
+		return nil
+	case benefit.EdgeTranslations:
+		m.ResetTranslations()
+		return nil
 	}
 	return fmt.Errorf("unknown Benefit edge %s", name)
 }
@@ -1677,20 +2152,22 @@ func (m *BenefitCategoryMutation) ResetEdge(name string) error {
 // BenefitFilterMutation represents an operation that mutates the BenefitFilter nodes in the graph.
 type BenefitFilterMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	value          *string
-	from           *string
-	to             *string
-	clearedFields  map[string]struct{}
-	benefit        *int
-	clearedbenefit bool
-	filter         *int
-	clearedfilter  bool
-	done           bool
-	oldValue       func(context.Context) (*BenefitFilter, error)
-	predicates     []predicate.BenefitFilter
+	op                Op
+	typ               string
+	id                *int
+	value             *string
+	from              *string
+	to                *string
+	clearedFields     map[string]struct{}
+	benefit           *int
+	clearedbenefit    bool
+	filter            *int
+	clearedfilter     bool
+	rule_group        *int
+	clearedrule_group bool
+	done              bool
+	oldValue          func(context.Context) (*BenefitFilter, error)
+	predicates        []predicate.BenefitFilter
 }
 
 var _ ent.Mutation = (*BenefitFilterMutation)(nil)
@@ -2010,6 +2487,55 @@ func (m *BenefitFilterMutation) ResetTo() {
 	delete(m.clearedFields, benefitfilter.FieldTo)
 }
 
+// SetRuleGroupID sets the "rule_group_id" field.
+func (m *BenefitFilterMutation) SetRuleGroupID(i int) {
+	m.rule_group = &i
+}
+
+// RuleGroupID returns the value of the "rule_group_id" field in the mutation.
+func (m *BenefitFilterMutation) RuleGroupID() (r int, exists bool) {
+	v := m.rule_group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleGroupID returns the old "rule_group_id" field's value of the BenefitFilter entity.
+// If the BenefitFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BenefitFilterMutation) OldRuleGroupID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleGroupID: %w", err)
+	}
+	return oldValue.RuleGroupID, nil
+}
+
+// ClearRuleGroupID clears the value of the "rule_group_id" field.
+func (m *BenefitFilterMutation) ClearRuleGroupID() {
+	m.rule_group = nil
+	m.clearedFields[benefitfilter.FieldRuleGroupID] = struct{}{}
+}
+
+// RuleGroupIDCleared returns if the "rule_group_id" field was cleared in this mutation.
+func (m *BenefitFilterMutation) RuleGroupIDCleared() bool {
+	_, ok := m.clearedFields[benefitfilter.FieldRuleGroupID]
+	return ok
+}
+
+// ResetRuleGroupID resets all changes to the "rule_group_id" field.
+func (m *BenefitFilterMutation) ResetRuleGroupID() {
+	m.rule_group = nil
+	delete(m.clearedFields, benefitfilter.FieldRuleGroupID)
+}
+
 // ClearBenefit clears the "benefit" edge to the Benefit entity.
 func (m *BenefitFilterMutation) ClearBenefit() {
 	m.clearedbenefit = true
@@ -2064,6 +2590,33 @@ func (m *BenefitFilterMutation) ResetFilter() {
 	m.clearedfilter = false
 }
 
+// ClearRuleGroup clears the "rule_group" edge to the RuleGroup entity.
+func (m *BenefitFilterMutation) ClearRuleGroup() {
+	m.clearedrule_group = true
+	m.clearedFields[benefitfilter.FieldRuleGroupID] = struct{}{}
+}
+
+// RuleGroupCleared reports if the "rule_group" edge to the RuleGroup entity was cleared.
+func (m *BenefitFilterMutation) RuleGroupCleared() bool {
+	return m.RuleGroupIDCleared() || m.clearedrule_group
+}
+
+// RuleGroupIDs returns the "rule_group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuleGroupID instead. It exists only for internal usage by the builders.
+func (m *BenefitFilterMutation) RuleGroupIDs() (ids []int) {
+	if id := m.rule_group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRuleGroup resets all changes to the "rule_group" edge.
+func (m *BenefitFilterMutation) ResetRuleGroup() {
+	m.rule_group = nil
+	m.clearedrule_group = false
+}
+
 // Where appends a list predicates to the BenefitFilterMutation builder.
 func (m *BenefitFilterMutation) Where(ps ...predicate.BenefitFilter) {
 	m.predicates = append(m.predicates, ps...)
@@ -2098,7 +2651,7 @@ func (m *BenefitFilterMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BenefitFilterMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.benefit != nil {
 		fields = append(fields, benefitfilter.FieldBenefitID)
 	}
@@ -2114,6 +2667,9 @@ func (m *BenefitFilterMutation) Fields() []string {
 	if m.to != nil {
 		fields = append(fields, benefitfilter.FieldTo)
 	}
+	if m.rule_group != nil {
+		fields = append(fields, benefitfilter.FieldRuleGroupID)
+	}
 	return fields
 }
 
@@ -2132,6 +2688,8 @@ func (m *BenefitFilterMutation) Field(name string) (ent.Value, bool) {
 		return m.From()
 	case benefitfilter.FieldTo:
 		return m.To()
+	case benefitfilter.FieldRuleGroupID:
+		return m.RuleGroupID()
 	}
 	return nil, false
 }
@@ -2151,6 +2709,8 @@ func (m *BenefitFilterMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldFrom(ctx)
 	case benefitfilter.FieldTo:
 		return m.OldTo(ctx)
+	case benefitfilter.FieldRuleGroupID:
+		return m.OldRuleGroupID(ctx)
 	}
 	return nil, fmt.Errorf("unknown BenefitFilter field %s", name)
 }
@@ -2195,6 +2755,13 @@ func (m *BenefitFilterMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTo(v)
 		return nil
+	case benefitfilter.FieldRuleGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleGroupID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown BenefitFilter field %s", name)
 }
@@ -2237,6 +2804,9 @@ func (m *BenefitFilterMutation) ClearedFields() []string {
 	if m.FieldCleared(benefitfilter.FieldTo) {
 		fields = append(fields, benefitfilter.FieldTo)
 	}
+	if m.FieldCleared(benefitfilter.FieldRuleGroupID) {
+		fields = append(fields, benefitfilter.FieldRuleGroupID)
+	}
 	return fields
 }
 
@@ -2260,6 +2830,9 @@ func (m *BenefitFilterMutation) ClearField(name string) error {
 	case benefitfilter.FieldTo:
 		m.ClearTo()
 		return nil
+	case benefitfilter.FieldRuleGroupID:
+		m.ClearRuleGroupID()
+		return nil
 	}
 	return fmt.Errorf("unknown BenefitFilter nullable field %s", name)
 }
@@ -2283,19 +2856,25 @@ func (m *BenefitFilterMutation) ResetField(name string) error {
 	case benefitfilter.FieldTo:
 		m.ResetTo()
 		return nil
+	case benefitfilter.FieldRuleGroupID:
+		m.ResetRuleGroupID()
+		return nil
 	}
 	return fmt.Errorf("unknown BenefitFilter field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *BenefitFilterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.benefit != nil {
 		edges = append(edges, benefitfilter.EdgeBenefit)
 	}
 	if m.filter != nil {
 		edges = append(edges, benefitfilter.EdgeFilter)
 	}
+	if m.rule_group != nil {
+		edges = append(edges, benefitfilter.EdgeRuleGroup)
+	}
 	return edges
 }
 
@@ -2311,13 +2890,17 @@ func (m *BenefitFilterMutation) AddedIDs(name string) []ent.Value {
 		if id := m.filter; id != nil {
 			return []ent.Value{*id}
 		}
+	case benefitfilter.EdgeRuleGroup:
+		if id := m.rule_group; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *BenefitFilterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	return edges
 }
 
@@ -2329,13 +2912,16 @@ func (m *BenefitFilterMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *BenefitFilterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedbenefit {
 		edges = append(edges, benefitfilter.EdgeBenefit)
 	}
 	if m.clearedfilter {
 		edges = append(edges, benefitfilter.EdgeFilter)
 	}
+	if m.clearedrule_group {
+		edges = append(edges, benefitfilter.EdgeRuleGroup)
+	}
 	return edges
 }
 
@@ -2347,6 +2933,8 @@ func (m *BenefitFilterMutation) EdgeCleared(name string) bool {
 		return m.clearedbenefit
 	case benefitfilter.EdgeFilter:
 		return m.clearedfilter
+	case benefitfilter.EdgeRuleGroup:
+		return m.clearedrule_group
 	}
 	return false
 }
@@ -2361,6 +2949,9 @@ func (m *BenefitFilterMutation) ClearEdge(name string) error {
 	case benefitfilter.EdgeFilter:
 		m.ClearFilter()
 		return nil
+	case benefitfilter.EdgeRuleGroup:
+		m.ClearRuleGroup()
+		return nil
 	}
 	return fmt.Errorf("unknown BenefitFilter unique edge %s", name)
 }
@@ -2375,38 +2966,42 @@ func (m *BenefitFilterMutation) ResetEdge(name string) error {
 	case benefitfilter.EdgeFilter:
 		m.ResetFilter()
 		return nil
+	case benefitfilter.EdgeRuleGroup:
+		m.ResetRuleGroup()
+		return nil
 	}
 	return fmt.Errorf("unknown BenefitFilter edge %s", name)
 }
 
-// CategoryMutation represents an operation that mutates the Category nodes in the graph.
-type CategoryMutation struct {
+// BenefitTranslationMutation represents an operation that mutates the BenefitTranslation nodes in the graph.
+type BenefitTranslationMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	name                      *string
-	description               *string
-	clearedFields             map[string]struct{}
-	benefit_categories        map[int]struct{}
-	removedbenefit_categories map[int]struct{}
-	clearedbenefit_categories bool
-	done                      bool
-	oldValue                  func(context.Context) (*Category, error)
-	predicates                []predicate.Category
+	op             Op
+	typ            string
+	id             *int
+	locale         *benefittranslation.Locale
+	title          *string
+	content        *string
+	bonus          *string
+	clearedFields  map[string]struct{}
+	benefit        *int
+	clearedbenefit bool
+	done           bool
+	oldValue       func(context.Context) (*BenefitTranslation, error)
+	predicates     []predicate.BenefitTranslation
 }
 
-var _ ent.Mutation = (*CategoryMutation)(nil)
+var _ ent.Mutation = (*BenefitTranslationMutation)(nil)
 
-// categoryOption allows management of the mutation configuration using functional options.
-type categoryOption func(*CategoryMutation)
+// benefittranslationOption allows management of the mutation configuration using functional options.
+type benefittranslationOption func(*BenefitTranslationMutation)
 
-// newCategoryMutation creates new mutation for the Category entity.
-func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
-	m := &CategoryMutation{
+// newBenefitTranslationMutation creates new mutation for the BenefitTranslation entity.
+func newBenefitTranslationMutation(c config, op Op, opts ...benefittranslationOption) *BenefitTranslationMutation {
+	m := &BenefitTranslationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCategory,
+		typ:           TypeBenefitTranslation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2415,20 +3010,20 @@ func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutat
 	return m
 }
 
-// withCategoryID sets the ID field of the mutation.
-func withCategoryID(id int) categoryOption {
-	return func(m *CategoryMutation) {
+// withBenefitTranslationID sets the ID field of the mutation.
+func withBenefitTranslationID(id int) benefittranslationOption {
+	return func(m *BenefitTranslationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Category
+			value *BenefitTranslation
 		)
-		m.oldValue = func(ctx context.Context) (*Category, error) {
+		m.oldValue = func(ctx context.Context) (*BenefitTranslation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Category.Get(ctx, id)
+					value, err = m.Client().BenefitTranslation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2437,10 +3032,10 @@ func withCategoryID(id int) categoryOption {
 	}
 }
 
-// withCategory sets the old Category of the mutation.
-func withCategory(node *Category) categoryOption {
-	return func(m *CategoryMutation) {
-		m.oldValue = func(context.Context) (*Category, error) {
+// withBenefitTranslation sets the old BenefitTranslation of the mutation.
+func withBenefitTranslation(node *BenefitTranslation) benefittranslationOption {
+	return func(m *BenefitTranslationMutation) {
+		m.oldValue = func(context.Context) (*BenefitTranslation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2449,7 +3044,7 @@ func withCategory(node *Category) categoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CategoryMutation) Client() *Client {
+func (m BenefitTranslationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2457,7 +3052,7 @@ func (m CategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CategoryMutation) Tx() (*Tx, error) {
+func (m BenefitTranslationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2468,7 +3063,7 @@ func (m CategoryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CategoryMutation) ID() (id int, exists bool) {
+func (m *BenefitTranslationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2479,7 +3074,7 @@ func (m *CategoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BenefitTranslationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2488,174 +3083,889 @@ func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BenefitTranslation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *CategoryMutation) SetName(s string) {
-	m.name = &s
+// SetBenefitID sets the "benefit_id" field.
+func (m *BenefitTranslationMutation) SetBenefitID(i int) {
+	m.benefit = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// BenefitID returns the value of the "benefit_id" field in the mutation.
+func (m *BenefitTranslationMutation) BenefitID() (r int, exists bool) {
+	v := m.benefit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldBenefitID returns the old "benefit_id" field's value of the BenefitTranslation entity.
+// If the BenefitTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BenefitTranslationMutation) OldBenefitID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldBenefitID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldBenefitID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldBenefitID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.BenefitID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CategoryMutation) ResetName() {
-	m.name = nil
+// ResetBenefitID resets all changes to the "benefit_id" field.
+func (m *BenefitTranslationMutation) ResetBenefitID() {
+	m.benefit = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *CategoryMutation) SetDescription(s string) {
-	m.description = &s
+// SetLocale sets the "locale" field.
+func (m *BenefitTranslationMutation) SetLocale(b benefittranslation.Locale) {
+	m.locale = &b
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *CategoryMutation) Description() (r string, exists bool) {
-	v := m.description
+// Locale returns the value of the "locale" field in the mutation.
+func (m *BenefitTranslationMutation) Locale() (r benefittranslation.Locale, exists bool) {
+	v := m.locale
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldLocale returns the old "locale" field's value of the BenefitTranslation entity.
+// If the BenefitTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *BenefitTranslationMutation) OldLocale(ctx context.Context) (v benefittranslation.Locale, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLocale requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Locale, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *CategoryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[category.FieldDescription] = struct{}{}
+// ResetLocale resets all changes to the "locale" field.
+func (m *BenefitTranslationMutation) ResetLocale() {
+	m.locale = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *CategoryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[category.FieldDescription]
-	return ok
+// SetTitle sets the "title" field.
+func (m *BenefitTranslationMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *CategoryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, category.FieldDescription)
+// Title returns the value of the "title" field in the mutation.
+func (m *BenefitTranslationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddBenefitCategoryIDs adds the "benefit_categories" edge to the BenefitCategory entity by ids.
-func (m *CategoryMutation) AddBenefitCategoryIDs(ids ...int) {
-	if m.benefit_categories == nil {
-		m.benefit_categories = make(map[int]struct{})
+// OldTitle returns the old "title" field's value of the BenefitTranslation entity.
+// If the BenefitTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BenefitTranslationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.benefit_categories[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
+	return oldValue.Title, nil
 }
 
-// ClearBenefitCategories clears the "benefit_categories" edge to the BenefitCategory entity.
-func (m *CategoryMutation) ClearBenefitCategories() {
-	m.clearedbenefit_categories = true
+// ClearTitle clears the value of the "title" field.
+func (m *BenefitTranslationMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[benefittranslation.FieldTitle] = struct{}{}
 }
 
-// BenefitCategoriesCleared reports if the "benefit_categories" edge to the BenefitCategory entity was cleared.
-func (m *CategoryMutation) BenefitCategoriesCleared() bool {
-	return m.clearedbenefit_categories
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *BenefitTranslationMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[benefittranslation.FieldTitle]
+	return ok
 }
 
-// RemoveBenefitCategoryIDs removes the "benefit_categories" edge to the BenefitCategory entity by IDs.
-func (m *CategoryMutation) RemoveBenefitCategoryIDs(ids ...int) {
-	if m.removedbenefit_categories == nil {
-		m.removedbenefit_categories = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.benefit_categories, ids[i])
-		m.removedbenefit_categories[ids[i]] = struct{}{}
-	}
+// ResetTitle resets all changes to the "title" field.
+func (m *BenefitTranslationMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, benefittranslation.FieldTitle)
 }
 
-// RemovedBenefitCategories returns the removed IDs of the "benefit_categories" edge to the BenefitCategory entity.
-func (m *CategoryMutation) RemovedBenefitCategoriesIDs() (ids []int) {
-	for id := range m.removedbenefit_categories {
-		ids = append(ids, id)
-	}
-	return
+// SetContent sets the "content" field.
+func (m *BenefitTranslationMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// BenefitCategoriesIDs returns the "benefit_categories" edge IDs in the mutation.
-func (m *CategoryMutation) BenefitCategoriesIDs() (ids []int) {
-	for id := range m.benefit_categories {
-		ids = append(ids, id)
+// Content returns the value of the "content" field in the mutation.
+func (m *BenefitTranslationMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetBenefitCategories resets all changes to the "benefit_categories" edge.
-func (m *CategoryMutation) ResetBenefitCategories() {
-	m.benefit_categories = nil
-	m.clearedbenefit_categories = false
-	m.removedbenefit_categories = nil
+// OldContent returns the old "content" field's value of the BenefitTranslation entity.
+// If the BenefitTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BenefitTranslationMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// Where appends a list predicates to the CategoryMutation builder.
-func (m *CategoryMutation) Where(ps ...predicate.Category) {
-	m.predicates = append(m.predicates, ps...)
+// ClearContent clears the value of the "content" field.
+func (m *BenefitTranslationMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[benefittranslation.FieldContent] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Category, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *BenefitTranslationMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[benefittranslation.FieldContent]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *CategoryMutation) Op() Op {
-	return m.op
+// ResetContent resets all changes to the "content" field.
+func (m *BenefitTranslationMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, benefittranslation.FieldContent)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CategoryMutation) SetOp(op Op) {
-	m.op = op
+// SetBonus sets the "bonus" field.
+func (m *BenefitTranslationMutation) SetBonus(s string) {
+	m.bonus = &s
+}
+
+// Bonus returns the value of the "bonus" field in the mutation.
+func (m *BenefitTranslationMutation) Bonus() (r string, exists bool) {
+	v := m.bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBonus returns the old "bonus" field's value of the BenefitTranslation entity.
+// If the BenefitTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BenefitTranslationMutation) OldBonus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBonus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBonus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBonus: %w", err)
+	}
+	return oldValue.Bonus, nil
+}
+
+// ClearBonus clears the value of the "bonus" field.
+func (m *BenefitTranslationMutation) ClearBonus() {
+	m.bonus = nil
+	m.clearedFields[benefittranslation.FieldBonus] = struct{}{}
+}
+
+// BonusCleared returns if the "bonus" field was cleared in this mutation.
+func (m *BenefitTranslationMutation) BonusCleared() bool {
+	_, ok := m.clearedFields[benefittranslation.FieldBonus]
+	return ok
+}
+
+// ResetBonus resets all changes to the "bonus" field.
+func (m *BenefitTranslationMutation) ResetBonus() {
+	m.bonus = nil
+	delete(m.clearedFields, benefittranslation.FieldBonus)
+}
+
+// ClearBenefit clears the "benefit" edge to the Benefit entity.
+func (m *BenefitTranslationMutation) ClearBenefit() {
+	m.clearedbenefit = true
+	m.clearedFields[benefittranslation.FieldBenefitID] = struct{}{}
+}
+
+// BenefitCleared reports if the "benefit" edge to the Benefit entity was cleared.
+func (m *BenefitTranslationMutation) BenefitCleared() bool {
+	return m.clearedbenefit
+}
+
+// BenefitIDs returns the "benefit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BenefitID instead. It exists only for internal usage by the builders.
+func (m *BenefitTranslationMutation) BenefitIDs() (ids []int) {
+	if id := m.benefit; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBenefit resets all changes to the "benefit" edge.
+func (m *BenefitTranslationMutation) ResetBenefit() {
+	m.benefit = nil
+	m.clearedbenefit = false
+}
+
+// Where appends a list predicates to the BenefitTranslationMutation builder.
+func (m *BenefitTranslationMutation) Where(ps ...predicate.BenefitTranslation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BenefitTranslationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BenefitTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BenefitTranslation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BenefitTranslationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BenefitTranslationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BenefitTranslation).
+func (m *BenefitTranslationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BenefitTranslationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.benefit != nil {
+		fields = append(fields, benefittranslation.FieldBenefitID)
+	}
+	if m.locale != nil {
+		fields = append(fields, benefittranslation.FieldLocale)
+	}
+	if m.title != nil {
+		fields = append(fields, benefittranslation.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, benefittranslation.FieldContent)
+	}
+	if m.bonus != nil {
+		fields = append(fields, benefittranslation.FieldBonus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BenefitTranslationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case benefittranslation.FieldBenefitID:
+		return m.BenefitID()
+	case benefittranslation.FieldLocale:
+		return m.Locale()
+	case benefittranslation.FieldTitle:
+		return m.Title()
+	case benefittranslation.FieldContent:
+		return m.Content()
+	case benefittranslation.FieldBonus:
+		return m.Bonus()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BenefitTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case benefittranslation.FieldBenefitID:
+		return m.OldBenefitID(ctx)
+	case benefittranslation.FieldLocale:
+		return m.OldLocale(ctx)
+	case benefittranslation.FieldTitle:
+		return m.OldTitle(ctx)
+	case benefittranslation.FieldContent:
+		return m.OldContent(ctx)
+	case benefittranslation.FieldBonus:
+		return m.OldBonus(ctx)
+	}
+	return nil, fmt.Errorf("unknown BenefitTranslation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BenefitTranslationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case benefittranslation.FieldBenefitID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBenefitID(v)
+		return nil
+	case benefittranslation.FieldLocale:
+		v, ok := value.(benefittranslation.Locale)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case benefittranslation.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case benefittranslation.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case benefittranslation.FieldBonus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBonus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BenefitTranslation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BenefitTranslationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BenefitTranslationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BenefitTranslationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BenefitTranslation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BenefitTranslationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(benefittranslation.FieldTitle) {
+		fields = append(fields, benefittranslation.FieldTitle)
+	}
+	if m.FieldCleared(benefittranslation.FieldContent) {
+		fields = append(fields, benefittranslation.FieldContent)
+	}
+	if m.FieldCleared(benefittranslation.FieldBonus) {
+		fields = append(fields, benefittranslation.FieldBonus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BenefitTranslationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BenefitTranslationMutation) ClearField(name string) error {
+	switch name {
+	case benefittranslation.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case benefittranslation.FieldContent:
+		m.ClearContent()
+		return nil
+	case benefittranslation.FieldBonus:
+		m.ClearBonus()
+		return nil
+	}
+	return fmt.Errorf("unknown BenefitTranslation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BenefitTranslationMutation) ResetField(name string) error {
+	switch name {
+	case benefittranslation.FieldBenefitID:
+		m.ResetBenefitID()
+		return nil
+	case benefittranslation.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case benefittranslation.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case benefittranslation.FieldContent:
+		m.ResetContent()
+		return nil
+	case benefittranslation.FieldBonus:
+		m.ResetBonus()
+		return nil
+	}
+	return fmt.Errorf("unknown BenefitTranslation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BenefitTranslationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.benefit != nil {
+		edges = append(edges, benefittranslation.EdgeBenefit)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BenefitTranslationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case benefittranslation.EdgeBenefit:
+		if id := m.benefit; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BenefitTranslationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BenefitTranslationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BenefitTranslationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbenefit {
+		edges = append(edges, benefittranslation.EdgeBenefit)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BenefitTranslationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case benefittranslation.EdgeBenefit:
+		return m.clearedbenefit
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BenefitTranslationMutation) ClearEdge(name string) error {
+	switch name {
+	case benefittranslation.EdgeBenefit:
+		m.ClearBenefit()
+		return nil
+	}
+	return fmt.Errorf("unknown BenefitTranslation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BenefitTranslationMutation) ResetEdge(name string) error {
+	switch name {
+	case benefittranslation.EdgeBenefit:
+		m.ResetBenefit()
+		return nil
+	}
+	return fmt.Errorf("unknown BenefitTranslation edge %s", name)
+}
+
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	name                      *string
+	description               *string
+	clearedFields             map[string]struct{}
+	benefit_categories        map[int]struct{}
+	removedbenefit_categories map[int]struct{}
+	clearedbenefit_categories bool
+	translations              map[int]struct{}
+	removedtranslations       map[int]struct{}
+	clearedtranslations       bool
+	done                      bool
+	oldValue                  func(context.Context) (*Category, error)
+	predicates                []predicate.Category
+}
+
+var _ ent.Mutation = (*CategoryMutation)(nil)
+
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
+
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id int) categoryOption {
+	return func(m *CategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Category
+		)
+		m.oldValue = func(ctx context.Context) (*Category, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Category.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *CategoryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CategoryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *CategoryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[category.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CategoryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[category.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *CategoryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, category.FieldDescription)
+}
+
+// AddBenefitCategoryIDs adds the "benefit_categories" edge to the BenefitCategory entity by ids.
+func (m *CategoryMutation) AddBenefitCategoryIDs(ids ...int) {
+	if m.benefit_categories == nil {
+		m.benefit_categories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.benefit_categories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBenefitCategories clears the "benefit_categories" edge to the BenefitCategory entity.
+func (m *CategoryMutation) ClearBenefitCategories() {
+	m.clearedbenefit_categories = true
+}
+
+// BenefitCategoriesCleared reports if the "benefit_categories" edge to the BenefitCategory entity was cleared.
+func (m *CategoryMutation) BenefitCategoriesCleared() bool {
+	return m.clearedbenefit_categories
+}
+
+// RemoveBenefitCategoryIDs removes the "benefit_categories" edge to the BenefitCategory entity by IDs.
+func (m *CategoryMutation) RemoveBenefitCategoryIDs(ids ...int) {
+	if m.removedbenefit_categories == nil {
+		m.removedbenefit_categories = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.benefit_categories, ids[i])
+		m.removedbenefit_categories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBenefitCategories returns the removed IDs of the "benefit_categories" edge to the BenefitCategory entity.
+func (m *CategoryMutation) RemovedBenefitCategoriesIDs() (ids []int) {
+	for id := range m.removedbenefit_categories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BenefitCategoriesIDs returns the "benefit_categories" edge IDs in the mutation.
+func (m *CategoryMutation) BenefitCategoriesIDs() (ids []int) {
+	for id := range m.benefit_categories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBenefitCategories resets all changes to the "benefit_categories" edge.
+func (m *CategoryMutation) ResetBenefitCategories() {
+	m.benefit_categories = nil
+	m.clearedbenefit_categories = false
+	m.removedbenefit_categories = nil
+}
+
+// AddTranslationIDs adds the "translations" edge to the CategoryTranslation entity by ids.
+func (m *CategoryMutation) AddTranslationIDs(ids ...int) {
+	if m.translations == nil {
+		m.translations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.translations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranslations clears the "translations" edge to the CategoryTranslation entity.
+func (m *CategoryMutation) ClearTranslations() {
+	m.clearedtranslations = true
+}
+
+// TranslationsCleared reports if the "translations" edge to the CategoryTranslation entity was cleared.
+func (m *CategoryMutation) TranslationsCleared() bool {
+	return m.clearedtranslations
+}
+
+// RemoveTranslationIDs removes the "translations" edge to the CategoryTranslation entity by IDs.
+func (m *CategoryMutation) RemoveTranslationIDs(ids ...int) {
+	if m.removedtranslations == nil {
+		m.removedtranslations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.translations, ids[i])
+		m.removedtranslations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranslations returns the removed IDs of the "translations" edge to the CategoryTranslation entity.
+func (m *CategoryMutation) RemovedTranslationsIDs() (ids []int) {
+	for id := range m.removedtranslations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranslationsIDs returns the "translations" edge IDs in the mutation.
+func (m *CategoryMutation) TranslationsIDs() (ids []int) {
+	for id := range m.translations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranslations resets all changes to the "translations" edge.
+func (m *CategoryMutation) ResetTranslations() {
+	m.translations = nil
+	m.clearedtranslations = false
+	m.removedtranslations = nil
+}
+
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryMutation) SetOp(op Op) {
+	m.op = op
 }
 
 // Type returns the node type of this mutation (Category).
@@ -2666,13 +3976,5015 @@ func (m *CategoryMutation) Type() string {
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, category.FieldName)
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, category.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case category.FieldName:
+		return m.Name()
+	case category.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case category.FieldName:
+		return m.OldName(ctx)
+	case category.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Category field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case category.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case category.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(category.FieldDescription) {
+		fields = append(fields, category.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMutation) ClearField(name string) error {
+	switch name {
+	case category.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Category nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMutation) ResetField(name string) error {
+	switch name {
+	case category.FieldName:
+		m.ResetName()
+		return nil
+	case category.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.benefit_categories != nil {
+		edges = append(edges, category.EdgeBenefitCategories)
+	}
+	if m.translations != nil {
+		edges = append(edges, category.EdgeTranslations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeBenefitCategories:
+		ids := make([]ent.Value, 0, len(m.benefit_categories))
+		for id := range m.benefit_categories {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.translations))
+		for id := range m.translations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbenefit_categories != nil {
+		edges = append(edges, category.EdgeBenefitCategories)
+	}
+	if m.removedtranslations != nil {
+		edges = append(edges, category.EdgeTranslations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeBenefitCategories:
+		ids := make([]ent.Value, 0, len(m.removedbenefit_categories))
+		for id := range m.removedbenefit_categories {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.removedtranslations))
+		for id := range m.removedtranslations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbenefit_categories {
+		edges = append(edges, category.EdgeBenefitCategories)
+	}
+	if m.clearedtranslations {
+		edges = append(edges, category.EdgeTranslations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case category.EdgeBenefitCategories:
+		return m.clearedbenefit_categories
+	case category.EdgeTranslations:
+		return m.clearedtranslations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case category.EdgeBenefitCategories:
+		m.ResetBenefitCategories()
+		return nil
+	case category.EdgeTranslations:
+		m.ResetTranslations()
+		return nil
+	}
+	return fmt.Errorf("unknown Category edge %s", name)
+}
+
+// CategoryTranslationMutation represents an operation that mutates the CategoryTranslation nodes in the graph.
+type CategoryTranslationMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	locale          *categorytranslation.Locale
+	name            *string
+	description     *string
+	clearedFields   map[string]struct{}
+	category        *int
+	clearedcategory bool
+	done            bool
+	oldValue        func(context.Context) (*CategoryTranslation, error)
+	predicates      []predicate.CategoryTranslation
+}
+
+var _ ent.Mutation = (*CategoryTranslationMutation)(nil)
+
+// categorytranslationOption allows management of the mutation configuration using functional options.
+type categorytranslationOption func(*CategoryTranslationMutation)
+
+// newCategoryTranslationMutation creates new mutation for the CategoryTranslation entity.
+func newCategoryTranslationMutation(c config, op Op, opts ...categorytranslationOption) *CategoryTranslationMutation {
+	m := &CategoryTranslationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategoryTranslation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryTranslationID sets the ID field of the mutation.
+func withCategoryTranslationID(id int) categorytranslationOption {
+	return func(m *CategoryTranslationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CategoryTranslation
+		)
+		m.oldValue = func(ctx context.Context) (*CategoryTranslation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CategoryTranslation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategoryTranslation sets the old CategoryTranslation of the mutation.
+func withCategoryTranslation(node *CategoryTranslation) categorytranslationOption {
+	return func(m *CategoryTranslationMutation) {
+		m.oldValue = func(context.Context) (*CategoryTranslation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryTranslationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryTranslationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryTranslationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryTranslationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CategoryTranslation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *CategoryTranslationMutation) SetCategoryID(i int) {
+	m.category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *CategoryTranslationMutation) CategoryID() (r int, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the CategoryTranslation entity.
+// If the CategoryTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryTranslationMutation) OldCategoryID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *CategoryTranslationMutation) ResetCategoryID() {
+	m.category = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *CategoryTranslationMutation) SetLocale(c categorytranslation.Locale) {
+	m.locale = &c
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *CategoryTranslationMutation) Locale() (r categorytranslation.Locale, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the CategoryTranslation entity.
+// If the CategoryTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryTranslationMutation) OldLocale(ctx context.Context) (v categorytranslation.Locale, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *CategoryTranslationMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetName sets the "name" field.
+func (m *CategoryTranslationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryTranslationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CategoryTranslation entity.
+// If the CategoryTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryTranslationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *CategoryTranslationMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[categorytranslation.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *CategoryTranslationMutation) NameCleared() bool {
+	_, ok := m.clearedFields[categorytranslation.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryTranslationMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, categorytranslation.FieldName)
+}
+
+// SetDescription sets the "description" field.
+func (m *CategoryTranslationMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CategoryTranslationMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the CategoryTranslation entity.
+// If the CategoryTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryTranslationMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *CategoryTranslationMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[categorytranslation.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CategoryTranslationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[categorytranslation.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *CategoryTranslationMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, categorytranslation.FieldDescription)
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *CategoryTranslationMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[categorytranslation.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *CategoryTranslationMutation) CategoryCleared() bool {
+	return m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *CategoryTranslationMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *CategoryTranslationMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// Where appends a list predicates to the CategoryTranslationMutation builder.
+func (m *CategoryTranslationMutation) Where(ps ...predicate.CategoryTranslation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryTranslationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CategoryTranslation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryTranslationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryTranslationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CategoryTranslation).
+func (m *CategoryTranslationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryTranslationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.category != nil {
+		fields = append(fields, categorytranslation.FieldCategoryID)
+	}
+	if m.locale != nil {
+		fields = append(fields, categorytranslation.FieldLocale)
+	}
+	if m.name != nil {
+		fields = append(fields, categorytranslation.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, categorytranslation.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryTranslationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case categorytranslation.FieldCategoryID:
+		return m.CategoryID()
+	case categorytranslation.FieldLocale:
+		return m.Locale()
+	case categorytranslation.FieldName:
+		return m.Name()
+	case categorytranslation.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case categorytranslation.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case categorytranslation.FieldLocale:
+		return m.OldLocale(ctx)
+	case categorytranslation.FieldName:
+		return m.OldName(ctx)
+	case categorytranslation.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown CategoryTranslation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryTranslationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case categorytranslation.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	case categorytranslation.FieldLocale:
+		v, ok := value.(categorytranslation.Locale)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case categorytranslation.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case categorytranslation.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryTranslation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryTranslationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryTranslationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryTranslationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CategoryTranslation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryTranslationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(categorytranslation.FieldName) {
+		fields = append(fields, categorytranslation.FieldName)
+	}
+	if m.FieldCleared(categorytranslation.FieldDescription) {
+		fields = append(fields, categorytranslation.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryTranslationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryTranslationMutation) ClearField(name string) error {
+	switch name {
+	case categorytranslation.FieldName:
+		m.ClearName()
+		return nil
+	case categorytranslation.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryTranslation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryTranslationMutation) ResetField(name string) error {
+	switch name {
+	case categorytranslation.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case categorytranslation.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case categorytranslation.FieldName:
+		m.ResetName()
+		return nil
+	case categorytranslation.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryTranslation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryTranslationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.category != nil {
+		edges = append(edges, categorytranslation.EdgeCategory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryTranslationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case categorytranslation.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryTranslationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryTranslationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryTranslationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcategory {
+		edges = append(edges, categorytranslation.EdgeCategory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryTranslationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case categorytranslation.EdgeCategory:
+		return m.clearedcategory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryTranslationMutation) ClearEdge(name string) error {
+	switch name {
+	case categorytranslation.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryTranslation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryTranslationMutation) ResetEdge(name string) error {
+	switch name {
+	case categorytranslation.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryTranslation edge %s", name)
+}
+
+// ChildMutation represents an operation that mutates the Child nodes in the graph.
+type ChildMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	first_name           *string
+	last_name            *string
+	birth_date           *time.Time
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	user                 *int
+	cleareduser          bool
+	child_filters        map[int]struct{}
+	removedchild_filters map[int]struct{}
+	clearedchild_filters bool
+	done                 bool
+	oldValue             func(context.Context) (*Child, error)
+	predicates           []predicate.Child
+}
+
+var _ ent.Mutation = (*ChildMutation)(nil)
+
+// childOption allows management of the mutation configuration using functional options.
+type childOption func(*ChildMutation)
+
+// newChildMutation creates new mutation for the Child entity.
+func newChildMutation(c config, op Op, opts ...childOption) *ChildMutation {
+	m := &ChildMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChild,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChildID sets the ID field of the mutation.
+func withChildID(id int) childOption {
+	return func(m *ChildMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Child
+		)
+		m.oldValue = func(ctx context.Context) (*Child, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Child.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChild sets the old Child of the mutation.
+func withChild(node *Child) childOption {
+	return func(m *ChildMutation) {
+		m.oldValue = func(context.Context) (*Child, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChildMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChildMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChildMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChildMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Child.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *ChildMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *ChildMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the Child entity.
+// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildMutation) OldFirstName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *ChildMutation) ResetFirstName() {
+	m.first_name = nil
+}
+
+// SetLastName sets the "last_name" field.
+func (m *ChildMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *ChildMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the Child entity.
+// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildMutation) OldLastName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *ChildMutation) ResetLastName() {
+	m.last_name = nil
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (m *ChildMutation) SetBirthDate(t time.Time) {
+	m.birth_date = &t
+}
+
+// BirthDate returns the value of the "birth_date" field in the mutation.
+func (m *ChildMutation) BirthDate() (r time.Time, exists bool) {
+	v := m.birth_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthDate returns the old "birth_date" field's value of the Child entity.
+// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildMutation) OldBirthDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthDate: %w", err)
+	}
+	return oldValue.BirthDate, nil
+}
+
+// ResetBirthDate resets all changes to the "birth_date" field.
+func (m *ChildMutation) ResetBirthDate() {
+	m.birth_date = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ChildMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ChildMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Child entity.
+// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ChildMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChildMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChildMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Child entity.
+// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChildMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ChildMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[child.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ChildMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ChildMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ChildMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddChildFilterIDs adds the "child_filters" edge to the ChildFilter entity by ids.
+func (m *ChildMutation) AddChildFilterIDs(ids ...int) {
+	if m.child_filters == nil {
+		m.child_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.child_filters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildFilters clears the "child_filters" edge to the ChildFilter entity.
+func (m *ChildMutation) ClearChildFilters() {
+	m.clearedchild_filters = true
+}
+
+// ChildFiltersCleared reports if the "child_filters" edge to the ChildFilter entity was cleared.
+func (m *ChildMutation) ChildFiltersCleared() bool {
+	return m.clearedchild_filters
+}
+
+// RemoveChildFilterIDs removes the "child_filters" edge to the ChildFilter entity by IDs.
+func (m *ChildMutation) RemoveChildFilterIDs(ids ...int) {
+	if m.removedchild_filters == nil {
+		m.removedchild_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.child_filters, ids[i])
+		m.removedchild_filters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildFilters returns the removed IDs of the "child_filters" edge to the ChildFilter entity.
+func (m *ChildMutation) RemovedChildFiltersIDs() (ids []int) {
+	for id := range m.removedchild_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildFiltersIDs returns the "child_filters" edge IDs in the mutation.
+func (m *ChildMutation) ChildFiltersIDs() (ids []int) {
+	for id := range m.child_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildFilters resets all changes to the "child_filters" edge.
+func (m *ChildMutation) ResetChildFilters() {
+	m.child_filters = nil
+	m.clearedchild_filters = false
+	m.removedchild_filters = nil
+}
+
+// Where appends a list predicates to the ChildMutation builder.
+func (m *ChildMutation) Where(ps ...predicate.Child) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Child, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Child).
+func (m *ChildMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChildMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.first_name != nil {
+		fields = append(fields, child.FieldFirstName)
+	}
+	if m.last_name != nil {
+		fields = append(fields, child.FieldLastName)
+	}
+	if m.birth_date != nil {
+		fields = append(fields, child.FieldBirthDate)
+	}
+	if m.user != nil {
+		fields = append(fields, child.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, child.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case child.FieldFirstName:
+		return m.FirstName()
+	case child.FieldLastName:
+		return m.LastName()
+	case child.FieldBirthDate:
+		return m.BirthDate()
+	case child.FieldUserID:
+		return m.UserID()
+	case child.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case child.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case child.FieldLastName:
+		return m.OldLastName(ctx)
+	case child.FieldBirthDate:
+		return m.OldBirthDate(ctx)
+	case child.FieldUserID:
+		return m.OldUserID(ctx)
+	case child.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Child field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case child.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case child.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case child.FieldBirthDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBirthDate(v)
+		return nil
+	case child.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case child.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Child field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChildMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChildMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Child numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChildMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChildMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Child nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChildMutation) ResetField(name string) error {
+	switch name {
+	case child.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case child.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case child.FieldBirthDate:
+		m.ResetBirthDate()
+		return nil
+	case child.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case child.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Child field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, child.EdgeUser)
+	}
+	if m.child_filters != nil {
+		edges = append(edges, child.EdgeChildFilters)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case child.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case child.EdgeChildFilters:
+		ids := make([]ent.Value, 0, len(m.child_filters))
+		for id := range m.child_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchild_filters != nil {
+		edges = append(edges, child.EdgeChildFilters)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChildMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case child.EdgeChildFilters:
+		ids := make([]ent.Value, 0, len(m.removedchild_filters))
+		for id := range m.removedchild_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, child.EdgeUser)
+	}
+	if m.clearedchild_filters {
+		edges = append(edges, child.EdgeChildFilters)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChildMutation) EdgeCleared(name string) bool {
+	switch name {
+	case child.EdgeUser:
+		return m.cleareduser
+	case child.EdgeChildFilters:
+		return m.clearedchild_filters
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChildMutation) ClearEdge(name string) error {
+	switch name {
+	case child.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Child unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChildMutation) ResetEdge(name string) error {
+	switch name {
+	case child.EdgeUser:
+		m.ResetUser()
+		return nil
+	case child.EdgeChildFilters:
+		m.ResetChildFilters()
+		return nil
+	}
+	return fmt.Errorf("unknown Child edge %s", name)
+}
+
+// ChildFilterMutation represents an operation that mutates the ChildFilter nodes in the graph.
+type ChildFilterMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	value         *string
+	clearedFields map[string]struct{}
+	child         *int
+	clearedchild  bool
+	filter        *int
+	clearedfilter bool
+	done          bool
+	oldValue      func(context.Context) (*ChildFilter, error)
+	predicates    []predicate.ChildFilter
+}
+
+var _ ent.Mutation = (*ChildFilterMutation)(nil)
+
+// childfilterOption allows management of the mutation configuration using functional options.
+type childfilterOption func(*ChildFilterMutation)
+
+// newChildFilterMutation creates new mutation for the ChildFilter entity.
+func newChildFilterMutation(c config, op Op, opts ...childfilterOption) *ChildFilterMutation {
+	m := &ChildFilterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChildFilter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChildFilterID sets the ID field of the mutation.
+func withChildFilterID(id int) childfilterOption {
+	return func(m *ChildFilterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChildFilter
+		)
+		m.oldValue = func(ctx context.Context) (*ChildFilter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChildFilter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChildFilter sets the old ChildFilter of the mutation.
+func withChildFilter(node *ChildFilter) childfilterOption {
+	return func(m *ChildFilterMutation) {
+		m.oldValue = func(context.Context) (*ChildFilter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChildFilterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChildFilterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChildFilterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChildFilterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChildFilter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChildID sets the "child_id" field.
+func (m *ChildFilterMutation) SetChildID(i int) {
+	m.child = &i
+}
+
+// ChildID returns the value of the "child_id" field in the mutation.
+func (m *ChildFilterMutation) ChildID() (r int, exists bool) {
+	v := m.child
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChildID returns the old "child_id" field's value of the ChildFilter entity.
+// If the ChildFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildFilterMutation) OldChildID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChildID: %w", err)
+	}
+	return oldValue.ChildID, nil
+}
+
+// ResetChildID resets all changes to the "child_id" field.
+func (m *ChildFilterMutation) ResetChildID() {
+	m.child = nil
+}
+
+// SetFilterID sets the "filter_id" field.
+func (m *ChildFilterMutation) SetFilterID(i int) {
+	m.filter = &i
+}
+
+// FilterID returns the value of the "filter_id" field in the mutation.
+func (m *ChildFilterMutation) FilterID() (r int, exists bool) {
+	v := m.filter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilterID returns the old "filter_id" field's value of the ChildFilter entity.
+// If the ChildFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildFilterMutation) OldFilterID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilterID: %w", err)
+	}
+	return oldValue.FilterID, nil
+}
+
+// ResetFilterID resets all changes to the "filter_id" field.
+func (m *ChildFilterMutation) ResetFilterID() {
+	m.filter = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ChildFilterMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ChildFilterMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the ChildFilter entity.
+// If the ChildFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChildFilterMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ChildFilterMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearChild clears the "child" edge to the Child entity.
+func (m *ChildFilterMutation) ClearChild() {
+	m.clearedchild = true
+	m.clearedFields[childfilter.FieldChildID] = struct{}{}
+}
+
+// ChildCleared reports if the "child" edge to the Child entity was cleared.
+func (m *ChildFilterMutation) ChildCleared() bool {
+	return m.clearedchild
+}
+
+// ChildIDs returns the "child" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChildID instead. It exists only for internal usage by the builders.
+func (m *ChildFilterMutation) ChildIDs() (ids []int) {
+	if id := m.child; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChild resets all changes to the "child" edge.
+func (m *ChildFilterMutation) ResetChild() {
+	m.child = nil
+	m.clearedchild = false
+}
+
+// ClearFilter clears the "filter" edge to the Filter entity.
+func (m *ChildFilterMutation) ClearFilter() {
+	m.clearedfilter = true
+	m.clearedFields[childfilter.FieldFilterID] = struct{}{}
+}
+
+// FilterCleared reports if the "filter" edge to the Filter entity was cleared.
+func (m *ChildFilterMutation) FilterCleared() bool {
+	return m.clearedfilter
+}
+
+// FilterIDs returns the "filter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FilterID instead. It exists only for internal usage by the builders.
+func (m *ChildFilterMutation) FilterIDs() (ids []int) {
+	if id := m.filter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFilter resets all changes to the "filter" edge.
+func (m *ChildFilterMutation) ResetFilter() {
+	m.filter = nil
+	m.clearedfilter = false
+}
+
+// Where appends a list predicates to the ChildFilterMutation builder.
+func (m *ChildFilterMutation) Where(ps ...predicate.ChildFilter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChildFilterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChildFilterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChildFilter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChildFilterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChildFilterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChildFilter).
+func (m *ChildFilterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChildFilterMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.child != nil {
+		fields = append(fields, childfilter.FieldChildID)
+	}
+	if m.filter != nil {
+		fields = append(fields, childfilter.FieldFilterID)
+	}
+	if m.value != nil {
+		fields = append(fields, childfilter.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChildFilterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case childfilter.FieldChildID:
+		return m.ChildID()
+	case childfilter.FieldFilterID:
+		return m.FilterID()
+	case childfilter.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChildFilterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case childfilter.FieldChildID:
+		return m.OldChildID(ctx)
+	case childfilter.FieldFilterID:
+		return m.OldFilterID(ctx)
+	case childfilter.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChildFilter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChildFilterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case childfilter.FieldChildID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChildID(v)
+		return nil
+	case childfilter.FieldFilterID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilterID(v)
+		return nil
+	case childfilter.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChildFilter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChildFilterMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChildFilterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChildFilterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ChildFilter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChildFilterMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChildFilterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChildFilterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChildFilter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChildFilterMutation) ResetField(name string) error {
+	switch name {
+	case childfilter.FieldChildID:
+		m.ResetChildID()
+		return nil
+	case childfilter.FieldFilterID:
+		m.ResetFilterID()
+		return nil
+	case childfilter.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ChildFilter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChildFilterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.child != nil {
+		edges = append(edges, childfilter.EdgeChild)
+	}
+	if m.filter != nil {
+		edges = append(edges, childfilter.EdgeFilter)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChildFilterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case childfilter.EdgeChild:
+		if id := m.child; id != nil {
+			return []ent.Value{*id}
+		}
+	case childfilter.EdgeFilter:
+		if id := m.filter; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChildFilterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChildFilterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChildFilterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedchild {
+		edges = append(edges, childfilter.EdgeChild)
+	}
+	if m.clearedfilter {
+		edges = append(edges, childfilter.EdgeFilter)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChildFilterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case childfilter.EdgeChild:
+		return m.clearedchild
+	case childfilter.EdgeFilter:
+		return m.clearedfilter
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChildFilterMutation) ClearEdge(name string) error {
+	switch name {
+	case childfilter.EdgeChild:
+		m.ClearChild()
+		return nil
+	case childfilter.EdgeFilter:
+		m.ClearFilter()
+		return nil
+	}
+	return fmt.Errorf("unknown ChildFilter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChildFilterMutation) ResetEdge(name string) error {
+	switch name {
+	case childfilter.EdgeChild:
+		m.ResetChild()
+		return nil
+	case childfilter.EdgeFilter:
+		m.ResetFilter()
+		return nil
+	}
+	return fmt.Errorf("unknown ChildFilter edge %s", name)
+}
+
+// EmailVerificationMutation represents an operation that mutates the EmailVerification nodes in the graph.
+type EmailVerificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	email         *string
+	otp           *string
+	tries         *int
+	addtries      *int
+	sent_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*EmailVerification, error)
+	predicates    []predicate.EmailVerification
+}
+
+var _ ent.Mutation = (*EmailVerificationMutation)(nil)
+
+// emailverificationOption allows management of the mutation configuration using functional options.
+type emailverificationOption func(*EmailVerificationMutation)
+
+// newEmailVerificationMutation creates new mutation for the EmailVerification entity.
+func newEmailVerificationMutation(c config, op Op, opts ...emailverificationOption) *EmailVerificationMutation {
+	m := &EmailVerificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailVerification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailVerificationID sets the ID field of the mutation.
+func withEmailVerificationID(id int) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailVerification
+		)
+		m.oldValue = func(ctx context.Context) (*EmailVerification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailVerification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailVerification sets the old EmailVerification of the mutation.
+func withEmailVerification(node *EmailVerification) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		m.oldValue = func(context.Context) (*EmailVerification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailVerificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailVerificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailVerificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailVerificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailVerification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *EmailVerificationMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EmailVerificationMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EmailVerificationMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *EmailVerificationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailVerificationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailVerificationMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetOtp sets the "otp" field.
+func (m *EmailVerificationMutation) SetOtp(s string) {
+	m.otp = &s
+}
+
+// Otp returns the value of the "otp" field in the mutation.
+func (m *EmailVerificationMutation) Otp() (r string, exists bool) {
+	v := m.otp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtp returns the old "otp" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldOtp(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtp: %w", err)
+	}
+	return oldValue.Otp, nil
+}
+
+// ResetOtp resets all changes to the "otp" field.
+func (m *EmailVerificationMutation) ResetOtp() {
+	m.otp = nil
+}
+
+// SetTries sets the "tries" field.
+func (m *EmailVerificationMutation) SetTries(i int) {
+	m.tries = &i
+	m.addtries = nil
+}
+
+// Tries returns the value of the "tries" field in the mutation.
+func (m *EmailVerificationMutation) Tries() (r int, exists bool) {
+	v := m.tries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTries returns the old "tries" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldTries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTries: %w", err)
+	}
+	return oldValue.Tries, nil
+}
+
+// AddTries adds i to the "tries" field.
+func (m *EmailVerificationMutation) AddTries(i int) {
+	if m.addtries != nil {
+		*m.addtries += i
+	} else {
+		m.addtries = &i
+	}
+}
+
+// AddedTries returns the value that was added to the "tries" field in this mutation.
+func (m *EmailVerificationMutation) AddedTries() (r int, exists bool) {
+	v := m.addtries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTries resets all changes to the "tries" field.
+func (m *EmailVerificationMutation) ResetTries() {
+	m.tries = nil
+	m.addtries = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *EmailVerificationMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *EmailVerificationMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *EmailVerificationMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailVerificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailVerificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailVerificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *EmailVerificationMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[emailverification.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EmailVerificationMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EmailVerificationMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *EmailVerificationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the EmailVerificationMutation builder.
+func (m *EmailVerificationMutation) Where(ps ...predicate.EmailVerification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailVerificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailVerification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailVerificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailVerificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailVerification).
+func (m *EmailVerificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user != nil {
+		fields = append(fields, emailverification.FieldUserID)
+	}
+	if m.email != nil {
+		fields = append(fields, emailverification.FieldEmail)
+	}
+	if m.otp != nil {
+		fields = append(fields, emailverification.FieldOtp)
+	}
+	if m.tries != nil {
+		fields = append(fields, emailverification.FieldTries)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, emailverification.FieldSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, emailverification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailverification.FieldUserID:
+		return m.UserID()
+	case emailverification.FieldEmail:
+		return m.Email()
+	case emailverification.FieldOtp:
+		return m.Otp()
+	case emailverification.FieldTries:
+		return m.Tries()
+	case emailverification.FieldSentAt:
+		return m.SentAt()
+	case emailverification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailverification.FieldUserID:
+		return m.OldUserID(ctx)
+	case emailverification.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailverification.FieldOtp:
+		return m.OldOtp(ctx)
+	case emailverification.FieldTries:
+		return m.OldTries(ctx)
+	case emailverification.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case emailverification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailverification.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case emailverification.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case emailverification.FieldOtp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOtp(v)
+		return nil
+	case emailverification.FieldTries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTries(v)
+		return nil
+	case emailverification.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case emailverification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailVerificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtries != nil {
+		fields = append(fields, emailverification.FieldTries)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case emailverification.FieldTries:
+		return m.AddedTries()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case emailverification.FieldTries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTries(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailVerificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EmailVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ResetField(name string) error {
+	switch name {
+	case emailverification.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case emailverification.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case emailverification.FieldOtp:
+		m.ResetOtp()
+		return nil
+	case emailverification.FieldTries:
+		m.ResetTries()
+		return nil
+	case emailverification.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case emailverification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, emailverification.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailVerificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case emailverification.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailVerificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, emailverification.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailVerificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case emailverification.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailVerificationMutation) ClearEdge(name string) error {
+	switch name {
+	case emailverification.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailVerificationMutation) ResetEdge(name string) error {
+	switch name {
+	case emailverification.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification edge %s", name)
+}
+
+// FilterMutation represents an operation that mutates the Filter nodes in the graph.
+type FilterMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	name                   *string
+	hint                   *string
+	_type                  *filter.Type
+	values                 *[]string
+	appendvalues           []string
+	is_age                 *bool
+	clearedFields          map[string]struct{}
+	user_filters           map[int]struct{}
+	removeduser_filters    map[int]struct{}
+	cleareduser_filters    bool
+	benefit_filters        map[int]struct{}
+	removedbenefit_filters map[int]struct{}
+	clearedbenefit_filters bool
+	child_filters          map[int]struct{}
+	removedchild_filters   map[int]struct{}
+	clearedchild_filters   bool
+	translations           map[int]struct{}
+	removedtranslations    map[int]struct{}
+	clearedtranslations    bool
+	done                   bool
+	oldValue               func(context.Context) (*Filter, error)
+	predicates             []predicate.Filter
+}
+
+var _ ent.Mutation = (*FilterMutation)(nil)
+
+// filterOption allows management of the mutation configuration using functional options.
+type filterOption func(*FilterMutation)
+
+// newFilterMutation creates new mutation for the Filter entity.
+func newFilterMutation(c config, op Op, opts ...filterOption) *FilterMutation {
+	m := &FilterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFilter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFilterID sets the ID field of the mutation.
+func withFilterID(id int) filterOption {
+	return func(m *FilterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Filter
+		)
+		m.oldValue = func(ctx context.Context) (*Filter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Filter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFilter sets the old Filter of the mutation.
+func withFilter(node *Filter) filterOption {
+	return func(m *FilterMutation) {
+		m.oldValue = func(context.Context) (*Filter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FilterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FilterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FilterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FilterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Filter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FilterMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FilterMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Filter entity.
+// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FilterMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHint sets the "hint" field.
+func (m *FilterMutation) SetHint(s string) {
+	m.hint = &s
+}
+
+// Hint returns the value of the "hint" field in the mutation.
+func (m *FilterMutation) Hint() (r string, exists bool) {
+	v := m.hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHint returns the old "hint" field's value of the Filter entity.
+// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterMutation) OldHint(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+	}
+	return oldValue.Hint, nil
+}
+
+// ClearHint clears the value of the "hint" field.
+func (m *FilterMutation) ClearHint() {
+	m.hint = nil
+	m.clearedFields[filter.FieldHint] = struct{}{}
+}
+
+// HintCleared returns if the "hint" field was cleared in this mutation.
+func (m *FilterMutation) HintCleared() bool {
+	_, ok := m.clearedFields[filter.FieldHint]
+	return ok
+}
+
+// ResetHint resets all changes to the "hint" field.
+func (m *FilterMutation) ResetHint() {
+	m.hint = nil
+	delete(m.clearedFields, filter.FieldHint)
+}
+
+// SetType sets the "type" field.
+func (m *FilterMutation) SetType(f filter.Type) {
+	m._type = &f
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *FilterMutation) GetType() (r filter.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Filter entity.
+// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterMutation) OldType(ctx context.Context) (v filter.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *FilterMutation) ResetType() {
+	m._type = nil
+}
+
+// SetValues sets the "values" field.
+func (m *FilterMutation) SetValues(s []string) {
+	m.values = &s
+	m.appendvalues = nil
+}
+
+// Values returns the value of the "values" field in the mutation.
+func (m *FilterMutation) Values() (r []string, exists bool) {
+	v := m.values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValues returns the old "values" field's value of the Filter entity.
+// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterMutation) OldValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValues: %w", err)
+	}
+	return oldValue.Values, nil
+}
+
+// AppendValues adds s to the "values" field.
+func (m *FilterMutation) AppendValues(s []string) {
+	m.appendvalues = append(m.appendvalues, s...)
+}
+
+// AppendedValues returns the list of values that were appended to the "values" field in this mutation.
+func (m *FilterMutation) AppendedValues() ([]string, bool) {
+	if len(m.appendvalues) == 0 {
+		return nil, false
+	}
+	return m.appendvalues, true
+}
+
+// ResetValues resets all changes to the "values" field.
+func (m *FilterMutation) ResetValues() {
+	m.values = nil
+	m.appendvalues = nil
+}
+
+// SetIsAge sets the "is_age" field.
+func (m *FilterMutation) SetIsAge(b bool) {
+	m.is_age = &b
+}
+
+// IsAge returns the value of the "is_age" field in the mutation.
+func (m *FilterMutation) IsAge() (r bool, exists bool) {
+	v := m.is_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAge returns the old "is_age" field's value of the Filter entity.
+// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterMutation) OldIsAge(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAge: %w", err)
+	}
+	return oldValue.IsAge, nil
+}
+
+// ResetIsAge resets all changes to the "is_age" field.
+func (m *FilterMutation) ResetIsAge() {
+	m.is_age = nil
+}
+
+// AddUserFilterIDs adds the "user_filters" edge to the UserFilter entity by ids.
+func (m *FilterMutation) AddUserFilterIDs(ids ...int) {
+	if m.user_filters == nil {
+		m.user_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_filters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserFilters clears the "user_filters" edge to the UserFilter entity.
+func (m *FilterMutation) ClearUserFilters() {
+	m.cleareduser_filters = true
+}
+
+// UserFiltersCleared reports if the "user_filters" edge to the UserFilter entity was cleared.
+func (m *FilterMutation) UserFiltersCleared() bool {
+	return m.cleareduser_filters
+}
+
+// RemoveUserFilterIDs removes the "user_filters" edge to the UserFilter entity by IDs.
+func (m *FilterMutation) RemoveUserFilterIDs(ids ...int) {
+	if m.removeduser_filters == nil {
+		m.removeduser_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_filters, ids[i])
+		m.removeduser_filters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserFilters returns the removed IDs of the "user_filters" edge to the UserFilter entity.
+func (m *FilterMutation) RemovedUserFiltersIDs() (ids []int) {
+	for id := range m.removeduser_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserFiltersIDs returns the "user_filters" edge IDs in the mutation.
+func (m *FilterMutation) UserFiltersIDs() (ids []int) {
+	for id := range m.user_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserFilters resets all changes to the "user_filters" edge.
+func (m *FilterMutation) ResetUserFilters() {
+	m.user_filters = nil
+	m.cleareduser_filters = false
+	m.removeduser_filters = nil
+}
+
+// AddBenefitFilterIDs adds the "benefit_filters" edge to the BenefitFilter entity by ids.
+func (m *FilterMutation) AddBenefitFilterIDs(ids ...int) {
+	if m.benefit_filters == nil {
+		m.benefit_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.benefit_filters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBenefitFilters clears the "benefit_filters" edge to the BenefitFilter entity.
+func (m *FilterMutation) ClearBenefitFilters() {
+	m.clearedbenefit_filters = true
+}
+
+// BenefitFiltersCleared reports if the "benefit_filters" edge to the BenefitFilter entity was cleared.
+func (m *FilterMutation) BenefitFiltersCleared() bool {
+	return m.clearedbenefit_filters
+}
+
+// RemoveBenefitFilterIDs removes the "benefit_filters" edge to the BenefitFilter entity by IDs.
+func (m *FilterMutation) RemoveBenefitFilterIDs(ids ...int) {
+	if m.removedbenefit_filters == nil {
+		m.removedbenefit_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.benefit_filters, ids[i])
+		m.removedbenefit_filters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBenefitFilters returns the removed IDs of the "benefit_filters" edge to the BenefitFilter entity.
+func (m *FilterMutation) RemovedBenefitFiltersIDs() (ids []int) {
+	for id := range m.removedbenefit_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BenefitFiltersIDs returns the "benefit_filters" edge IDs in the mutation.
+func (m *FilterMutation) BenefitFiltersIDs() (ids []int) {
+	for id := range m.benefit_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBenefitFilters resets all changes to the "benefit_filters" edge.
+func (m *FilterMutation) ResetBenefitFilters() {
+	m.benefit_filters = nil
+	m.clearedbenefit_filters = false
+	m.removedbenefit_filters = nil
+}
+
+// AddChildFilterIDs adds the "child_filters" edge to the ChildFilter entity by ids.
+func (m *FilterMutation) AddChildFilterIDs(ids ...int) {
+	if m.child_filters == nil {
+		m.child_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.child_filters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildFilters clears the "child_filters" edge to the ChildFilter entity.
+func (m *FilterMutation) ClearChildFilters() {
+	m.clearedchild_filters = true
+}
+
+// ChildFiltersCleared reports if the "child_filters" edge to the ChildFilter entity was cleared.
+func (m *FilterMutation) ChildFiltersCleared() bool {
+	return m.clearedchild_filters
+}
+
+// RemoveChildFilterIDs removes the "child_filters" edge to the ChildFilter entity by IDs.
+func (m *FilterMutation) RemoveChildFilterIDs(ids ...int) {
+	if m.removedchild_filters == nil {
+		m.removedchild_filters = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.child_filters, ids[i])
+		m.removedchild_filters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildFilters returns the removed IDs of the "child_filters" edge to the ChildFilter entity.
+func (m *FilterMutation) RemovedChildFiltersIDs() (ids []int) {
+	for id := range m.removedchild_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildFiltersIDs returns the "child_filters" edge IDs in the mutation.
+func (m *FilterMutation) ChildFiltersIDs() (ids []int) {
+	for id := range m.child_filters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildFilters resets all changes to the "child_filters" edge.
+func (m *FilterMutation) ResetChildFilters() {
+	m.child_filters = nil
+	m.clearedchild_filters = false
+	m.removedchild_filters = nil
+}
+
+// AddTranslationIDs adds the "translations" edge to the FilterTranslation entity by ids.
+func (m *FilterMutation) AddTranslationIDs(ids ...int) {
+	if m.translations == nil {
+		m.translations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.translations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranslations clears the "translations" edge to the FilterTranslation entity.
+func (m *FilterMutation) ClearTranslations() {
+	m.clearedtranslations = true
+}
+
+// TranslationsCleared reports if the "translations" edge to the FilterTranslation entity was cleared.
+func (m *FilterMutation) TranslationsCleared() bool {
+	return m.clearedtranslations
+}
+
+// RemoveTranslationIDs removes the "translations" edge to the FilterTranslation entity by IDs.
+func (m *FilterMutation) RemoveTranslationIDs(ids ...int) {
+	if m.removedtranslations == nil {
+		m.removedtranslations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.translations, ids[i])
+		m.removedtranslations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranslations returns the removed IDs of the "translations" edge to the FilterTranslation entity.
+func (m *FilterMutation) RemovedTranslationsIDs() (ids []int) {
+	for id := range m.removedtranslations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranslationsIDs returns the "translations" edge IDs in the mutation.
+func (m *FilterMutation) TranslationsIDs() (ids []int) {
+	for id := range m.translations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranslations resets all changes to the "translations" edge.
+func (m *FilterMutation) ResetTranslations() {
+	m.translations = nil
+	m.clearedtranslations = false
+	m.removedtranslations = nil
+}
+
+// Where appends a list predicates to the FilterMutation builder.
+func (m *FilterMutation) Where(ps ...predicate.Filter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FilterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FilterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Filter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FilterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FilterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Filter).
+func (m *FilterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FilterMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, filter.FieldName)
+	}
+	if m.hint != nil {
+		fields = append(fields, filter.FieldHint)
+	}
+	if m._type != nil {
+		fields = append(fields, filter.FieldType)
+	}
+	if m.values != nil {
+		fields = append(fields, filter.FieldValues)
+	}
+	if m.is_age != nil {
+		fields = append(fields, filter.FieldIsAge)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FilterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filter.FieldName:
+		return m.Name()
+	case filter.FieldHint:
+		return m.Hint()
+	case filter.FieldType:
+		return m.GetType()
+	case filter.FieldValues:
+		return m.Values()
+	case filter.FieldIsAge:
+		return m.IsAge()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FilterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filter.FieldName:
+		return m.OldName(ctx)
+	case filter.FieldHint:
+		return m.OldHint(ctx)
+	case filter.FieldType:
+		return m.OldType(ctx)
+	case filter.FieldValues:
+		return m.OldValues(ctx)
+	case filter.FieldIsAge:
+		return m.OldIsAge(ctx)
+	}
+	return nil, fmt.Errorf("unknown Filter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FilterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filter.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case filter.FieldHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHint(v)
+		return nil
+	case filter.FieldType:
+		v, ok := value.(filter.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case filter.FieldValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValues(v)
+		return nil
+	case filter.FieldIsAge:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Filter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FilterMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FilterMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FilterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Filter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FilterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filter.FieldHint) {
+		fields = append(fields, filter.FieldHint)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FilterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FilterMutation) ClearField(name string) error {
+	switch name {
+	case filter.FieldHint:
+		m.ClearHint()
+		return nil
+	}
+	return fmt.Errorf("unknown Filter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FilterMutation) ResetField(name string) error {
+	switch name {
+	case filter.FieldName:
+		m.ResetName()
+		return nil
+	case filter.FieldHint:
+		m.ResetHint()
+		return nil
+	case filter.FieldType:
+		m.ResetType()
+		return nil
+	case filter.FieldValues:
+		m.ResetValues()
+		return nil
+	case filter.FieldIsAge:
+		m.ResetIsAge()
+		return nil
+	}
+	return fmt.Errorf("unknown Filter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FilterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user_filters != nil {
+		edges = append(edges, filter.EdgeUserFilters)
+	}
+	if m.benefit_filters != nil {
+		edges = append(edges, filter.EdgeBenefitFilters)
+	}
+	if m.child_filters != nil {
+		edges = append(edges, filter.EdgeChildFilters)
+	}
+	if m.translations != nil {
+		edges = append(edges, filter.EdgeTranslations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FilterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filter.EdgeUserFilters:
+		ids := make([]ent.Value, 0, len(m.user_filters))
+		for id := range m.user_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	case filter.EdgeBenefitFilters:
+		ids := make([]ent.Value, 0, len(m.benefit_filters))
+		for id := range m.benefit_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	case filter.EdgeChildFilters:
+		ids := make([]ent.Value, 0, len(m.child_filters))
+		for id := range m.child_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	case filter.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.translations))
+		for id := range m.translations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FilterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removeduser_filters != nil {
+		edges = append(edges, filter.EdgeUserFilters)
+	}
+	if m.removedbenefit_filters != nil {
+		edges = append(edges, filter.EdgeBenefitFilters)
+	}
+	if m.removedchild_filters != nil {
+		edges = append(edges, filter.EdgeChildFilters)
+	}
+	if m.removedtranslations != nil {
+		edges = append(edges, filter.EdgeTranslations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FilterMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case filter.EdgeUserFilters:
+		ids := make([]ent.Value, 0, len(m.removeduser_filters))
+		for id := range m.removeduser_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	case filter.EdgeBenefitFilters:
+		ids := make([]ent.Value, 0, len(m.removedbenefit_filters))
+		for id := range m.removedbenefit_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	case filter.EdgeChildFilters:
+		ids := make([]ent.Value, 0, len(m.removedchild_filters))
+		for id := range m.removedchild_filters {
+			ids = append(ids, id)
+		}
+		return ids
+	case filter.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.removedtranslations))
+		for id := range m.removedtranslations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FilterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser_filters {
+		edges = append(edges, filter.EdgeUserFilters)
+	}
+	if m.clearedbenefit_filters {
+		edges = append(edges, filter.EdgeBenefitFilters)
+	}
+	if m.clearedchild_filters {
+		edges = append(edges, filter.EdgeChildFilters)
+	}
+	if m.clearedtranslations {
+		edges = append(edges, filter.EdgeTranslations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FilterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filter.EdgeUserFilters:
+		return m.cleareduser_filters
+	case filter.EdgeBenefitFilters:
+		return m.clearedbenefit_filters
+	case filter.EdgeChildFilters:
+		return m.clearedchild_filters
+	case filter.EdgeTranslations:
+		return m.clearedtranslations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FilterMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Filter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FilterMutation) ResetEdge(name string) error {
+	switch name {
+	case filter.EdgeUserFilters:
+		m.ResetUserFilters()
+		return nil
+	case filter.EdgeBenefitFilters:
+		m.ResetBenefitFilters()
+		return nil
+	case filter.EdgeChildFilters:
+		m.ResetChildFilters()
+		return nil
+	case filter.EdgeTranslations:
+		m.ResetTranslations()
+		return nil
+	}
+	return fmt.Errorf("unknown Filter edge %s", name)
+}
+
+// FilterTranslationMutation represents an operation that mutates the FilterTranslation nodes in the graph.
+type FilterTranslationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	locale        *filtertranslation.Locale
+	name          *string
+	hint          *string
+	values        *[]string
+	appendvalues  []string
+	clearedFields map[string]struct{}
+	filter        *int
+	clearedfilter bool
+	done          bool
+	oldValue      func(context.Context) (*FilterTranslation, error)
+	predicates    []predicate.FilterTranslation
+}
+
+var _ ent.Mutation = (*FilterTranslationMutation)(nil)
+
+// filtertranslationOption allows management of the mutation configuration using functional options.
+type filtertranslationOption func(*FilterTranslationMutation)
+
+// newFilterTranslationMutation creates new mutation for the FilterTranslation entity.
+func newFilterTranslationMutation(c config, op Op, opts ...filtertranslationOption) *FilterTranslationMutation {
+	m := &FilterTranslationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFilterTranslation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFilterTranslationID sets the ID field of the mutation.
+func withFilterTranslationID(id int) filtertranslationOption {
+	return func(m *FilterTranslationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FilterTranslation
+		)
+		m.oldValue = func(ctx context.Context) (*FilterTranslation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FilterTranslation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFilterTranslation sets the old FilterTranslation of the mutation.
+func withFilterTranslation(node *FilterTranslation) filtertranslationOption {
+	return func(m *FilterTranslationMutation) {
+		m.oldValue = func(context.Context) (*FilterTranslation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FilterTranslationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FilterTranslationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FilterTranslationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FilterTranslationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FilterTranslation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFilterID sets the "filter_id" field.
+func (m *FilterTranslationMutation) SetFilterID(i int) {
+	m.filter = &i
+}
+
+// FilterID returns the value of the "filter_id" field in the mutation.
+func (m *FilterTranslationMutation) FilterID() (r int, exists bool) {
+	v := m.filter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilterID returns the old "filter_id" field's value of the FilterTranslation entity.
+// If the FilterTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterTranslationMutation) OldFilterID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilterID: %w", err)
+	}
+	return oldValue.FilterID, nil
+}
+
+// ResetFilterID resets all changes to the "filter_id" field.
+func (m *FilterTranslationMutation) ResetFilterID() {
+	m.filter = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *FilterTranslationMutation) SetLocale(f filtertranslation.Locale) {
+	m.locale = &f
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *FilterTranslationMutation) Locale() (r filtertranslation.Locale, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the FilterTranslation entity.
+// If the FilterTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterTranslationMutation) OldLocale(ctx context.Context) (v filtertranslation.Locale, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *FilterTranslationMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetName sets the "name" field.
+func (m *FilterTranslationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FilterTranslationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the FilterTranslation entity.
+// If the FilterTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterTranslationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *FilterTranslationMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[filtertranslation.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *FilterTranslationMutation) NameCleared() bool {
+	_, ok := m.clearedFields[filtertranslation.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FilterTranslationMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, filtertranslation.FieldName)
+}
+
+// SetHint sets the "hint" field.
+func (m *FilterTranslationMutation) SetHint(s string) {
+	m.hint = &s
+}
+
+// Hint returns the value of the "hint" field in the mutation.
+func (m *FilterTranslationMutation) Hint() (r string, exists bool) {
+	v := m.hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHint returns the old "hint" field's value of the FilterTranslation entity.
+// If the FilterTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterTranslationMutation) OldHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+	}
+	return oldValue.Hint, nil
+}
+
+// ClearHint clears the value of the "hint" field.
+func (m *FilterTranslationMutation) ClearHint() {
+	m.hint = nil
+	m.clearedFields[filtertranslation.FieldHint] = struct{}{}
+}
+
+// HintCleared returns if the "hint" field was cleared in this mutation.
+func (m *FilterTranslationMutation) HintCleared() bool {
+	_, ok := m.clearedFields[filtertranslation.FieldHint]
+	return ok
+}
+
+// ResetHint resets all changes to the "hint" field.
+func (m *FilterTranslationMutation) ResetHint() {
+	m.hint = nil
+	delete(m.clearedFields, filtertranslation.FieldHint)
+}
+
+// SetValues sets the "values" field.
+func (m *FilterTranslationMutation) SetValues(s []string) {
+	m.values = &s
+	m.appendvalues = nil
+}
+
+// Values returns the value of the "values" field in the mutation.
+func (m *FilterTranslationMutation) Values() (r []string, exists bool) {
+	v := m.values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValues returns the old "values" field's value of the FilterTranslation entity.
+// If the FilterTranslation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FilterTranslationMutation) OldValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValues: %w", err)
+	}
+	return oldValue.Values, nil
+}
+
+// AppendValues adds s to the "values" field.
+func (m *FilterTranslationMutation) AppendValues(s []string) {
+	m.appendvalues = append(m.appendvalues, s...)
+}
+
+// AppendedValues returns the list of values that were appended to the "values" field in this mutation.
+func (m *FilterTranslationMutation) AppendedValues() ([]string, bool) {
+	if len(m.appendvalues) == 0 {
+		return nil, false
+	}
+	return m.appendvalues, true
+}
+
+// ClearValues clears the value of the "values" field.
+func (m *FilterTranslationMutation) ClearValues() {
+	m.values = nil
+	m.appendvalues = nil
+	m.clearedFields[filtertranslation.FieldValues] = struct{}{}
+}
+
+// ValuesCleared returns if the "values" field was cleared in this mutation.
+func (m *FilterTranslationMutation) ValuesCleared() bool {
+	_, ok := m.clearedFields[filtertranslation.FieldValues]
+	return ok
+}
+
+// ResetValues resets all changes to the "values" field.
+func (m *FilterTranslationMutation) ResetValues() {
+	m.values = nil
+	m.appendvalues = nil
+	delete(m.clearedFields, filtertranslation.FieldValues)
+}
+
+// ClearFilter clears the "filter" edge to the Filter entity.
+func (m *FilterTranslationMutation) ClearFilter() {
+	m.clearedfilter = true
+	m.clearedFields[filtertranslation.FieldFilterID] = struct{}{}
+}
+
+// FilterCleared reports if the "filter" edge to the Filter entity was cleared.
+func (m *FilterTranslationMutation) FilterCleared() bool {
+	return m.clearedfilter
+}
+
+// FilterIDs returns the "filter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FilterID instead. It exists only for internal usage by the builders.
+func (m *FilterTranslationMutation) FilterIDs() (ids []int) {
+	if id := m.filter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFilter resets all changes to the "filter" edge.
+func (m *FilterTranslationMutation) ResetFilter() {
+	m.filter = nil
+	m.clearedfilter = false
+}
+
+// Where appends a list predicates to the FilterTranslationMutation builder.
+func (m *FilterTranslationMutation) Where(ps ...predicate.FilterTranslation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FilterTranslationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FilterTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FilterTranslation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FilterTranslationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FilterTranslationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FilterTranslation).
+func (m *FilterTranslationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FilterTranslationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.filter != nil {
+		fields = append(fields, filtertranslation.FieldFilterID)
+	}
+	if m.locale != nil {
+		fields = append(fields, filtertranslation.FieldLocale)
+	}
+	if m.name != nil {
+		fields = append(fields, filtertranslation.FieldName)
+	}
+	if m.hint != nil {
+		fields = append(fields, filtertranslation.FieldHint)
+	}
+	if m.values != nil {
+		fields = append(fields, filtertranslation.FieldValues)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FilterTranslationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filtertranslation.FieldFilterID:
+		return m.FilterID()
+	case filtertranslation.FieldLocale:
+		return m.Locale()
+	case filtertranslation.FieldName:
+		return m.Name()
+	case filtertranslation.FieldHint:
+		return m.Hint()
+	case filtertranslation.FieldValues:
+		return m.Values()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FilterTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filtertranslation.FieldFilterID:
+		return m.OldFilterID(ctx)
+	case filtertranslation.FieldLocale:
+		return m.OldLocale(ctx)
+	case filtertranslation.FieldName:
+		return m.OldName(ctx)
+	case filtertranslation.FieldHint:
+		return m.OldHint(ctx)
+	case filtertranslation.FieldValues:
+		return m.OldValues(ctx)
+	}
+	return nil, fmt.Errorf("unknown FilterTranslation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FilterTranslationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filtertranslation.FieldFilterID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilterID(v)
+		return nil
+	case filtertranslation.FieldLocale:
+		v, ok := value.(filtertranslation.Locale)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case filtertranslation.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case filtertranslation.FieldHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHint(v)
+		return nil
+	case filtertranslation.FieldValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValues(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FilterTranslation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FilterTranslationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FilterTranslationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FilterTranslationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FilterTranslation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FilterTranslationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filtertranslation.FieldName) {
+		fields = append(fields, filtertranslation.FieldName)
+	}
+	if m.FieldCleared(filtertranslation.FieldHint) {
+		fields = append(fields, filtertranslation.FieldHint)
+	}
+	if m.FieldCleared(filtertranslation.FieldValues) {
+		fields = append(fields, filtertranslation.FieldValues)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FilterTranslationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FilterTranslationMutation) ClearField(name string) error {
+	switch name {
+	case filtertranslation.FieldName:
+		m.ClearName()
+		return nil
+	case filtertranslation.FieldHint:
+		m.ClearHint()
+		return nil
+	case filtertranslation.FieldValues:
+		m.ClearValues()
+		return nil
+	}
+	return fmt.Errorf("unknown FilterTranslation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FilterTranslationMutation) ResetField(name string) error {
+	switch name {
+	case filtertranslation.FieldFilterID:
+		m.ResetFilterID()
+		return nil
+	case filtertranslation.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case filtertranslation.FieldName:
+		m.ResetName()
+		return nil
+	case filtertranslation.FieldHint:
+		m.ResetHint()
+		return nil
+	case filtertranslation.FieldValues:
+		m.ResetValues()
+		return nil
+	}
+	return fmt.Errorf("unknown FilterTranslation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FilterTranslationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.filter != nil {
+		edges = append(edges, filtertranslation.EdgeFilter)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FilterTranslationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filtertranslation.EdgeFilter:
+		if id := m.filter; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FilterTranslationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FilterTranslationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FilterTranslationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfilter {
+		edges = append(edges, filtertranslation.EdgeFilter)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FilterTranslationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filtertranslation.EdgeFilter:
+		return m.clearedfilter
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FilterTranslationMutation) ClearEdge(name string) error {
+	switch name {
+	case filtertranslation.EdgeFilter:
+		m.ClearFilter()
+		return nil
+	}
+	return fmt.Errorf("unknown FilterTranslation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FilterTranslationMutation) ResetEdge(name string) error {
+	switch name {
+	case filtertranslation.EdgeFilter:
+		m.ResetFilter()
+		return nil
+	}
+	return fmt.Errorf("unknown FilterTranslation edge %s", name)
+}
+
+// OutboxEmailMutation represents an operation that mutates the OutboxEmail nodes in the graph.
+type OutboxEmailMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	kind            *string
+	recipient       *string
+	subject         *string
+	body            *string
+	text_body       *string
+	status          *outboxemail.Status
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	locked_until    *time.Time
+	last_error      *string
+	sent_at         *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OutboxEmail, error)
+	predicates      []predicate.OutboxEmail
+}
+
+var _ ent.Mutation = (*OutboxEmailMutation)(nil)
+
+// outboxemailOption allows management of the mutation configuration using functional options.
+type outboxemailOption func(*OutboxEmailMutation)
+
+// newOutboxEmailMutation creates new mutation for the OutboxEmail entity.
+func newOutboxEmailMutation(c config, op Op, opts ...outboxemailOption) *OutboxEmailMutation {
+	m := &OutboxEmailMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEmail,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEmailID sets the ID field of the mutation.
+func withOutboxEmailID(id int) outboxemailOption {
+	return func(m *OutboxEmailMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEmail
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEmail, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEmail.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEmail sets the old OutboxEmail of the mutation.
+func withOutboxEmail(node *OutboxEmail) outboxemailOption {
+	return func(m *OutboxEmailMutation) {
+		m.oldValue = func(context.Context) (*OutboxEmail, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEmailMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEmailMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEmailMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEmailMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEmail.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *OutboxEmailMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *OutboxEmailMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *OutboxEmailMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetRecipient sets the "recipient" field.
+func (m *OutboxEmailMutation) SetRecipient(s string) {
+	m.recipient = &s
+}
+
+// Recipient returns the value of the "recipient" field in the mutation.
+func (m *OutboxEmailMutation) Recipient() (r string, exists bool) {
+	v := m.recipient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipient returns the old "recipient" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldRecipient(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipient: %w", err)
+	}
+	return oldValue.Recipient, nil
+}
+
+// ResetRecipient resets all changes to the "recipient" field.
+func (m *OutboxEmailMutation) ResetRecipient() {
+	m.recipient = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *OutboxEmailMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *OutboxEmailMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *OutboxEmailMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetBody sets the "body" field.
+func (m *OutboxEmailMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *OutboxEmailMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *OutboxEmailMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetTextBody sets the "text_body" field.
+func (m *OutboxEmailMutation) SetTextBody(s string) {
+	m.text_body = &s
+}
+
+// TextBody returns the value of the "text_body" field in the mutation.
+func (m *OutboxEmailMutation) TextBody() (r string, exists bool) {
+	v := m.text_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextBody returns the old "text_body" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldTextBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextBody: %w", err)
+	}
+	return oldValue.TextBody, nil
+}
+
+// ResetTextBody resets all changes to the "text_body" field.
+func (m *OutboxEmailMutation) ResetTextBody() {
+	m.text_body = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OutboxEmailMutation) SetStatus(o outboxemail.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OutboxEmailMutation) Status() (r outboxemail.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldStatus(ctx context.Context) (v outboxemail.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OutboxEmailMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEmailMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEmailMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEmailMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEmailMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEmailMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEmailMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEmailMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEmailMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *OutboxEmailMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *OutboxEmailMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *OutboxEmailMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[outboxemail.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *OutboxEmailMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[outboxemail.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *OutboxEmailMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, outboxemail.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEmailMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEmailMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEmailMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxemail.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEmailMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxemail.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEmailMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxemail.FieldLastError)
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *OutboxEmailMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *OutboxEmailMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *OutboxEmailMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[outboxemail.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *OutboxEmailMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[outboxemail.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *OutboxEmailMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, outboxemail.FieldSentAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEmailMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEmailMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEmail entity.
+// If the OutboxEmail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEmailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEmailMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxEmailMutation builder.
+func (m *OutboxEmailMutation) Where(ps ...predicate.OutboxEmail) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEmailMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEmailMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEmail, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEmailMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEmailMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEmail).
+func (m *OutboxEmailMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEmailMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.kind != nil {
+		fields = append(fields, outboxemail.FieldKind)
 	}
-	if m.description != nil {
-		fields = append(fields, category.FieldDescription)
+	if m.recipient != nil {
+		fields = append(fields, outboxemail.FieldRecipient)
+	}
+	if m.subject != nil {
+		fields = append(fields, outboxemail.FieldSubject)
+	}
+	if m.body != nil {
+		fields = append(fields, outboxemail.FieldBody)
+	}
+	if m.text_body != nil {
+		fields = append(fields, outboxemail.FieldTextBody)
+	}
+	if m.status != nil {
+		fields = append(fields, outboxemail.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxemail.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxemail.FieldNextAttemptAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, outboxemail.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxemail.FieldLastError)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, outboxemail.FieldSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxemail.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2680,12 +8992,32 @@ func (m *CategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *OutboxEmailMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case category.FieldName:
-		return m.Name()
-	case category.FieldDescription:
-		return m.Description()
+	case outboxemail.FieldKind:
+		return m.Kind()
+	case outboxemail.FieldRecipient:
+		return m.Recipient()
+	case outboxemail.FieldSubject:
+		return m.Subject()
+	case outboxemail.FieldBody:
+		return m.Body()
+	case outboxemail.FieldTextBody:
+		return m.TextBody()
+	case outboxemail.FieldStatus:
+		return m.Status()
+	case outboxemail.FieldAttempts:
+		return m.Attempts()
+	case outboxemail.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxemail.FieldLockedUntil:
+		return m.LockedUntil()
+	case outboxemail.FieldLastError:
+		return m.LastError()
+	case outboxemail.FieldSentAt:
+		return m.SentAt()
+	case outboxemail.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2693,219 +9025,326 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OutboxEmailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case category.FieldName:
-		return m.OldName(ctx)
-	case category.FieldDescription:
-		return m.OldDescription(ctx)
+	case outboxemail.FieldKind:
+		return m.OldKind(ctx)
+	case outboxemail.FieldRecipient:
+		return m.OldRecipient(ctx)
+	case outboxemail.FieldSubject:
+		return m.OldSubject(ctx)
+	case outboxemail.FieldBody:
+		return m.OldBody(ctx)
+	case outboxemail.FieldTextBody:
+		return m.OldTextBody(ctx)
+	case outboxemail.FieldStatus:
+		return m.OldStatus(ctx)
+	case outboxemail.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxemail.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxemail.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case outboxemail.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxemail.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case outboxemail.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Category field %s", name)
+	return nil, fmt.Errorf("unknown OutboxEmail field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+func (m *OutboxEmailMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case category.FieldName:
+	case outboxemail.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case outboxemail.FieldRecipient:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipient(v)
+		return nil
+	case outboxemail.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case outboxemail.FieldBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetBody(v)
 		return nil
-	case category.FieldDescription:
+	case outboxemail.FieldTextBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTextBody(v)
+		return nil
+	case outboxemail.FieldStatus:
+		v, ok := value.(outboxemail.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case outboxemail.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxemail.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxemail.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case outboxemail.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxemail.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case outboxemail.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown OutboxEmail field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CategoryMutation) AddedFields() []string {
-	return nil
+func (m *OutboxEmailMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxemail.FieldAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OutboxEmailMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxemail.FieldAttempts:
+		return m.AddedAttempts()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+func (m *OutboxEmailMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case outboxemail.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Category numeric field %s", name)
+	return fmt.Errorf("unknown OutboxEmail numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CategoryMutation) ClearedFields() []string {
+func (m *OutboxEmailMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(category.FieldDescription) {
-		fields = append(fields, category.FieldDescription)
+	if m.FieldCleared(outboxemail.FieldLockedUntil) {
+		fields = append(fields, outboxemail.FieldLockedUntil)
+	}
+	if m.FieldCleared(outboxemail.FieldLastError) {
+		fields = append(fields, outboxemail.FieldLastError)
+	}
+	if m.FieldCleared(outboxemail.FieldSentAt) {
+		fields = append(fields, outboxemail.FieldSentAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CategoryMutation) FieldCleared(name string) bool {
+func (m *OutboxEmailMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CategoryMutation) ClearField(name string) error {
+func (m *OutboxEmailMutation) ClearField(name string) error {
 	switch name {
-	case category.FieldDescription:
-		m.ClearDescription()
+	case outboxemail.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case outboxemail.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case outboxemail.FieldSentAt:
+		m.ClearSentAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Category nullable field %s", name)
+	return fmt.Errorf("unknown OutboxEmail nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CategoryMutation) ResetField(name string) error {
+func (m *OutboxEmailMutation) ResetField(name string) error {
 	switch name {
-	case category.FieldName:
-		m.ResetName()
+	case outboxemail.FieldKind:
+		m.ResetKind()
 		return nil
-	case category.FieldDescription:
-		m.ResetDescription()
+	case outboxemail.FieldRecipient:
+		m.ResetRecipient()
+		return nil
+	case outboxemail.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case outboxemail.FieldBody:
+		m.ResetBody()
+		return nil
+	case outboxemail.FieldTextBody:
+		m.ResetTextBody()
+		return nil
+	case outboxemail.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case outboxemail.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxemail.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxemail.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case outboxemail.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxemail.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case outboxemail.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown OutboxEmail field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.benefit_categories != nil {
-		edges = append(edges, category.EdgeBenefitCategories)
-	}
+func (m *OutboxEmailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case category.EdgeBenefitCategories:
-		ids := make([]ent.Value, 0, len(m.benefit_categories))
-		for id := range m.benefit_categories {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *OutboxEmailMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedbenefit_categories != nil {
-		edges = append(edges, category.EdgeBenefitCategories)
-	}
+func (m *OutboxEmailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case category.EdgeBenefitCategories:
-		ids := make([]ent.Value, 0, len(m.removedbenefit_categories))
-		for id := range m.removedbenefit_categories {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *OutboxEmailMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedbenefit_categories {
-		edges = append(edges, category.EdgeBenefitCategories)
-	}
+func (m *OutboxEmailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CategoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case category.EdgeBenefitCategories:
-		return m.clearedbenefit_categories
-	}
+func (m *OutboxEmailMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CategoryMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Category unique edge %s", name)
+func (m *OutboxEmailMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEmail unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CategoryMutation) ResetEdge(name string) error {
-	switch name {
-	case category.EdgeBenefitCategories:
-		m.ResetBenefitCategories()
-		return nil
-	}
-	return fmt.Errorf("unknown Category edge %s", name)
+func (m *OutboxEmailMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEmail edge %s", name)
 }
 
-// ChildMutation represents an operation that mutates the Child nodes in the graph.
-type ChildMutation struct {
+// RecoveryCodeMutation represents an operation that mutates the RecoveryCode nodes in the graph.
+type RecoveryCodeMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	first_name           *string
-	last_name            *string
-	birth_date           *time.Time
-	created_at           *time.Time
-	clearedFields        map[string]struct{}
-	user                 *int
-	cleareduser          bool
-	child_filters        map[int]struct{}
-	removedchild_filters map[int]struct{}
-	clearedchild_filters bool
-	done                 bool
-	oldValue             func(context.Context) (*Child, error)
-	predicates           []predicate.Child
+	op            Op
+	typ           string
+	id            *int
+	code_hash     *string
+	used_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RecoveryCode, error)
+	predicates    []predicate.RecoveryCode
 }
 
-var _ ent.Mutation = (*ChildMutation)(nil)
+var _ ent.Mutation = (*RecoveryCodeMutation)(nil)
 
-// childOption allows management of the mutation configuration using functional options.
-type childOption func(*ChildMutation)
+// recoverycodeOption allows management of the mutation configuration using functional options.
+type recoverycodeOption func(*RecoveryCodeMutation)
 
-// newChildMutation creates new mutation for the Child entity.
-func newChildMutation(c config, op Op, opts ...childOption) *ChildMutation {
-	m := &ChildMutation{
+// newRecoveryCodeMutation creates new mutation for the RecoveryCode entity.
+func newRecoveryCodeMutation(c config, op Op, opts ...recoverycodeOption) *RecoveryCodeMutation {
+	m := &RecoveryCodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChild,
+		typ:           TypeRecoveryCode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2914,20 +9353,20 @@ func newChildMutation(c config, op Op, opts ...childOption) *ChildMutation {
 	return m
 }
 
-// withChildID sets the ID field of the mutation.
-func withChildID(id int) childOption {
-	return func(m *ChildMutation) {
+// withRecoveryCodeID sets the ID field of the mutation.
+func withRecoveryCodeID(id int) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Child
+			value *RecoveryCode
 		)
-		m.oldValue = func(ctx context.Context) (*Child, error) {
+		m.oldValue = func(ctx context.Context) (*RecoveryCode, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Child.Get(ctx, id)
+					value, err = m.Client().RecoveryCode.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2936,10 +9375,10 @@ func withChildID(id int) childOption {
 	}
 }
 
-// withChild sets the old Child of the mutation.
-func withChild(node *Child) childOption {
-	return func(m *ChildMutation) {
-		m.oldValue = func(context.Context) (*Child, error) {
+// withRecoveryCode sets the old RecoveryCode of the mutation.
+func withRecoveryCode(node *RecoveryCode) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		m.oldValue = func(context.Context) (*RecoveryCode, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2948,7 +9387,7 @@ func withChild(node *Child) childOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChildMutation) Client() *Client {
+func (m RecoveryCodeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2956,7 +9395,7 @@ func (m ChildMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChildMutation) Tx() (*Tx, error) {
+func (m RecoveryCodeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2967,7 +9406,7 @@ func (m ChildMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChildMutation) ID() (id int, exists bool) {
+func (m *RecoveryCodeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2978,7 +9417,7 @@ func (m *ChildMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChildMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RecoveryCodeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2987,163 +9426,140 @@ func (m *ChildMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Child.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RecoveryCode.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *ChildMutation) SetFirstName(s string) {
-	m.first_name = &s
-}
-
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *ChildMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFirstName returns the old "first_name" field's value of the Child entity.
-// If the Child object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildMutation) OldFirstName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
-	}
-	return oldValue.FirstName, nil
-}
-
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *ChildMutation) ResetFirstName() {
-	m.first_name = nil
-}
-
-// SetLastName sets the "last_name" field.
-func (m *ChildMutation) SetLastName(s string) {
-	m.last_name = &s
+// SetUserID sets the "user_id" field.
+func (m *RecoveryCodeMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *ChildMutation) LastName() (r string, exists bool) {
-	v := m.last_name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RecoveryCodeMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastName returns the old "last_name" field's value of the Child entity.
-// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildMutation) OldLastName(ctx context.Context) (v string, err error) {
+func (m *RecoveryCodeMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.LastName, nil
+	return oldValue.UserID, nil
 }
 
-// ResetLastName resets all changes to the "last_name" field.
-func (m *ChildMutation) ResetLastName() {
-	m.last_name = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RecoveryCodeMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetBirthDate sets the "birth_date" field.
-func (m *ChildMutation) SetBirthDate(t time.Time) {
-	m.birth_date = &t
+// SetCodeHash sets the "code_hash" field.
+func (m *RecoveryCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
 }
 
-// BirthDate returns the value of the "birth_date" field in the mutation.
-func (m *ChildMutation) BirthDate() (r time.Time, exists bool) {
-	v := m.birth_date
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *RecoveryCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldBirthDate returns the old "birth_date" field's value of the Child entity.
-// If the Child object wasn't provided to the builder, the object is fetched from the database.
+
+// OldCodeHash returns the old "code_hash" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildMutation) OldBirthDate(ctx context.Context) (v time.Time, err error) {
+func (m *RecoveryCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthDate requires an ID field in the mutation")
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
 	}
-	return oldValue.BirthDate, nil
+	return oldValue.CodeHash, nil
 }
 
-// ResetBirthDate resets all changes to the "birth_date" field.
-func (m *ChildMutation) ResetBirthDate() {
-	m.birth_date = nil
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *RecoveryCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ChildMutation) SetUserID(i int) {
-	m.user = &i
+// SetUsedAt sets the "used_at" field.
+func (m *RecoveryCodeMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ChildMutation) UserID() (r int, exists bool) {
-	v := m.user
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RecoveryCodeMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Child entity.
-// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedAt returns the old "used_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *RecoveryCodeMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.UsedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ChildMutation) ResetUserID() {
-	m.user = nil
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RecoveryCodeMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[recoverycode.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RecoveryCodeMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[recoverycode.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RecoveryCodeMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, recoverycode.FieldUsedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ChildMutation) SetCreatedAt(t time.Time) {
+func (m *RecoveryCodeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChildMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *RecoveryCodeMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3151,10 +9567,10 @@ func (m *ChildMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Child entity.
-// If the Child object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RecoveryCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3169,25 +9585,25 @@ func (m *ChildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChildMutation) ResetCreatedAt() {
+func (m *RecoveryCodeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *ChildMutation) ClearUser() {
+func (m *RecoveryCodeMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[child.FieldUserID] = struct{}{}
+	m.clearedFields[recoverycode.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ChildMutation) UserCleared() bool {
+func (m *RecoveryCodeMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *ChildMutation) UserIDs() (ids []int) {
+func (m *RecoveryCodeMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3195,74 +9611,20 @@ func (m *ChildMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *ChildMutation) ResetUser() {
+func (m *RecoveryCodeMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// AddChildFilterIDs adds the "child_filters" edge to the ChildFilter entity by ids.
-func (m *ChildMutation) AddChildFilterIDs(ids ...int) {
-	if m.child_filters == nil {
-		m.child_filters = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.child_filters[ids[i]] = struct{}{}
-	}
-}
-
-// ClearChildFilters clears the "child_filters" edge to the ChildFilter entity.
-func (m *ChildMutation) ClearChildFilters() {
-	m.clearedchild_filters = true
-}
-
-// ChildFiltersCleared reports if the "child_filters" edge to the ChildFilter entity was cleared.
-func (m *ChildMutation) ChildFiltersCleared() bool {
-	return m.clearedchild_filters
-}
-
-// RemoveChildFilterIDs removes the "child_filters" edge to the ChildFilter entity by IDs.
-func (m *ChildMutation) RemoveChildFilterIDs(ids ...int) {
-	if m.removedchild_filters == nil {
-		m.removedchild_filters = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.child_filters, ids[i])
-		m.removedchild_filters[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedChildFilters returns the removed IDs of the "child_filters" edge to the ChildFilter entity.
-func (m *ChildMutation) RemovedChildFiltersIDs() (ids []int) {
-	for id := range m.removedchild_filters {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ChildFiltersIDs returns the "child_filters" edge IDs in the mutation.
-func (m *ChildMutation) ChildFiltersIDs() (ids []int) {
-	for id := range m.child_filters {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetChildFilters resets all changes to the "child_filters" edge.
-func (m *ChildMutation) ResetChildFilters() {
-	m.child_filters = nil
-	m.clearedchild_filters = false
-	m.removedchild_filters = nil
-}
-
-// Where appends a list predicates to the ChildMutation builder.
-func (m *ChildMutation) Where(ps ...predicate.Child) {
+// Where appends a list predicates to the RecoveryCodeMutation builder.
+func (m *RecoveryCodeMutation) Where(ps ...predicate.RecoveryCode) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChildMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RecoveryCodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChildMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Child, len(ps))
+func (m *RecoveryCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecoveryCode, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3270,39 +9632,36 @@ func (m *ChildMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChildMutation) Op() Op {
+func (m *RecoveryCodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChildMutation) SetOp(op Op) {
+func (m *RecoveryCodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Child).
-func (m *ChildMutation) Type() string {
+// Type returns the node type of this mutation (RecoveryCode).
+func (m *RecoveryCodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChildMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.first_name != nil {
-		fields = append(fields, child.FieldFirstName)
-	}
-	if m.last_name != nil {
-		fields = append(fields, child.FieldLastName)
+func (m *RecoveryCodeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user != nil {
+		fields = append(fields, recoverycode.FieldUserID)
 	}
-	if m.birth_date != nil {
-		fields = append(fields, child.FieldBirthDate)
+	if m.code_hash != nil {
+		fields = append(fields, recoverycode.FieldCodeHash)
 	}
-	if m.user != nil {
-		fields = append(fields, child.FieldUserID)
+	if m.used_at != nil {
+		fields = append(fields, recoverycode.FieldUsedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, child.FieldCreatedAt)
+		fields = append(fields, recoverycode.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3310,17 +9669,15 @@ func (m *ChildMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChildMutation) Field(name string) (ent.Value, bool) {
+func (m *RecoveryCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case child.FieldFirstName:
-		return m.FirstName()
-	case child.FieldLastName:
-		return m.LastName()
-	case child.FieldBirthDate:
-		return m.BirthDate()
-	case child.FieldUserID:
+	case recoverycode.FieldUserID:
 		return m.UserID()
-	case child.FieldCreatedAt:
+	case recoverycode.FieldCodeHash:
+		return m.CodeHash()
+	case recoverycode.FieldUsedAt:
+		return m.UsedAt()
+	case recoverycode.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -3329,56 +9686,47 @@ func (m *ChildMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RecoveryCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case child.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case child.FieldLastName:
-		return m.OldLastName(ctx)
-	case child.FieldBirthDate:
-		return m.OldBirthDate(ctx)
-	case child.FieldUserID:
+	case recoverycode.FieldUserID:
 		return m.OldUserID(ctx)
-	case child.FieldCreatedAt:
+	case recoverycode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case recoverycode.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case recoverycode.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Child field %s", name)
+	return nil, fmt.Errorf("unknown RecoveryCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChildMutation) SetField(name string, value ent.Value) error {
+func (m *RecoveryCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case child.FieldFirstName:
-		v, ok := value.(string)
+	case recoverycode.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstName(v)
+		m.SetUserID(v)
 		return nil
-	case child.FieldLastName:
+	case recoverycode.FieldCodeHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastName(v)
+		m.SetCodeHash(v)
 		return nil
-	case child.FieldBirthDate:
+	case recoverycode.FieldUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBirthDate(v)
-		return nil
-	case child.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
+		m.SetUsedAt(v)
 		return nil
-	case child.FieldCreatedAt:
+	case recoverycode.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3386,12 +9734,12 @@ func (m *ChildMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Child field %s", name)
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChildMutation) AddedFields() []string {
+func (m *RecoveryCodeMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -3399,7 +9747,7 @@ func (m *ChildMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChildMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RecoveryCodeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -3408,184 +9756,164 @@ func (m *ChildMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChildMutation) AddField(name string, value ent.Value) error {
+func (m *RecoveryCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Child numeric field %s", name)
+	return fmt.Errorf("unknown RecoveryCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChildMutation) ClearedFields() []string {
-	return nil
+func (m *RecoveryCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recoverycode.FieldUsedAt) {
+		fields = append(fields, recoverycode.FieldUsedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChildMutation) FieldCleared(name string) bool {
+func (m *RecoveryCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChildMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Child nullable field %s", name)
+func (m *RecoveryCodeMutation) ClearField(name string) error {
+	switch name {
+	case recoverycode.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChildMutation) ResetField(name string) error {
+func (m *RecoveryCodeMutation) ResetField(name string) error {
 	switch name {
-	case child.FieldFirstName:
-		m.ResetFirstName()
-		return nil
-	case child.FieldLastName:
-		m.ResetLastName()
+	case recoverycode.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case child.FieldBirthDate:
-		m.ResetBirthDate()
+	case recoverycode.FieldCodeHash:
+		m.ResetCodeHash()
 		return nil
-	case child.FieldUserID:
-		m.ResetUserID()
+	case recoverycode.FieldUsedAt:
+		m.ResetUsedAt()
 		return nil
-	case child.FieldCreatedAt:
+	case recoverycode.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Child field %s", name)
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RecoveryCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, child.EdgeUser)
-	}
-	if m.child_filters != nil {
-		edges = append(edges, child.EdgeChildFilters)
+		edges = append(edges, recoverycode.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChildMutation) AddedIDs(name string) []ent.Value {
+func (m *RecoveryCodeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case child.EdgeUser:
+	case recoverycode.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case child.EdgeChildFilters:
-		ids := make([]ent.Value, 0, len(m.child_filters))
-		for id := range m.child_filters {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchild_filters != nil {
-		edges = append(edges, child.EdgeChildFilters)
-	}
+func (m *RecoveryCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChildMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case child.EdgeChildFilters:
-		ids := make([]ent.Value, 0, len(m.removedchild_filters))
-		for id := range m.removedchild_filters {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RecoveryCodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RecoveryCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, child.EdgeUser)
-	}
-	if m.clearedchild_filters {
-		edges = append(edges, child.EdgeChildFilters)
+		edges = append(edges, recoverycode.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChildMutation) EdgeCleared(name string) bool {
+func (m *RecoveryCodeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case child.EdgeUser:
+	case recoverycode.EdgeUser:
 		return m.cleareduser
-	case child.EdgeChildFilters:
-		return m.clearedchild_filters
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChildMutation) ClearEdge(name string) error {
+func (m *RecoveryCodeMutation) ClearEdge(name string) error {
 	switch name {
-	case child.EdgeUser:
+	case recoverycode.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Child unique edge %s", name)
+	return fmt.Errorf("unknown RecoveryCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChildMutation) ResetEdge(name string) error {
+func (m *RecoveryCodeMutation) ResetEdge(name string) error {
 	switch name {
-	case child.EdgeUser:
+	case recoverycode.EdgeUser:
 		m.ResetUser()
 		return nil
-	case child.EdgeChildFilters:
-		m.ResetChildFilters()
-		return nil
 	}
-	return fmt.Errorf("unknown Child edge %s", name)
+	return fmt.Errorf("unknown RecoveryCode edge %s", name)
 }
 
-// ChildFilterMutation represents an operation that mutates the ChildFilter nodes in the graph.
-type ChildFilterMutation struct {
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	value         *string
+	token_hash    *string
+	family_id     *uuid.UUID
+	expires_at    *time.Time
+	revoked_at    *time.Time
+	created_at    *time.Time
 	clearedFields map[string]struct{}
-	child         *int
-	clearedchild  bool
-	filter        *int
-	clearedfilter bool
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*ChildFilter, error)
-	predicates    []predicate.ChildFilter
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
 }
 
-var _ ent.Mutation = (*ChildFilterMutation)(nil)
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
 
-// childfilterOption allows management of the mutation configuration using functional options.
-type childfilterOption func(*ChildFilterMutation)
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
 
-// newChildFilterMutation creates new mutation for the ChildFilter entity.
-func newChildFilterMutation(c config, op Op, opts ...childfilterOption) *ChildFilterMutation {
-	m := &ChildFilterMutation{
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChildFilter,
+		typ:           TypeRefreshToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3593,21 +9921,21 @@ func newChildFilterMutation(c config, op Op, opts ...childfilterOption) *ChildFi
 	}
 	return m
 }
-
-// withChildFilterID sets the ID field of the mutation.
-func withChildFilterID(id int) childfilterOption {
-	return func(m *ChildFilterMutation) {
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id int) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChildFilter
+			value *RefreshToken
 		)
-		m.oldValue = func(ctx context.Context) (*ChildFilter, error) {
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChildFilter.Get(ctx, id)
+					value, err = m.Client().RefreshToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3616,10 +9944,10 @@ func withChildFilterID(id int) childfilterOption {
 	}
 }
 
-// withChildFilter sets the old ChildFilter of the mutation.
-func withChildFilter(node *ChildFilter) childfilterOption {
-	return func(m *ChildFilterMutation) {
-		m.oldValue = func(context.Context) (*ChildFilter, error) {
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3628,7 +9956,7 @@ func withChildFilter(node *ChildFilter) childfilterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChildFilterMutation) Client() *Client {
+func (m RefreshTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3636,7 +9964,7 @@ func (m ChildFilterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChildFilterMutation) Tx() (*Tx, error) {
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3647,7 +9975,7 @@ func (m ChildFilterMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChildFilterMutation) ID() (id int, exists bool) {
+func (m *RefreshTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3658,7 +9986,7 @@ func (m *ChildFilterMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChildFilterMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3667,183 +9995,277 @@ func (m *ChildFilterMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChildFilter.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChildID sets the "child_id" field.
-func (m *ChildFilterMutation) SetChildID(i int) {
-	m.child = &i
+// SetTokenHash sets the "token_hash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// ChildID returns the value of the "child_id" field in the mutation.
-func (m *ChildFilterMutation) ChildID() (r int, exists bool) {
-	v := m.child
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChildID returns the old "child_id" field's value of the ChildFilter entity.
-// If the ChildFilter object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildFilterMutation) OldChildID(ctx context.Context) (v int, err error) {
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChildID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChildID requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChildID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.ChildID, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetChildID resets all changes to the "child_id" field.
-func (m *ChildFilterMutation) ResetChildID() {
-	m.child = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetFilterID sets the "filter_id" field.
-func (m *ChildFilterMutation) SetFilterID(i int) {
-	m.filter = &i
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// FilterID returns the value of the "filter_id" field in the mutation.
-func (m *ChildFilterMutation) FilterID() (r int, exists bool) {
-	v := m.filter
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilterID returns the old "filter_id" field's value of the ChildFilter entity.
-// If the ChildFilter object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildFilterMutation) OldFilterID(ctx context.Context) (v int, err error) {
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilterID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.FilterID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetFilterID resets all changes to the "filter_id" field.
-func (m *ChildFilterMutation) ResetFilterID() {
-	m.filter = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetValue sets the "value" field.
-func (m *ChildFilterMutation) SetValue(s string) {
-	m.value = &s
+// SetFamilyID sets the "family_id" field.
+func (m *RefreshTokenMutation) SetFamilyID(u uuid.UUID) {
+	m.family_id = &u
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ChildFilterMutation) Value() (r string, exists bool) {
-	v := m.value
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RefreshTokenMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the ChildFilter entity.
-// If the ChildFilter object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilyID returns the old "family_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChildFilterMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *RefreshTokenMutation) OldFamilyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.FamilyID, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ChildFilterMutation) ResetValue() {
-	m.value = nil
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
 }
 
-// ClearChild clears the "child" edge to the Child entity.
-func (m *ChildFilterMutation) ClearChild() {
-	m.clearedchild = true
-	m.clearedFields[childfilter.FieldChildID] = struct{}{}
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// ChildCleared reports if the "child" edge to the Child entity was cleared.
-func (m *ChildFilterMutation) ChildCleared() bool {
-	return m.clearedchild
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ChildIDs returns the "child" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChildID instead. It exists only for internal usage by the builders.
-func (m *ChildFilterMutation) ChildIDs() (ids []int) {
-	if id := m.child; id != nil {
-		ids = append(ids, *id)
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetChild resets all changes to the "child" edge.
-func (m *ChildFilterMutation) ResetChild() {
-	m.child = nil
-	m.clearedchild = false
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// ClearFilter clears the "filter" edge to the Filter entity.
-func (m *ChildFilterMutation) ClearFilter() {
-	m.clearedfilter = true
-	m.clearedFields[childfilter.FieldFilterID] = struct{}{}
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// FilterCleared reports if the "filter" edge to the Filter entity was cleared.
-func (m *ChildFilterMutation) FilterCleared() bool {
-	return m.clearedfilter
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FilterIDs returns the "filter" edge IDs in the mutation.
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RefreshTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[refreshtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FilterID instead. It exists only for internal usage by the builders.
-func (m *ChildFilterMutation) FilterIDs() (ids []int) {
-	if id := m.filter; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFilter resets all changes to the "filter" edge.
-func (m *ChildFilterMutation) ResetFilter() {
-	m.filter = nil
-	m.clearedfilter = false
+// ResetUser resets all changes to the "user" edge.
+func (m *RefreshTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the ChildFilterMutation builder.
-func (m *ChildFilterMutation) Where(ps ...predicate.ChildFilter) {
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChildFilterMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChildFilterMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChildFilter, len(ps))
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3851,33 +10273,42 @@ func (m *ChildFilterMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChildFilterMutation) Op() Op {
+func (m *RefreshTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChildFilterMutation) SetOp(op Op) {
+func (m *RefreshTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChildFilter).
-func (m *ChildFilterMutation) Type() string {
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChildFilterMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.child != nil {
-		fields = append(fields, childfilter.FieldChildID)
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.token_hash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
 	}
-	if m.filter != nil {
-		fields = append(fields, childfilter.FieldFilterID)
+	if m.user != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
 	}
-	if m.value != nil {
-		fields = append(fields, childfilter.FieldValue)
+	if m.family_id != nil {
+		fields = append(fields, refreshtoken.FieldFamilyID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3885,14 +10316,20 @@ func (m *ChildFilterMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChildFilterMutation) Field(name string) (ent.Value, bool) {
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case childfilter.FieldChildID:
-		return m.ChildID()
-	case childfilter.FieldFilterID:
-		return m.FilterID()
-	case childfilter.FieldValue:
-		return m.Value()
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3900,51 +10337,78 @@ func (m *ChildFilterMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChildFilterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case childfilter.FieldChildID:
-		return m.OldChildID(ctx)
-	case childfilter.FieldFilterID:
-		return m.OldFilterID(ctx)
-	case childfilter.FieldValue:
-		return m.OldValue(ctx)
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChildFilter field %s", name)
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChildFilterMutation) SetField(name string, value ent.Value) error {
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case childfilter.FieldChildID:
-		v, ok := value.(int)
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChildID(v)
+		m.SetTokenHash(v)
 		return nil
-	case childfilter.FieldFilterID:
+	case refreshtoken.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilterID(v)
+		m.SetUserID(v)
 		return nil
-	case childfilter.FieldValue:
-		v, ok := value.(string)
+	case refreshtoken.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetFamilyID(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChildFilter field %s", name)
+	return fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChildFilterMutation) AddedFields() []string {
+func (m *RefreshTokenMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -3952,7 +10416,7 @@ func (m *ChildFilterMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChildFilterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -3961,70 +10425,81 @@ func (m *ChildFilterMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChildFilterMutation) AddField(name string, value ent.Value) error {
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ChildFilter numeric field %s", name)
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChildFilterMutation) ClearedFields() []string {
-	return nil
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChildFilterMutation) FieldCleared(name string) bool {
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChildFilterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ChildFilter nullable field %s", name)
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChildFilterMutation) ResetField(name string) error {
+func (m *RefreshTokenMutation) ResetField(name string) error {
 	switch name {
-	case childfilter.FieldChildID:
-		m.ResetChildID()
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case childfilter.FieldFilterID:
-		m.ResetFilterID()
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case childfilter.FieldValue:
-		m.ResetValue()
+	case refreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ChildFilter field %s", name)
+	return fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChildFilterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.child != nil {
-		edges = append(edges, childfilter.EdgeChild)
-	}
-	if m.filter != nil {
-		edges = append(edges, childfilter.EdgeFilter)
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, refreshtoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChildFilterMutation) AddedIDs(name string) []ent.Value {
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case childfilter.EdgeChild:
-		if id := m.child; id != nil {
-			return []ent.Value{*id}
-		}
-	case childfilter.EdgeFilter:
-		if id := m.filter; id != nil {
+	case refreshtoken.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4032,106 +10507,92 @@ func (m *ChildFilterMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChildFilterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChildFilterMutation) RemovedIDs(name string) []ent.Value {
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChildFilterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedchild {
-		edges = append(edges, childfilter.EdgeChild)
-	}
-	if m.clearedfilter {
-		edges = append(edges, childfilter.EdgeFilter)
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, refreshtoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChildFilterMutation) EdgeCleared(name string) bool {
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case childfilter.EdgeChild:
-		return m.clearedchild
-	case childfilter.EdgeFilter:
-		return m.clearedfilter
+	case refreshtoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChildFilterMutation) ClearEdge(name string) error {
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case childfilter.EdgeChild:
-		m.ClearChild()
-		return nil
-	case childfilter.EdgeFilter:
-		m.ClearFilter()
+	case refreshtoken.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ChildFilter unique edge %s", name)
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChildFilterMutation) ResetEdge(name string) error {
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case childfilter.EdgeChild:
-		m.ResetChild()
-		return nil
-	case childfilter.EdgeFilter:
-		m.ResetFilter()
+	case refreshtoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ChildFilter edge %s", name)
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
 }
 
-// FilterMutation represents an operation that mutates the Filter nodes in the graph.
-type FilterMutation struct {
+// RuleGroupMutation represents an operation that mutates the RuleGroup nodes in the graph.
+type RuleGroupMutation struct {
 	config
 	op                     Op
 	typ                    string
 	id                     *int
-	name                   *string
-	hint                   *string
-	_type                  *filter.Type
-	values                 *[]string
-	appendvalues           []string
+	operator               *rulegroup.Operator
 	clearedFields          map[string]struct{}
-	user_filters           map[int]struct{}
-	removeduser_filters    map[int]struct{}
-	cleareduser_filters    bool
+	benefit                *int
+	clearedbenefit         bool
+	parent                 *int
+	clearedparent          bool
+	children               map[int]struct{}
+	removedchildren        map[int]struct{}
+	clearedchildren        bool
 	benefit_filters        map[int]struct{}
 	removedbenefit_filters map[int]struct{}
 	clearedbenefit_filters bool
-	child_filters          map[int]struct{}
-	removedchild_filters   map[int]struct{}
-	clearedchild_filters   bool
 	done                   bool
-	oldValue               func(context.Context) (*Filter, error)
-	predicates             []predicate.Filter
+	oldValue               func(context.Context) (*RuleGroup, error)
+	predicates             []predicate.RuleGroup
 }
 
-var _ ent.Mutation = (*FilterMutation)(nil)
+var _ ent.Mutation = (*RuleGroupMutation)(nil)
 
-// filterOption allows management of the mutation configuration using functional options.
-type filterOption func(*FilterMutation)
+// rulegroupOption allows management of the mutation configuration using functional options.
+type rulegroupOption func(*RuleGroupMutation)
 
-// newFilterMutation creates new mutation for the Filter entity.
-func newFilterMutation(c config, op Op, opts ...filterOption) *FilterMutation {
-	m := &FilterMutation{
+// newRuleGroupMutation creates new mutation for the RuleGroup entity.
+func newRuleGroupMutation(c config, op Op, opts ...rulegroupOption) *RuleGroupMutation {
+	m := &RuleGroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFilter,
+		typ:           TypeRuleGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4140,20 +10601,20 @@ func newFilterMutation(c config, op Op, opts ...filterOption) *FilterMutation {
 	return m
 }
 
-// withFilterID sets the ID field of the mutation.
-func withFilterID(id int) filterOption {
-	return func(m *FilterMutation) {
+// withRuleGroupID sets the ID field of the mutation.
+func withRuleGroupID(id int) rulegroupOption {
+	return func(m *RuleGroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Filter
+			value *RuleGroup
 		)
-		m.oldValue = func(ctx context.Context) (*Filter, error) {
+		m.oldValue = func(ctx context.Context) (*RuleGroup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Filter.Get(ctx, id)
+					value, err = m.Client().RuleGroup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4162,10 +10623,10 @@ func withFilterID(id int) filterOption {
 	}
 }
 
-// withFilter sets the old Filter of the mutation.
-func withFilter(node *Filter) filterOption {
-	return func(m *FilterMutation) {
-		m.oldValue = func(context.Context) (*Filter, error) {
+// withRuleGroup sets the old RuleGroup of the mutation.
+func withRuleGroup(node *RuleGroup) rulegroupOption {
+	return func(m *RuleGroupMutation) {
+		m.oldValue = func(context.Context) (*RuleGroup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4174,7 +10635,7 @@ func withFilter(node *Filter) filterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FilterMutation) Client() *Client {
+func (m RuleGroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4182,7 +10643,7 @@ func (m FilterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FilterMutation) Tx() (*Tx, error) {
+func (m RuleGroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4193,7 +10654,7 @@ func (m FilterMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FilterMutation) ID() (id int, exists bool) {
+func (m *RuleGroupMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4204,7 +10665,7 @@ func (m *FilterMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FilterMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RuleGroupMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4213,240 +10674,243 @@ func (m *FilterMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Filter.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RuleGroup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FilterMutation) SetName(s string) {
-	m.name = &s
+// SetBenefitID sets the "benefit_id" field.
+func (m *RuleGroupMutation) SetBenefitID(i int) {
+	m.benefit = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FilterMutation) Name() (r string, exists bool) {
-	v := m.name
+// BenefitID returns the value of the "benefit_id" field in the mutation.
+func (m *RuleGroupMutation) BenefitID() (r int, exists bool) {
+	v := m.benefit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Filter entity.
-// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// OldBenefitID returns the old "benefit_id" field's value of the RuleGroup entity.
+// If the RuleGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FilterMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RuleGroupMutation) OldBenefitID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldBenefitID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldBenefitID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldBenefitID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.BenefitID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FilterMutation) ResetName() {
-	m.name = nil
+// ResetBenefitID resets all changes to the "benefit_id" field.
+func (m *RuleGroupMutation) ResetBenefitID() {
+	m.benefit = nil
 }
 
-// SetHint sets the "hint" field.
-func (m *FilterMutation) SetHint(s string) {
-	m.hint = &s
+// SetParentID sets the "parent_id" field.
+func (m *RuleGroupMutation) SetParentID(i int) {
+	m.parent = &i
 }
 
-// Hint returns the value of the "hint" field in the mutation.
-func (m *FilterMutation) Hint() (r string, exists bool) {
-	v := m.hint
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *RuleGroupMutation) ParentID() (r int, exists bool) {
+	v := m.parent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHint returns the old "hint" field's value of the Filter entity.
-// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the RuleGroup entity.
+// If the RuleGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FilterMutation) OldHint(ctx context.Context) (v *string, err error) {
+func (m *RuleGroupMutation) OldParentID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHint requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.Hint, nil
+	return oldValue.ParentID, nil
 }
 
-// ClearHint clears the value of the "hint" field.
-func (m *FilterMutation) ClearHint() {
-	m.hint = nil
-	m.clearedFields[filter.FieldHint] = struct{}{}
+// ClearParentID clears the value of the "parent_id" field.
+func (m *RuleGroupMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[rulegroup.FieldParentID] = struct{}{}
 }
 
-// HintCleared returns if the "hint" field was cleared in this mutation.
-func (m *FilterMutation) HintCleared() bool {
-	_, ok := m.clearedFields[filter.FieldHint]
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *RuleGroupMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[rulegroup.FieldParentID]
 	return ok
 }
 
-// ResetHint resets all changes to the "hint" field.
-func (m *FilterMutation) ResetHint() {
-	m.hint = nil
-	delete(m.clearedFields, filter.FieldHint)
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *RuleGroupMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, rulegroup.FieldParentID)
 }
 
-// SetType sets the "type" field.
-func (m *FilterMutation) SetType(f filter.Type) {
-	m._type = &f
+// SetOperator sets the "operator" field.
+func (m *RuleGroupMutation) SetOperator(r rulegroup.Operator) {
+	m.operator = &r
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *FilterMutation) GetType() (r filter.Type, exists bool) {
-	v := m._type
+// Operator returns the value of the "operator" field in the mutation.
+func (m *RuleGroupMutation) Operator() (r rulegroup.Operator, exists bool) {
+	v := m.operator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Filter entity.
-// If the Filter object wasn't provided to the builder, the object is fetched from the database.
+// OldOperator returns the old "operator" field's value of the RuleGroup entity.
+// If the RuleGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FilterMutation) OldType(ctx context.Context) (v filter.Type, err error) {
+func (m *RuleGroupMutation) OldOperator(ctx context.Context) (v rulegroup.Operator, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperator is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldOperator requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperator: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Operator, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *FilterMutation) ResetType() {
-	m._type = nil
+// ResetOperator resets all changes to the "operator" field.
+func (m *RuleGroupMutation) ResetOperator() {
+	m.operator = nil
 }
 
-// SetValues sets the "values" field.
-func (m *FilterMutation) SetValues(s []string) {
-	m.values = &s
-	m.appendvalues = nil
+// ClearBenefit clears the "benefit" edge to the Benefit entity.
+func (m *RuleGroupMutation) ClearBenefit() {
+	m.clearedbenefit = true
+	m.clearedFields[rulegroup.FieldBenefitID] = struct{}{}
 }
 
-// Values returns the value of the "values" field in the mutation.
-func (m *FilterMutation) Values() (r []string, exists bool) {
-	v := m.values
-	if v == nil {
-		return
-	}
-	return *v, true
+// BenefitCleared reports if the "benefit" edge to the Benefit entity was cleared.
+func (m *RuleGroupMutation) BenefitCleared() bool {
+	return m.clearedbenefit
 }
 
-// OldValues returns the old "values" field's value of the Filter entity.
-// If the Filter object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FilterMutation) OldValues(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValues is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValues requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValues: %w", err)
+// BenefitIDs returns the "benefit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BenefitID instead. It exists only for internal usage by the builders.
+func (m *RuleGroupMutation) BenefitIDs() (ids []int) {
+	if id := m.benefit; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Values, nil
+	return
 }
 
-// AppendValues adds s to the "values" field.
-func (m *FilterMutation) AppendValues(s []string) {
-	m.appendvalues = append(m.appendvalues, s...)
+// ResetBenefit resets all changes to the "benefit" edge.
+func (m *RuleGroupMutation) ResetBenefit() {
+	m.benefit = nil
+	m.clearedbenefit = false
 }
 
-// AppendedValues returns the list of values that were appended to the "values" field in this mutation.
-func (m *FilterMutation) AppendedValues() ([]string, bool) {
-	if len(m.appendvalues) == 0 {
-		return nil, false
+// ClearParent clears the "parent" edge to the RuleGroup entity.
+func (m *RuleGroupMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[rulegroup.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the RuleGroup entity was cleared.
+func (m *RuleGroupMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *RuleGroupMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
-	return m.appendvalues, true
+	return
 }
 
-// ResetValues resets all changes to the "values" field.
-func (m *FilterMutation) ResetValues() {
-	m.values = nil
-	m.appendvalues = nil
+// ResetParent resets all changes to the "parent" edge.
+func (m *RuleGroupMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// AddUserFilterIDs adds the "user_filters" edge to the UserFilter entity by ids.
-func (m *FilterMutation) AddUserFilterIDs(ids ...int) {
-	if m.user_filters == nil {
-		m.user_filters = make(map[int]struct{})
+// AddChildIDs adds the "children" edge to the RuleGroup entity by ids.
+func (m *RuleGroupMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.user_filters[ids[i]] = struct{}{}
+		m.children[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUserFilters clears the "user_filters" edge to the UserFilter entity.
-func (m *FilterMutation) ClearUserFilters() {
-	m.cleareduser_filters = true
-}
-
-// UserFiltersCleared reports if the "user_filters" edge to the UserFilter entity was cleared.
-func (m *FilterMutation) UserFiltersCleared() bool {
-	return m.cleareduser_filters
+// ClearChildren clears the "children" edge to the RuleGroup entity.
+func (m *RuleGroupMutation) ClearChildren() {
+	m.clearedchildren = true
 }
 
-// RemoveUserFilterIDs removes the "user_filters" edge to the UserFilter entity by IDs.
-func (m *FilterMutation) RemoveUserFilterIDs(ids ...int) {
-	if m.removeduser_filters == nil {
-		m.removeduser_filters = make(map[int]struct{})
+// ChildrenCleared reports if the "children" edge to the RuleGroup entity was cleared.
+func (m *RuleGroupMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the RuleGroup entity by IDs.
+func (m *RuleGroupMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.user_filters, ids[i])
-		m.removeduser_filters[ids[i]] = struct{}{}
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUserFilters returns the removed IDs of the "user_filters" edge to the UserFilter entity.
-func (m *FilterMutation) RemovedUserFiltersIDs() (ids []int) {
-	for id := range m.removeduser_filters {
+// RemovedChildren returns the removed IDs of the "children" edge to the RuleGroup entity.
+func (m *RuleGroupMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UserFiltersIDs returns the "user_filters" edge IDs in the mutation.
-func (m *FilterMutation) UserFiltersIDs() (ids []int) {
-	for id := range m.user_filters {
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *RuleGroupMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUserFilters resets all changes to the "user_filters" edge.
-func (m *FilterMutation) ResetUserFilters() {
-	m.user_filters = nil
-	m.cleareduser_filters = false
-	m.removeduser_filters = nil
+// ResetChildren resets all changes to the "children" edge.
+func (m *RuleGroupMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
 }
 
 // AddBenefitFilterIDs adds the "benefit_filters" edge to the BenefitFilter entity by ids.
-func (m *FilterMutation) AddBenefitFilterIDs(ids ...int) {
+func (m *RuleGroupMutation) AddBenefitFilterIDs(ids ...int) {
 	if m.benefit_filters == nil {
 		m.benefit_filters = make(map[int]struct{})
 	}
@@ -4456,17 +10920,17 @@ func (m *FilterMutation) AddBenefitFilterIDs(ids ...int) {
 }
 
 // ClearBenefitFilters clears the "benefit_filters" edge to the BenefitFilter entity.
-func (m *FilterMutation) ClearBenefitFilters() {
+func (m *RuleGroupMutation) ClearBenefitFilters() {
 	m.clearedbenefit_filters = true
 }
 
 // BenefitFiltersCleared reports if the "benefit_filters" edge to the BenefitFilter entity was cleared.
-func (m *FilterMutation) BenefitFiltersCleared() bool {
+func (m *RuleGroupMutation) BenefitFiltersCleared() bool {
 	return m.clearedbenefit_filters
 }
 
 // RemoveBenefitFilterIDs removes the "benefit_filters" edge to the BenefitFilter entity by IDs.
-func (m *FilterMutation) RemoveBenefitFilterIDs(ids ...int) {
+func (m *RuleGroupMutation) RemoveBenefitFilterIDs(ids ...int) {
 	if m.removedbenefit_filters == nil {
 		m.removedbenefit_filters = make(map[int]struct{})
 	}
@@ -4477,7 +10941,7 @@ func (m *FilterMutation) RemoveBenefitFilterIDs(ids ...int) {
 }
 
 // RemovedBenefitFilters returns the removed IDs of the "benefit_filters" edge to the BenefitFilter entity.
-func (m *FilterMutation) RemovedBenefitFiltersIDs() (ids []int) {
+func (m *RuleGroupMutation) RemovedBenefitFiltersIDs() (ids []int) {
 	for id := range m.removedbenefit_filters {
 		ids = append(ids, id)
 	}
@@ -4485,7 +10949,7 @@ func (m *FilterMutation) RemovedBenefitFiltersIDs() (ids []int) {
 }
 
 // BenefitFiltersIDs returns the "benefit_filters" edge IDs in the mutation.
-func (m *FilterMutation) BenefitFiltersIDs() (ids []int) {
+func (m *RuleGroupMutation) BenefitFiltersIDs() (ids []int) {
 	for id := range m.benefit_filters {
 		ids = append(ids, id)
 	}
@@ -4493,75 +10957,21 @@ func (m *FilterMutation) BenefitFiltersIDs() (ids []int) {
 }
 
 // ResetBenefitFilters resets all changes to the "benefit_filters" edge.
-func (m *FilterMutation) ResetBenefitFilters() {
+func (m *RuleGroupMutation) ResetBenefitFilters() {
 	m.benefit_filters = nil
 	m.clearedbenefit_filters = false
 	m.removedbenefit_filters = nil
 }
 
-// AddChildFilterIDs adds the "child_filters" edge to the ChildFilter entity by ids.
-func (m *FilterMutation) AddChildFilterIDs(ids ...int) {
-	if m.child_filters == nil {
-		m.child_filters = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.child_filters[ids[i]] = struct{}{}
-	}
-}
-
-// ClearChildFilters clears the "child_filters" edge to the ChildFilter entity.
-func (m *FilterMutation) ClearChildFilters() {
-	m.clearedchild_filters = true
-}
-
-// ChildFiltersCleared reports if the "child_filters" edge to the ChildFilter entity was cleared.
-func (m *FilterMutation) ChildFiltersCleared() bool {
-	return m.clearedchild_filters
-}
-
-// RemoveChildFilterIDs removes the "child_filters" edge to the ChildFilter entity by IDs.
-func (m *FilterMutation) RemoveChildFilterIDs(ids ...int) {
-	if m.removedchild_filters == nil {
-		m.removedchild_filters = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.child_filters, ids[i])
-		m.removedchild_filters[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedChildFilters returns the removed IDs of the "child_filters" edge to the ChildFilter entity.
-func (m *FilterMutation) RemovedChildFiltersIDs() (ids []int) {
-	for id := range m.removedchild_filters {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ChildFiltersIDs returns the "child_filters" edge IDs in the mutation.
-func (m *FilterMutation) ChildFiltersIDs() (ids []int) {
-	for id := range m.child_filters {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetChildFilters resets all changes to the "child_filters" edge.
-func (m *FilterMutation) ResetChildFilters() {
-	m.child_filters = nil
-	m.clearedchild_filters = false
-	m.removedchild_filters = nil
-}
-
-// Where appends a list predicates to the FilterMutation builder.
-func (m *FilterMutation) Where(ps ...predicate.Filter) {
+// Where appends a list predicates to the RuleGroupMutation builder.
+func (m *RuleGroupMutation) Where(ps ...predicate.RuleGroup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FilterMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RuleGroupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FilterMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Filter, len(ps))
+func (m *RuleGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RuleGroup, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4569,36 +10979,33 @@ func (m *FilterMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FilterMutation) Op() Op {
+func (m *RuleGroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FilterMutation) SetOp(op Op) {
+func (m *RuleGroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Filter).
-func (m *FilterMutation) Type() string {
+// Type returns the node type of this mutation (RuleGroup).
+func (m *RuleGroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FilterMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, filter.FieldName)
-	}
-	if m.hint != nil {
-		fields = append(fields, filter.FieldHint)
+func (m *RuleGroupMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.benefit != nil {
+		fields = append(fields, rulegroup.FieldBenefitID)
 	}
-	if m._type != nil {
-		fields = append(fields, filter.FieldType)
+	if m.parent != nil {
+		fields = append(fields, rulegroup.FieldParentID)
 	}
-	if m.values != nil {
-		fields = append(fields, filter.FieldValues)
+	if m.operator != nil {
+		fields = append(fields, rulegroup.FieldOperator)
 	}
 	return fields
 }
@@ -4606,16 +11013,14 @@ func (m *FilterMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FilterMutation) Field(name string) (ent.Value, bool) {
+func (m *RuleGroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case filter.FieldName:
-		return m.Name()
-	case filter.FieldHint:
-		return m.Hint()
-	case filter.FieldType:
-		return m.GetType()
-	case filter.FieldValues:
-		return m.Values()
+	case rulegroup.FieldBenefitID:
+		return m.BenefitID()
+	case rulegroup.FieldParentID:
+		return m.ParentID()
+	case rulegroup.FieldOperator:
+		return m.Operator()
 	}
 	return nil, false
 }
@@ -4623,285 +11028,304 @@ func (m *FilterMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FilterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RuleGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case filter.FieldName:
-		return m.OldName(ctx)
-	case filter.FieldHint:
-		return m.OldHint(ctx)
-	case filter.FieldType:
-		return m.OldType(ctx)
-	case filter.FieldValues:
-		return m.OldValues(ctx)
+	case rulegroup.FieldBenefitID:
+		return m.OldBenefitID(ctx)
+	case rulegroup.FieldParentID:
+		return m.OldParentID(ctx)
+	case rulegroup.FieldOperator:
+		return m.OldOperator(ctx)
 	}
-	return nil, fmt.Errorf("unknown Filter field %s", name)
+	return nil, fmt.Errorf("unknown RuleGroup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FilterMutation) SetField(name string, value ent.Value) error {
+func (m *RuleGroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case filter.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case filter.FieldHint:
-		v, ok := value.(string)
+	case rulegroup.FieldBenefitID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHint(v)
+		m.SetBenefitID(v)
 		return nil
-	case filter.FieldType:
-		v, ok := value.(filter.Type)
+	case rulegroup.FieldParentID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetParentID(v)
 		return nil
-	case filter.FieldValues:
-		v, ok := value.([]string)
+	case rulegroup.FieldOperator:
+		v, ok := value.(rulegroup.Operator)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValues(v)
+		m.SetOperator(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Filter field %s", name)
+	return fmt.Errorf("unknown RuleGroup field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FilterMutation) AddedFields() []string {
-	return nil
+func (m *RuleGroupMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FilterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RuleGroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FilterMutation) AddField(name string, value ent.Value) error {
+func (m *RuleGroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Filter numeric field %s", name)
+	return fmt.Errorf("unknown RuleGroup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FilterMutation) ClearedFields() []string {
+func (m *RuleGroupMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(filter.FieldHint) {
-		fields = append(fields, filter.FieldHint)
+	if m.FieldCleared(rulegroup.FieldParentID) {
+		fields = append(fields, rulegroup.FieldParentID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FilterMutation) FieldCleared(name string) bool {
+func (m *RuleGroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FilterMutation) ClearField(name string) error {
+func (m *RuleGroupMutation) ClearField(name string) error {
 	switch name {
-	case filter.FieldHint:
-		m.ClearHint()
+	case rulegroup.FieldParentID:
+		m.ClearParentID()
 		return nil
 	}
-	return fmt.Errorf("unknown Filter nullable field %s", name)
+	return fmt.Errorf("unknown RuleGroup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FilterMutation) ResetField(name string) error {
+func (m *RuleGroupMutation) ResetField(name string) error {
 	switch name {
-	case filter.FieldName:
-		m.ResetName()
-		return nil
-	case filter.FieldHint:
-		m.ResetHint()
+	case rulegroup.FieldBenefitID:
+		m.ResetBenefitID()
 		return nil
-	case filter.FieldType:
-		m.ResetType()
+	case rulegroup.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case filter.FieldValues:
-		m.ResetValues()
+	case rulegroup.FieldOperator:
+		m.ResetOperator()
 		return nil
 	}
-	return fmt.Errorf("unknown Filter field %s", name)
+	return fmt.Errorf("unknown RuleGroup field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FilterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user_filters != nil {
-		edges = append(edges, filter.EdgeUserFilters)
+func (m *RuleGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.benefit != nil {
+		edges = append(edges, rulegroup.EdgeBenefit)
 	}
-	if m.benefit_filters != nil {
-		edges = append(edges, filter.EdgeBenefitFilters)
+	if m.parent != nil {
+		edges = append(edges, rulegroup.EdgeParent)
 	}
-	if m.child_filters != nil {
-		edges = append(edges, filter.EdgeChildFilters)
+	if m.children != nil {
+		edges = append(edges, rulegroup.EdgeChildren)
+	}
+	if m.benefit_filters != nil {
+		edges = append(edges, rulegroup.EdgeBenefitFilters)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FilterMutation) AddedIDs(name string) []ent.Value {
+func (m *RuleGroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case filter.EdgeUserFilters:
-		ids := make([]ent.Value, 0, len(m.user_filters))
-		for id := range m.user_filters {
+	case rulegroup.EdgeBenefit:
+		if id := m.benefit; id != nil {
+			return []ent.Value{*id}
+		}
+	case rulegroup.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case rulegroup.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
 			ids = append(ids, id)
 		}
 		return ids
-	case filter.EdgeBenefitFilters:
+	case rulegroup.EdgeBenefitFilters:
 		ids := make([]ent.Value, 0, len(m.benefit_filters))
 		for id := range m.benefit_filters {
 			ids = append(ids, id)
 		}
 		return ids
-	case filter.EdgeChildFilters:
-		ids := make([]ent.Value, 0, len(m.child_filters))
-		for id := range m.child_filters {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FilterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removeduser_filters != nil {
-		edges = append(edges, filter.EdgeUserFilters)
+func (m *RuleGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, rulegroup.EdgeChildren)
 	}
 	if m.removedbenefit_filters != nil {
-		edges = append(edges, filter.EdgeBenefitFilters)
-	}
-	if m.removedchild_filters != nil {
-		edges = append(edges, filter.EdgeChildFilters)
+		edges = append(edges, rulegroup.EdgeBenefitFilters)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FilterMutation) RemovedIDs(name string) []ent.Value {
+func (m *RuleGroupMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case filter.EdgeUserFilters:
-		ids := make([]ent.Value, 0, len(m.removeduser_filters))
-		for id := range m.removeduser_filters {
+	case rulegroup.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
 			ids = append(ids, id)
 		}
 		return ids
-	case filter.EdgeBenefitFilters:
+	case rulegroup.EdgeBenefitFilters:
 		ids := make([]ent.Value, 0, len(m.removedbenefit_filters))
 		for id := range m.removedbenefit_filters {
 			ids = append(ids, id)
 		}
 		return ids
-	case filter.EdgeChildFilters:
-		ids := make([]ent.Value, 0, len(m.removedchild_filters))
-		for id := range m.removedchild_filters {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FilterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser_filters {
-		edges = append(edges, filter.EdgeUserFilters)
+func (m *RuleGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedbenefit {
+		edges = append(edges, rulegroup.EdgeBenefit)
 	}
-	if m.clearedbenefit_filters {
-		edges = append(edges, filter.EdgeBenefitFilters)
+	if m.clearedparent {
+		edges = append(edges, rulegroup.EdgeParent)
 	}
-	if m.clearedchild_filters {
-		edges = append(edges, filter.EdgeChildFilters)
+	if m.clearedchildren {
+		edges = append(edges, rulegroup.EdgeChildren)
+	}
+	if m.clearedbenefit_filters {
+		edges = append(edges, rulegroup.EdgeBenefitFilters)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FilterMutation) EdgeCleared(name string) bool {
+func (m *RuleGroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case filter.EdgeUserFilters:
-		return m.cleareduser_filters
-	case filter.EdgeBenefitFilters:
+	case rulegroup.EdgeBenefit:
+		return m.clearedbenefit
+	case rulegroup.EdgeParent:
+		return m.clearedparent
+	case rulegroup.EdgeChildren:
+		return m.clearedchildren
+	case rulegroup.EdgeBenefitFilters:
 		return m.clearedbenefit_filters
-	case filter.EdgeChildFilters:
-		return m.clearedchild_filters
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FilterMutation) ClearEdge(name string) error {
+func (m *RuleGroupMutation) ClearEdge(name string) error {
 	switch name {
+	case rulegroup.EdgeBenefit:
+		m.ClearBenefit()
+		return nil
+	case rulegroup.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown Filter unique edge %s", name)
+	return fmt.Errorf("unknown RuleGroup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FilterMutation) ResetEdge(name string) error {
+func (m *RuleGroupMutation) ResetEdge(name string) error {
 	switch name {
-	case filter.EdgeUserFilters:
-		m.ResetUserFilters()
+	case rulegroup.EdgeBenefit:
+		m.ResetBenefit()
 		return nil
-	case filter.EdgeBenefitFilters:
-		m.ResetBenefitFilters()
+	case rulegroup.EdgeParent:
+		m.ResetParent()
 		return nil
-	case filter.EdgeChildFilters:
-		m.ResetChildFilters()
+	case rulegroup.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case rulegroup.EdgeBenefitFilters:
+		m.ResetBenefitFilters()
 		return nil
 	}
-	return fmt.Errorf("unknown Filter edge %s", name)
+	return fmt.Errorf("unknown RuleGroup edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	first_name          *string
-	last_name           *string
-	birth_date          *time.Time
-	email               *string
-	password            *string
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	user_filters        map[int]struct{}
-	removeduser_filters map[int]struct{}
-	cleareduser_filters bool
-	children            map[int]struct{}
-	removedchildren     map[int]struct{}
-	clearedchildren     bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
+	op                         Op
+	typ                        string
+	id                         *int
+	first_name                 *string
+	last_name                  *string
+	birth_date                 *time.Time
+	email                      *string
+	email_verified_at          *time.Time
+	pending_email              *string
+	password                   *string
+	role                       *user.Role
+	locale                     *user.Locale
+	totp_secret                *string
+	totp_enabled_at            *time.Time
+	totp_last_step             *int64
+	addtotp_last_step          *int64
+	totp_required              *bool
+	created_at                 *time.Time
+	clearedFields              map[string]struct{}
+	user_filters               map[int]struct{}
+	removeduser_filters        map[int]struct{}
+	cleareduser_filters        bool
+	children                   map[int]struct{}
+	removedchildren            map[int]struct{}
+	clearedchildren            bool
+	refresh_tokens             map[int]struct{}
+	removedrefresh_tokens      map[int]struct{}
+	clearedrefresh_tokens      bool
+	email_verifications        map[int]struct{}
+	removedemail_verifications map[int]struct{}
+	clearedemail_verifications bool
+	recovery_codes             map[int]struct{}
+	removedrecovery_codes      map[int]struct{}
+	clearedrecovery_codes      bool
+	done                       bool
+	oldValue                   func(context.Context) (*User, error)
+	predicates                 []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -5057,142 +11481,502 @@ func (m *UserMutation) LastName() (r string, exists bool) {
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *UserMutation) ResetLastName() {
+	m.last_name = nil
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (m *UserMutation) SetBirthDate(t time.Time) {
+	m.birth_date = &t
+}
+
+// BirthDate returns the value of the "birth_date" field in the mutation.
+func (m *UserMutation) BirthDate() (r time.Time, exists bool) {
+	v := m.birth_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthDate returns the old "birth_date" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBirthDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthDate: %w", err)
+	}
+	return oldValue.BirthDate, nil
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (m *UserMutation) ClearBirthDate() {
+	m.birth_date = nil
+	m.clearedFields[user.FieldBirthDate] = struct{}{}
+}
+
+// BirthDateCleared returns if the "birth_date" field was cleared in this mutation.
+func (m *UserMutation) BirthDateCleared() bool {
+	_, ok := m.clearedFields[user.FieldBirthDate]
+	return ok
+}
+
+// ResetBirthDate resets all changes to the "birth_date" field.
+func (m *UserMutation) ResetBirthDate() {
+	m.birth_date = nil
+	delete(m.clearedFields, user.FieldBirthDate)
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
+}
+
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
+	}
+	return oldValue.EmailVerifiedAt, nil
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
+}
+
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
+}
+
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (m *UserMutation) SetPendingEmail(s string) {
+	m.pending_email = &s
+}
+
+// PendingEmail returns the value of the "pending_email" field in the mutation.
+func (m *UserMutation) PendingEmail() (r string, exists bool) {
+	v := m.pending_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingEmail returns the old "pending_email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPendingEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingEmail: %w", err)
+	}
+	return oldValue.PendingEmail, nil
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (m *UserMutation) ClearPendingEmail() {
+	m.pending_email = nil
+	m.clearedFields[user.FieldPendingEmail] = struct{}{}
+}
+
+// PendingEmailCleared returns if the "pending_email" field was cleared in this mutation.
+func (m *UserMutation) PendingEmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldPendingEmail]
+	return ok
+}
+
+// ResetPendingEmail resets all changes to the "pending_email" field.
+func (m *UserMutation) ResetPendingEmail() {
+	m.pending_email = nil
+	delete(m.clearedFields, user.FieldPendingEmail)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *UserMutation) SetLocale(u user.Locale) {
+	m.locale = &u
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *UserMutation) Locale() (r user.Locale, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLocale(ctx context.Context) (v user.Locale, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *UserMutation) ResetLocale() {
+	m.locale = nil
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
 	}
-	return oldValue.LastName, nil
+	return oldValue.TotpSecret, nil
 }
 
-// ResetLastName resets all changes to the "last_name" field.
-func (m *UserMutation) ResetLastName() {
-	m.last_name = nil
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
 }
 
-// SetBirthDate sets the "birth_date" field.
-func (m *UserMutation) SetBirthDate(t time.Time) {
-	m.birth_date = &t
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
 }
 
-// BirthDate returns the value of the "birth_date" field in the mutation.
-func (m *UserMutation) BirthDate() (r time.Time, exists bool) {
-	v := m.birth_date
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (m *UserMutation) SetTotpEnabledAt(t time.Time) {
+	m.totp_enabled_at = &t
+}
+
+// TotpEnabledAt returns the value of the "totp_enabled_at" field in the mutation.
+func (m *UserMutation) TotpEnabledAt() (r time.Time, exists bool) {
+	v := m.totp_enabled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBirthDate returns the old "birth_date" field's value of the User entity.
+// OldTotpEnabledAt returns the old "totp_enabled_at" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBirthDate(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpEnabledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpEnabledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthDate requires an ID field in the mutation")
+		return v, errors.New("OldTotpEnabledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpEnabledAt: %w", err)
 	}
-	return oldValue.BirthDate, nil
+	return oldValue.TotpEnabledAt, nil
 }
 
-// ClearBirthDate clears the value of the "birth_date" field.
-func (m *UserMutation) ClearBirthDate() {
-	m.birth_date = nil
-	m.clearedFields[user.FieldBirthDate] = struct{}{}
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (m *UserMutation) ClearTotpEnabledAt() {
+	m.totp_enabled_at = nil
+	m.clearedFields[user.FieldTotpEnabledAt] = struct{}{}
 }
 
-// BirthDateCleared returns if the "birth_date" field was cleared in this mutation.
-func (m *UserMutation) BirthDateCleared() bool {
-	_, ok := m.clearedFields[user.FieldBirthDate]
+// TotpEnabledAtCleared returns if the "totp_enabled_at" field was cleared in this mutation.
+func (m *UserMutation) TotpEnabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpEnabledAt]
 	return ok
 }
 
-// ResetBirthDate resets all changes to the "birth_date" field.
-func (m *UserMutation) ResetBirthDate() {
-	m.birth_date = nil
-	delete(m.clearedFields, user.FieldBirthDate)
+// ResetTotpEnabledAt resets all changes to the "totp_enabled_at" field.
+func (m *UserMutation) ResetTotpEnabledAt() {
+	m.totp_enabled_at = nil
+	delete(m.clearedFields, user.FieldTotpEnabledAt)
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetTotpLastStep sets the "totp_last_step" field.
+func (m *UserMutation) SetTotpLastStep(i int64) {
+	m.totp_last_step = &i
+	m.addtotp_last_step = nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// TotpLastStep returns the value of the "totp_last_step" field in the mutation.
+func (m *UserMutation) TotpLastStep() (r int64, exists bool) {
+	v := m.totp_last_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
+// OldTotpLastStep returns the old "totp_last_step" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldTotpLastStep(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpLastStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldTotpLastStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpLastStep: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.TotpLastStep, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (m *UserMutation) AddTotpLastStep(i int64) {
+	if m.addtotp_last_step != nil {
+		*m.addtotp_last_step += i
+	} else {
+		m.addtotp_last_step = &i
+	}
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// AddedTotpLastStep returns the value that was added to the "totp_last_step" field in this mutation.
+func (m *UserMutation) AddedTotpLastStep() (r int64, exists bool) {
+	v := m.addtotp_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// ResetTotpLastStep resets all changes to the "totp_last_step" field.
+func (m *UserMutation) ResetTotpLastStep() {
+	m.totp_last_step = nil
+	m.addtotp_last_step = nil
+}
+
+// SetTotpRequired sets the "totp_required" field.
+func (m *UserMutation) SetTotpRequired(b bool) {
+	m.totp_required = &b
+}
+
+// TotpRequired returns the value of the "totp_required" field in the mutation.
+func (m *UserMutation) TotpRequired() (r bool, exists bool) {
+	v := m.totp_required
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
+// OldTotpRequired returns the old "totp_required" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldTotpRequired(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpRequired is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldTotpRequired requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpRequired: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.TotpRequired, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetTotpRequired resets all changes to the "totp_required" field.
+func (m *UserMutation) ResetTotpRequired() {
+	m.totp_required = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -5339,6 +12123,168 @@ func (m *UserMutation) ResetChildren() {
 	m.removedchildren = nil
 }
 
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...int) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
+}
+
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...int) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []int) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []int) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
+}
+
+// AddEmailVerificationIDs adds the "email_verifications" edge to the EmailVerification entity by ids.
+func (m *UserMutation) AddEmailVerificationIDs(ids ...int) {
+	if m.email_verifications == nil {
+		m.email_verifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.email_verifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEmailVerifications clears the "email_verifications" edge to the EmailVerification entity.
+func (m *UserMutation) ClearEmailVerifications() {
+	m.clearedemail_verifications = true
+}
+
+// EmailVerificationsCleared reports if the "email_verifications" edge to the EmailVerification entity was cleared.
+func (m *UserMutation) EmailVerificationsCleared() bool {
+	return m.clearedemail_verifications
+}
+
+// RemoveEmailVerificationIDs removes the "email_verifications" edge to the EmailVerification entity by IDs.
+func (m *UserMutation) RemoveEmailVerificationIDs(ids ...int) {
+	if m.removedemail_verifications == nil {
+		m.removedemail_verifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.email_verifications, ids[i])
+		m.removedemail_verifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEmailVerifications returns the removed IDs of the "email_verifications" edge to the EmailVerification entity.
+func (m *UserMutation) RemovedEmailVerificationsIDs() (ids []int) {
+	for id := range m.removedemail_verifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EmailVerificationsIDs returns the "email_verifications" edge IDs in the mutation.
+func (m *UserMutation) EmailVerificationsIDs() (ids []int) {
+	for id := range m.email_verifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEmailVerifications resets all changes to the "email_verifications" edge.
+func (m *UserMutation) ResetEmailVerifications() {
+	m.email_verifications = nil
+	m.clearedemail_verifications = false
+	m.removedemail_verifications = nil
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by ids.
+func (m *UserMutation) AddRecoveryCodeIDs(ids ...int) {
+	if m.recovery_codes == nil {
+		m.recovery_codes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recovery_codes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecoveryCodes clears the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.clearedrecovery_codes = true
+}
+
+// RecoveryCodesCleared reports if the "recovery_codes" edge to the RecoveryCode entity was cleared.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	return m.clearedrecovery_codes
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (m *UserMutation) RemoveRecoveryCodeIDs(ids ...int) {
+	if m.removedrecovery_codes == nil {
+		m.removedrecovery_codes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recovery_codes, ids[i])
+		m.removedrecovery_codes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecoveryCodes returns the removed IDs of the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) RemovedRecoveryCodesIDs() (ids []int) {
+	for id := range m.removedrecovery_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecoveryCodesIDs returns the "recovery_codes" edge IDs in the mutation.
+func (m *UserMutation) RecoveryCodesIDs() (ids []int) {
+	for id := range m.recovery_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" edge.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.clearedrecovery_codes = false
+	m.removedrecovery_codes = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -5373,7 +12319,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 14)
 	if m.first_name != nil {
 		fields = append(fields, user.FieldFirstName)
 	}
@@ -5386,9 +12332,33 @@ func (m *UserMutation) Fields() []string {
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.pending_email != nil {
+		fields = append(fields, user.FieldPendingEmail)
+	}
 	if m.password != nil {
 		fields = append(fields, user.FieldPassword)
 	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.locale != nil {
+		fields = append(fields, user.FieldLocale)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled_at != nil {
+		fields = append(fields, user.FieldTotpEnabledAt)
+	}
+	if m.totp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	if m.totp_required != nil {
+		fields = append(fields, user.FieldTotpRequired)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -5408,8 +12378,24 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.BirthDate()
 	case user.FieldEmail:
 		return m.Email()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
+	case user.FieldPendingEmail:
+		return m.PendingEmail()
 	case user.FieldPassword:
 		return m.Password()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldLocale:
+		return m.Locale()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabledAt:
+		return m.TotpEnabledAt()
+	case user.FieldTotpLastStep:
+		return m.TotpLastStep()
+	case user.FieldTotpRequired:
+		return m.TotpRequired()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -5429,8 +12415,24 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldBirthDate(ctx)
 	case user.FieldEmail:
 		return m.OldEmail(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
+	case user.FieldPendingEmail:
+		return m.OldPendingEmail(ctx)
 	case user.FieldPassword:
 		return m.OldPassword(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldLocale:
+		return m.OldLocale(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabledAt:
+		return m.OldTotpEnabledAt(ctx)
+	case user.FieldTotpLastStep:
+		return m.OldTotpLastStep(ctx)
+	case user.FieldTotpRequired:
+		return m.OldTotpRequired(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -5470,6 +12472,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEmail(v)
 		return nil
+	case user.FieldEmailVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerifiedAt(v)
+		return nil
+	case user.FieldPendingEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingEmail(v)
+		return nil
 	case user.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
@@ -5477,6 +12493,48 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPassword(v)
 		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldLocale:
+		v, ok := value.(user.Locale)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocale(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpEnabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpEnabledAt(v)
+		return nil
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpLastStep(v)
+		return nil
+	case user.FieldTotpRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpRequired(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -5491,13 +12549,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addtotp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTotpLastStep:
+		return m.AddedTotpLastStep()
+	}
 	return nil, false
 }
 
@@ -5506,6 +12572,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotpLastStep(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -5517,6 +12590,18 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldBirthDate) {
 		fields = append(fields, user.FieldBirthDate)
 	}
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.FieldCleared(user.FieldPendingEmail) {
+		fields = append(fields, user.FieldPendingEmail)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpEnabledAt) {
+		fields = append(fields, user.FieldTotpEnabledAt)
+	}
 	return fields
 }
 
@@ -5534,6 +12619,18 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldBirthDate:
 		m.ClearBirthDate()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
+		return nil
+	case user.FieldPendingEmail:
+		m.ClearPendingEmail()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpEnabledAt:
+		m.ClearTotpEnabledAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -5554,9 +12651,33 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldEmail:
 		m.ResetEmail()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
+		return nil
+	case user.FieldPendingEmail:
+		m.ResetPendingEmail()
+		return nil
 	case user.FieldPassword:
 		m.ResetPassword()
 		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldLocale:
+		m.ResetLocale()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabledAt:
+		m.ResetTotpEnabledAt()
+		return nil
+	case user.FieldTotpLastStep:
+		m.ResetTotpLastStep()
+		return nil
+	case user.FieldTotpRequired:
+		m.ResetTotpRequired()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -5566,13 +12687,22 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 5)
 	if m.user_filters != nil {
 		edges = append(edges, user.EdgeUserFilters)
 	}
 	if m.children != nil {
 		edges = append(edges, user.EdgeChildren)
 	}
+	if m.refresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.email_verifications != nil {
+		edges = append(edges, user.EdgeEmailVerifications)
+	}
+	if m.recovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
 	return edges
 }
 
@@ -5592,19 +12722,46 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refresh_tokens))
+		for id := range m.refresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEmailVerifications:
+		ids := make([]ent.Value, 0, len(m.email_verifications))
+		for id := range m.email_verifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.recovery_codes))
+		for id := range m.recovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 5)
 	if m.removeduser_filters != nil {
 		edges = append(edges, user.EdgeUserFilters)
 	}
 	if m.removedchildren != nil {
 		edges = append(edges, user.EdgeChildren)
 	}
+	if m.removedrefresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.removedemail_verifications != nil {
+		edges = append(edges, user.EdgeEmailVerifications)
+	}
+	if m.removedrecovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
 	return edges
 }
 
@@ -5624,19 +12781,46 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
+		for id := range m.removedrefresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEmailVerifications:
+		ids := make([]ent.Value, 0, len(m.removedemail_verifications))
+		for id := range m.removedemail_verifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.removedrecovery_codes))
+		for id := range m.removedrecovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 5)
 	if m.cleareduser_filters {
 		edges = append(edges, user.EdgeUserFilters)
 	}
 	if m.clearedchildren {
 		edges = append(edges, user.EdgeChildren)
 	}
+	if m.clearedrefresh_tokens {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.clearedemail_verifications {
+		edges = append(edges, user.EdgeEmailVerifications)
+	}
+	if m.clearedrecovery_codes {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
 	return edges
 }
 
@@ -5648,6 +12832,12 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.cleareduser_filters
 	case user.EdgeChildren:
 		return m.clearedchildren
+	case user.EdgeRefreshTokens:
+		return m.clearedrefresh_tokens
+	case user.EdgeEmailVerifications:
+		return m.clearedemail_verifications
+	case user.EdgeRecoveryCodes:
+		return m.clearedrecovery_codes
 	}
 	return false
 }
@@ -5670,6 +12860,15 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeChildren:
 		m.ResetChildren()
 		return nil
+	case user.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
+	case user.EdgeEmailVerifications:
+		m.ResetEmailVerifications()
+		return nil
+	case user.EdgeRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
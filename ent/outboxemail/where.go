@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outboxemail
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldID, id))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldKind, v))
+}
+
+// Recipient applies equality check predicate on the "recipient" field. It's identical to RecipientEQ.
+func Recipient(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldRecipient, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldSubject, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldBody, v))
+}
+
+// TextBody applies equality check predicate on the "text_body" field. It's identical to TextBodyEQ.
+func TextBody(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldTextBody, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldLastError, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContainsFold(FieldKind, v))
+}
+
+// RecipientEQ applies the EQ predicate on the "recipient" field.
+func RecipientEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldRecipient, v))
+}
+
+// RecipientNEQ applies the NEQ predicate on the "recipient" field.
+func RecipientNEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldRecipient, v))
+}
+
+// RecipientIn applies the In predicate on the "recipient" field.
+func RecipientIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldRecipient, vs...))
+}
+
+// RecipientNotIn applies the NotIn predicate on the "recipient" field.
+func RecipientNotIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldRecipient, vs...))
+}
+
+// RecipientGT applies the GT predicate on the "recipient" field.
+func RecipientGT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldRecipient, v))
+}
+
+// RecipientGTE applies the GTE predicate on the "recipient" field.
+func RecipientGTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldRecipient, v))
+}
+
+// RecipientLT applies the LT predicate on the "recipient" field.
+func RecipientLT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldRecipient, v))
+}
+
+// RecipientLTE applies the LTE predicate on the "recipient" field.
+func RecipientLTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldRecipient, v))
+}
+
+// RecipientContains applies the Contains predicate on the "recipient" field.
+func RecipientContains(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContains(FieldRecipient, v))
+}
+
+// RecipientHasPrefix applies the HasPrefix predicate on the "recipient" field.
+func RecipientHasPrefix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasPrefix(FieldRecipient, v))
+}
+
+// RecipientHasSuffix applies the HasSuffix predicate on the "recipient" field.
+func RecipientHasSuffix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasSuffix(FieldRecipient, v))
+}
+
+// RecipientEqualFold applies the EqualFold predicate on the "recipient" field.
+func RecipientEqualFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEqualFold(FieldRecipient, v))
+}
+
+// RecipientContainsFold applies the ContainsFold predicate on the "recipient" field.
+func RecipientContainsFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContainsFold(FieldRecipient, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContainsFold(FieldBody, v))
+}
+
+// TextBodyEQ applies the EQ predicate on the "text_body" field.
+func TextBodyEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldTextBody, v))
+}
+
+// TextBodyNEQ applies the NEQ predicate on the "text_body" field.
+func TextBodyNEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldTextBody, v))
+}
+
+// TextBodyIn applies the In predicate on the "text_body" field.
+func TextBodyIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldTextBody, vs...))
+}
+
+// TextBodyNotIn applies the NotIn predicate on the "text_body" field.
+func TextBodyNotIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldTextBody, vs...))
+}
+
+// TextBodyGT applies the GT predicate on the "text_body" field.
+func TextBodyGT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldTextBody, v))
+}
+
+// TextBodyGTE applies the GTE predicate on the "text_body" field.
+func TextBodyGTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldTextBody, v))
+}
+
+// TextBodyLT applies the LT predicate on the "text_body" field.
+func TextBodyLT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldTextBody, v))
+}
+
+// TextBodyLTE applies the LTE predicate on the "text_body" field.
+func TextBodyLTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldTextBody, v))
+}
+
+// TextBodyContains applies the Contains predicate on the "text_body" field.
+func TextBodyContains(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContains(FieldTextBody, v))
+}
+
+// TextBodyHasPrefix applies the HasPrefix predicate on the "text_body" field.
+func TextBodyHasPrefix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasPrefix(FieldTextBody, v))
+}
+
+// TextBodyHasSuffix applies the HasSuffix predicate on the "text_body" field.
+func TextBodyHasSuffix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasSuffix(FieldTextBody, v))
+}
+
+// TextBodyEqualFold applies the EqualFold predicate on the "text_body" field.
+func TextBodyEqualFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEqualFold(FieldTextBody, v))
+}
+
+// TextBodyContainsFold applies the ContainsFold predicate on the "text_body" field.
+func TextBodyContainsFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContainsFold(FieldTextBody, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotNull(FieldSentAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OutboxEmail) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OutboxEmail) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OutboxEmail) predicate.OutboxEmail {
+	return predicate.OutboxEmail(sql.NotPredicates(p))
+}
@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/outboxemail"
+)
+
+// OutboxEmailCreate is the builder for creating a OutboxEmail entity.
+type OutboxEmailCreate struct {
+	config
+	mutation *OutboxEmailMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *OutboxEmailCreate) SetKind(v string) *OutboxEmailCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetRecipient sets the "recipient" field.
+func (_c *OutboxEmailCreate) SetRecipient(v string) *OutboxEmailCreate {
+	_c.mutation.SetRecipient(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *OutboxEmailCreate) SetSubject(v string) *OutboxEmailCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *OutboxEmailCreate) SetBody(v string) *OutboxEmailCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetTextBody sets the "text_body" field.
+func (_c *OutboxEmailCreate) SetTextBody(v string) *OutboxEmailCreate {
+	_c.mutation.SetTextBody(v)
+	return _c
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableTextBody(v *string) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetTextBody(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OutboxEmailCreate) SetStatus(v outboxemail.Status) *OutboxEmailCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableStatus(v *outboxemail.Status) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *OutboxEmailCreate) SetAttempts(v int) *OutboxEmailCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableAttempts(v *int) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *OutboxEmailCreate) SetNextAttemptAt(v time.Time) *OutboxEmailCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableNextAttemptAt(v *time.Time) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *OutboxEmailCreate) SetLockedUntil(v time.Time) *OutboxEmailCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableLockedUntil(v *time.Time) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *OutboxEmailCreate) SetLastError(v string) *OutboxEmailCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableLastError(v *string) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *OutboxEmailCreate) SetSentAt(v time.Time) *OutboxEmailCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableSentAt(v *time.Time) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OutboxEmailCreate) SetCreatedAt(v time.Time) *OutboxEmailCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OutboxEmailCreate) SetNillableCreatedAt(v *time.Time) *OutboxEmailCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OutboxEmailMutation object of the builder.
+func (_c *OutboxEmailCreate) Mutation() *OutboxEmailMutation {
+	return _c.mutation
+}
+
+// Save creates the OutboxEmail in the database.
+func (_c *OutboxEmailCreate) Save(ctx context.Context) (*OutboxEmail, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OutboxEmailCreate) SaveX(ctx context.Context) *OutboxEmail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutboxEmailCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutboxEmailCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OutboxEmailCreate) defaults() {
+	if _, ok := _c.mutation.TextBody(); !ok {
+		v := outboxemail.DefaultTextBody
+		_c.mutation.SetTextBody(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := outboxemail.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := outboxemail.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := outboxemail.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := outboxemail.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OutboxEmailCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "OutboxEmail.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := outboxemail.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Recipient(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`ent: missing required field "OutboxEmail.recipient"`)}
+	}
+	if v, ok := _c.mutation.Recipient(); ok {
+		if err := outboxemail.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.recipient": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "OutboxEmail.subject"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "OutboxEmail.body"`)}
+	}
+	if _, ok := _c.mutation.TextBody(); !ok {
+		return &ValidationError{Name: "text_body", err: errors.New(`ent: missing required field "OutboxEmail.text_body"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OutboxEmail.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := outboxemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxEmail.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "OutboxEmail.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxEmail.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OutboxEmailCreate) sqlSave(ctx context.Context) (*OutboxEmail, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OutboxEmailCreate) createSpec() (*OutboxEmail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxEmail{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(outboxemail.Table, sqlgraph.NewFieldSpec(outboxemail.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(outboxemail.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Recipient(); ok {
+		_spec.SetField(outboxemail.FieldRecipient, field.TypeString, value)
+		_node.Recipient = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(outboxemail.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(outboxemail.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.TextBody(); ok {
+		_spec.SetField(outboxemail.FieldTextBody, field.TypeString, value)
+		_node.TextBody = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(outboxemail.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(outboxemail.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxemail.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(outboxemail.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(outboxemail.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(outboxemail.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxemail.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OutboxEmailCreateBulk is the builder for creating many OutboxEmail entities in bulk.
+type OutboxEmailCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxEmailCreate
+}
+
+// Save creates the OutboxEmail entities in the database.
+func (_c *OutboxEmailCreateBulk) Save(ctx context.Context) ([]*OutboxEmail, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OutboxEmail, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxEmailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OutboxEmailCreateBulk) SaveX(ctx context.Context) []*OutboxEmail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutboxEmailCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutboxEmailCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
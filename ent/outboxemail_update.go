@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/outboxemail"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// OutboxEmailUpdate is the builder for updating OutboxEmail entities.
+type OutboxEmailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEmailMutation
+}
+
+// Where appends a list predicates to the OutboxEmailUpdate builder.
+func (_u *OutboxEmailUpdate) Where(ps ...predicate.OutboxEmail) *OutboxEmailUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *OutboxEmailUpdate) SetKind(v string) *OutboxEmailUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableKind(v *string) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" field.
+func (_u *OutboxEmailUpdate) SetRecipient(v string) *OutboxEmailUpdate {
+	_u.mutation.SetRecipient(v)
+	return _u
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableRecipient(v *string) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetRecipient(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *OutboxEmailUpdate) SetSubject(v string) *OutboxEmailUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableSubject(v *string) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *OutboxEmailUpdate) SetBody(v string) *OutboxEmailUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableBody(v *string) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetTextBody sets the "text_body" field.
+func (_u *OutboxEmailUpdate) SetTextBody(v string) *OutboxEmailUpdate {
+	_u.mutation.SetTextBody(v)
+	return _u
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableTextBody(v *string) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetTextBody(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OutboxEmailUpdate) SetStatus(v outboxemail.Status) *OutboxEmailUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableStatus(v *outboxemail.Status) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *OutboxEmailUpdate) SetAttempts(v int) *OutboxEmailUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableAttempts(v *int) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboxEmailUpdate) AddAttempts(v int) *OutboxEmailUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *OutboxEmailUpdate) SetNextAttemptAt(v time.Time) *OutboxEmailUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableNextAttemptAt(v *time.Time) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *OutboxEmailUpdate) SetLockedUntil(v time.Time) *OutboxEmailUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableLockedUntil(v *time.Time) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *OutboxEmailUpdate) ClearLockedUntil() *OutboxEmailUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *OutboxEmailUpdate) SetLastError(v string) *OutboxEmailUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableLastError(v *string) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *OutboxEmailUpdate) ClearLastError() *OutboxEmailUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *OutboxEmailUpdate) SetSentAt(v time.Time) *OutboxEmailUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *OutboxEmailUpdate) SetNillableSentAt(v *time.Time) *OutboxEmailUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *OutboxEmailUpdate) ClearSentAt() *OutboxEmailUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the OutboxEmailMutation object of the builder.
+func (_u *OutboxEmailUpdate) Mutation() *OutboxEmailMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OutboxEmailUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboxEmailUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OutboxEmailUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboxEmailUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutboxEmailUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := outboxemail.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recipient(); ok {
+		if err := outboxemail.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.recipient": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := outboxemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OutboxEmailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxemail.Table, outboxemail.Columns, sqlgraph.NewFieldSpec(outboxemail.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(outboxemail.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Recipient(); ok {
+		_spec.SetField(outboxemail.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(outboxemail.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(outboxemail.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TextBody(); ok {
+		_spec.SetField(outboxemail.FieldTextBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(outboxemail.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outboxemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxemail.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(outboxemail.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(outboxemail.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(outboxemail.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxemail.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(outboxemail.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(outboxemail.FieldSentAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxemail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OutboxEmailUpdateOne is the builder for updating a single OutboxEmail entity.
+type OutboxEmailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEmailMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *OutboxEmailUpdateOne) SetKind(v string) *OutboxEmailUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableKind(v *string) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" field.
+func (_u *OutboxEmailUpdateOne) SetRecipient(v string) *OutboxEmailUpdateOne {
+	_u.mutation.SetRecipient(v)
+	return _u
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableRecipient(v *string) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetRecipient(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *OutboxEmailUpdateOne) SetSubject(v string) *OutboxEmailUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableSubject(v *string) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *OutboxEmailUpdateOne) SetBody(v string) *OutboxEmailUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableBody(v *string) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetTextBody sets the "text_body" field.
+func (_u *OutboxEmailUpdateOne) SetTextBody(v string) *OutboxEmailUpdateOne {
+	_u.mutation.SetTextBody(v)
+	return _u
+}
+
+// SetNillableTextBody sets the "text_body" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableTextBody(v *string) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetTextBody(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OutboxEmailUpdateOne) SetStatus(v outboxemail.Status) *OutboxEmailUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableStatus(v *outboxemail.Status) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *OutboxEmailUpdateOne) SetAttempts(v int) *OutboxEmailUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableAttempts(v *int) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboxEmailUpdateOne) AddAttempts(v int) *OutboxEmailUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *OutboxEmailUpdateOne) SetNextAttemptAt(v time.Time) *OutboxEmailUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableNextAttemptAt(v *time.Time) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *OutboxEmailUpdateOne) SetLockedUntil(v time.Time) *OutboxEmailUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableLockedUntil(v *time.Time) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *OutboxEmailUpdateOne) ClearLockedUntil() *OutboxEmailUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *OutboxEmailUpdateOne) SetLastError(v string) *OutboxEmailUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableLastError(v *string) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *OutboxEmailUpdateOne) ClearLastError() *OutboxEmailUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *OutboxEmailUpdateOne) SetSentAt(v time.Time) *OutboxEmailUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *OutboxEmailUpdateOne) SetNillableSentAt(v *time.Time) *OutboxEmailUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *OutboxEmailUpdateOne) ClearSentAt() *OutboxEmailUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the OutboxEmailMutation object of the builder.
+func (_u *OutboxEmailUpdateOne) Mutation() *OutboxEmailMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OutboxEmailUpdate builder.
+func (_u *OutboxEmailUpdateOne) Where(ps ...predicate.OutboxEmail) *OutboxEmailUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OutboxEmailUpdateOne) Select(field string, fields ...string) *OutboxEmailUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OutboxEmail entity.
+func (_u *OutboxEmailUpdateOne) Save(ctx context.Context) (*OutboxEmail, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboxEmailUpdateOne) SaveX(ctx context.Context) *OutboxEmail {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OutboxEmailUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboxEmailUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutboxEmailUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := outboxemail.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recipient(); ok {
+		if err := outboxemail.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.recipient": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := outboxemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxEmail.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OutboxEmailUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEmail, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxemail.Table, outboxemail.Columns, sqlgraph.NewFieldSpec(outboxemail.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxEmail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxemail.FieldID)
+		for _, f := range fields {
+			if !outboxemail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxemail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(outboxemail.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Recipient(); ok {
+		_spec.SetField(outboxemail.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(outboxemail.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(outboxemail.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TextBody(); ok {
+		_spec.SetField(outboxemail.FieldTextBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(outboxemail.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outboxemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxemail.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(outboxemail.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(outboxemail.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(outboxemail.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxemail.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(outboxemail.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(outboxemail.FieldSentAt, field.TypeTime)
+	}
+	_node = &OutboxEmail{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxemail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
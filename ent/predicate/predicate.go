@@ -18,18 +18,42 @@ type BenefitCategory func(*sql.Selector)
 // BenefitFilter is the predicate function for benefitfilter builders.
 type BenefitFilter func(*sql.Selector)
 
+// BenefitTranslation is the predicate function for benefittranslation builders.
+type BenefitTranslation func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// CategoryTranslation is the predicate function for categorytranslation builders.
+type CategoryTranslation func(*sql.Selector)
+
 // Child is the predicate function for child builders.
 type Child func(*sql.Selector)
 
 // ChildFilter is the predicate function for childfilter builders.
 type ChildFilter func(*sql.Selector)
 
+// EmailVerification is the predicate function for emailverification builders.
+type EmailVerification func(*sql.Selector)
+
 // Filter is the predicate function for filter builders.
 type Filter func(*sql.Selector)
 
+// FilterTranslation is the predicate function for filtertranslation builders.
+type FilterTranslation func(*sql.Selector)
+
+// OutboxEmail is the predicate function for outboxemail builders.
+type OutboxEmail func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// RuleGroup is the predicate function for rulegroup builders.
+type RuleGroup func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
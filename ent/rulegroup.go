@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/rulegroup"
+)
+
+// RuleGroup is the model entity for the RuleGroup schema.
+type RuleGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BenefitID holds the value of the "benefit_id" field.
+	BenefitID int `json:"benefit_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *int `json:"parent_id,omitempty"`
+	// Operator holds the value of the "operator" field.
+	Operator rulegroup.Operator `json:"operator,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RuleGroupQuery when eager-loading is set.
+	Edges        RuleGroupEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RuleGroupEdges holds the relations/edges for other nodes in the graph.
+type RuleGroupEdges struct {
+	// Benefit holds the value of the benefit edge.
+	Benefit *Benefit `json:"benefit,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *RuleGroup `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*RuleGroup `json:"children,omitempty"`
+	// BenefitFilters holds the value of the benefit_filters edge.
+	BenefitFilters []*BenefitFilter `json:"benefit_filters,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// BenefitOrErr returns the Benefit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleGroupEdges) BenefitOrErr() (*Benefit, error) {
+	if e.Benefit != nil {
+		return e.Benefit, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: benefit.Label}
+	}
+	return nil, &NotLoadedError{edge: "benefit"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleGroupEdges) ParentOrErr() (*RuleGroup, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: rulegroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e RuleGroupEdges) ChildrenOrErr() ([]*RuleGroup, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// BenefitFiltersOrErr returns the BenefitFilters value or an error if the edge
+// was not loaded in eager-loading.
+func (e RuleGroupEdges) BenefitFiltersOrErr() ([]*BenefitFilter, error) {
+	if e.loadedTypes[3] {
+		return e.BenefitFilters, nil
+	}
+	return nil, &NotLoadedError{edge: "benefit_filters"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RuleGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rulegroup.FieldID, rulegroup.FieldBenefitID, rulegroup.FieldParentID:
+			values[i] = new(sql.NullInt64)
+		case rulegroup.FieldOperator:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RuleGroup fields.
+func (_m *RuleGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rulegroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case rulegroup.FieldBenefitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field benefit_id", values[i])
+			} else if value.Valid {
+				_m.BenefitID = int(value.Int64)
+			}
+		case rulegroup.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(int)
+				*_m.ParentID = int(value.Int64)
+			}
+		case rulegroup.FieldOperator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator", values[i])
+			} else if value.Valid {
+				_m.Operator = rulegroup.Operator(value.String)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RuleGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *RuleGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBenefit queries the "benefit" edge of the RuleGroup entity.
+func (_m *RuleGroup) QueryBenefit() *BenefitQuery {
+	return NewRuleGroupClient(_m.config).QueryBenefit(_m)
+}
+
+// QueryParent queries the "parent" edge of the RuleGroup entity.
+func (_m *RuleGroup) QueryParent() *RuleGroupQuery {
+	return NewRuleGroupClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the RuleGroup entity.
+func (_m *RuleGroup) QueryChildren() *RuleGroupQuery {
+	return NewRuleGroupClient(_m.config).QueryChildren(_m)
+}
+
+// QueryBenefitFilters queries the "benefit_filters" edge of the RuleGroup entity.
+func (_m *RuleGroup) QueryBenefitFilters() *BenefitFilterQuery {
+	return NewRuleGroupClient(_m.config).QueryBenefitFilters(_m)
+}
+
+// Update returns a builder for updating this RuleGroup.
+// Note that you need to call RuleGroup.Unwrap() before calling this method if this RuleGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RuleGroup) Update() *RuleGroupUpdateOne {
+	return NewRuleGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RuleGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RuleGroup) Unwrap() *RuleGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RuleGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RuleGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("RuleGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("benefit_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BenefitID))
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("operator=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Operator))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RuleGroups is a parsable slice of RuleGroup.
+type RuleGroups []*RuleGroup
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rulegroup
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the rulegroup type in the database.
+	Label = "rule_group"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBenefitID holds the string denoting the benefit_id field in the database.
+	FieldBenefitID = "benefit_id"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldOperator holds the string denoting the operator field in the database.
+	FieldOperator = "operator"
+	// EdgeBenefit holds the string denoting the benefit edge name in mutations.
+	EdgeBenefit = "benefit"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeBenefitFilters holds the string denoting the benefit_filters edge name in mutations.
+	EdgeBenefitFilters = "benefit_filters"
+	// Table holds the table name of the rulegroup in the database.
+	Table = "rule_groups"
+	// BenefitTable is the table that holds the benefit relation/edge.
+	BenefitTable = "rule_groups"
+	// BenefitInverseTable is the table name for the Benefit entity.
+	// It exists in this package in order to avoid circular dependency with the "benefit" package.
+	BenefitInverseTable = "benefits"
+	// BenefitColumn is the table column denoting the benefit relation/edge.
+	BenefitColumn = "benefit_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "rule_groups"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "rule_groups"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
+	// BenefitFiltersTable is the table that holds the benefit_filters relation/edge.
+	BenefitFiltersTable = "benefit_filters"
+	// BenefitFiltersInverseTable is the table name for the BenefitFilter entity.
+	// It exists in this package in order to avoid circular dependency with the "benefitfilter" package.
+	BenefitFiltersInverseTable = "benefit_filters"
+	// BenefitFiltersColumn is the table column denoting the benefit_filters relation/edge.
+	BenefitFiltersColumn = "rule_group_id"
+)
+
+// Columns holds all SQL columns for rulegroup fields.
+var Columns = []string{
+	FieldID,
+	FieldBenefitID,
+	FieldParentID,
+	FieldOperator,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Operator defines the type for the "operator" enum field.
+type Operator string
+
+// Operator values.
+const (
+	OperatorAND Operator = "AND"
+	OperatorOR  Operator = "OR"
+	OperatorNOT Operator = "NOT"
+)
+
+func (o Operator) String() string {
+	return string(o)
+}
+
+// OperatorValidator is a validator for the "operator" field enum values. It is called by the builders before save.
+func OperatorValidator(o Operator) error {
+	switch o {
+	case OperatorAND, OperatorOR, OperatorNOT:
+		return nil
+	default:
+		return fmt.Errorf("rulegroup: invalid enum value for operator field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the RuleGroup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBenefitID orders the results by the benefit_id field.
+func ByBenefitID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBenefitID, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByOperator orders the results by the operator field.
+func ByOperator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperator, opts...).ToFunc()
+}
+
+// ByBenefitField orders the results by benefit field.
+func ByBenefitField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBenefitStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBenefitFiltersCount orders the results by benefit_filters count.
+func ByBenefitFiltersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBenefitFiltersStep(), opts...)
+	}
+}
+
+// ByBenefitFilters orders the results by benefit_filters terms.
+func ByBenefitFilters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBenefitFiltersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBenefitStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BenefitInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BenefitTable, BenefitColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newBenefitFiltersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BenefitFiltersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BenefitFiltersTable, BenefitFiltersColumn),
+	)
+}
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rulegroup
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/citizenkz/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldLTE(FieldID, id))
+}
+
+// BenefitID applies equality check predicate on the "benefit_id" field. It's identical to BenefitIDEQ.
+func BenefitID(v int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldBenefitID, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldParentID, v))
+}
+
+// BenefitIDEQ applies the EQ predicate on the "benefit_id" field.
+func BenefitIDEQ(v int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldBenefitID, v))
+}
+
+// BenefitIDNEQ applies the NEQ predicate on the "benefit_id" field.
+func BenefitIDNEQ(v int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNEQ(FieldBenefitID, v))
+}
+
+// BenefitIDIn applies the In predicate on the "benefit_id" field.
+func BenefitIDIn(vs ...int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldIn(FieldBenefitID, vs...))
+}
+
+// BenefitIDNotIn applies the NotIn predicate on the "benefit_id" field.
+func BenefitIDNotIn(vs ...int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNotIn(FieldBenefitID, vs...))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNotNull(FieldParentID))
+}
+
+// OperatorEQ applies the EQ predicate on the "operator" field.
+func OperatorEQ(v Operator) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldEQ(FieldOperator, v))
+}
+
+// OperatorNEQ applies the NEQ predicate on the "operator" field.
+func OperatorNEQ(v Operator) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNEQ(FieldOperator, v))
+}
+
+// OperatorIn applies the In predicate on the "operator" field.
+func OperatorIn(vs ...Operator) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldIn(FieldOperator, vs...))
+}
+
+// OperatorNotIn applies the NotIn predicate on the "operator" field.
+func OperatorNotIn(vs ...Operator) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.FieldNotIn(FieldOperator, vs...))
+}
+
+// HasBenefit applies the HasEdge predicate on the "benefit" edge.
+func HasBenefit() predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BenefitTable, BenefitColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBenefitWith applies the HasEdge predicate on the "benefit" edge with a given conditions (other predicates).
+func HasBenefitWith(preds ...predicate.Benefit) predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := newBenefitStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.RuleGroup) predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.RuleGroup) predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBenefitFilters applies the HasEdge predicate on the "benefit_filters" edge.
+func HasBenefitFilters() predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BenefitFiltersTable, BenefitFiltersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBenefitFiltersWith applies the HasEdge predicate on the "benefit_filters" edge with a given conditions (other predicates).
+func HasBenefitFiltersWith(preds ...predicate.BenefitFilter) predicate.RuleGroup {
+	return predicate.RuleGroup(func(s *sql.Selector) {
+		step := newBenefitFiltersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RuleGroup) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RuleGroup) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RuleGroup) predicate.RuleGroup {
+	return predicate.RuleGroup(sql.NotPredicates(p))
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/rulegroup"
+)
+
+// RuleGroupCreate is the builder for creating a RuleGroup entity.
+type RuleGroupCreate struct {
+	config
+	mutation *RuleGroupMutation
+	hooks    []Hook
+}
+
+// SetBenefitID sets the "benefit_id" field.
+func (_c *RuleGroupCreate) SetBenefitID(v int) *RuleGroupCreate {
+	_c.mutation.SetBenefitID(v)
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *RuleGroupCreate) SetParentID(v int) *RuleGroupCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *RuleGroupCreate) SetNillableParentID(v *int) *RuleGroupCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetOperator sets the "operator" field.
+func (_c *RuleGroupCreate) SetOperator(v rulegroup.Operator) *RuleGroupCreate {
+	_c.mutation.SetOperator(v)
+	return _c
+}
+
+// SetBenefit sets the "benefit" edge to the Benefit entity.
+func (_c *RuleGroupCreate) SetBenefit(v *Benefit) *RuleGroupCreate {
+	return _c.SetBenefitID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the RuleGroup entity.
+func (_c *RuleGroupCreate) SetParent(v *RuleGroup) *RuleGroupCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the RuleGroup entity by IDs.
+func (_c *RuleGroupCreate) AddChildIDs(ids ...int) *RuleGroupCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the RuleGroup entity.
+func (_c *RuleGroupCreate) AddChildren(v ...*RuleGroup) *RuleGroupCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// AddBenefitFilterIDs adds the "benefit_filters" edge to the BenefitFilter entity by IDs.
+func (_c *RuleGroupCreate) AddBenefitFilterIDs(ids ...int) *RuleGroupCreate {
+	_c.mutation.AddBenefitFilterIDs(ids...)
+	return _c
+}
+
+// AddBenefitFilters adds the "benefit_filters" edges to the BenefitFilter entity.
+func (_c *RuleGroupCreate) AddBenefitFilters(v ...*BenefitFilter) *RuleGroupCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBenefitFilterIDs(ids...)
+}
+
+// Mutation returns the RuleGroupMutation object of the builder.
+func (_c *RuleGroupCreate) Mutation() *RuleGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the RuleGroup in the database.
+func (_c *RuleGroupCreate) Save(ctx context.Context) (*RuleGroup, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RuleGroupCreate) SaveX(ctx context.Context) *RuleGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RuleGroupCreate) check() error {
+	if _, ok := _c.mutation.BenefitID(); !ok {
+		return &ValidationError{Name: "benefit_id", err: errors.New(`ent: missing required field "RuleGroup.benefit_id"`)}
+	}
+	if _, ok := _c.mutation.Operator(); !ok {
+		return &ValidationError{Name: "operator", err: errors.New(`ent: missing required field "RuleGroup.operator"`)}
+	}
+	if v, ok := _c.mutation.Operator(); ok {
+		if err := rulegroup.OperatorValidator(v); err != nil {
+			return &ValidationError{Name: "operator", err: fmt.Errorf(`ent: validator failed for field "RuleGroup.operator": %w`, err)}
+		}
+	}
+	if len(_c.mutation.BenefitIDs()) == 0 {
+		return &ValidationError{Name: "benefit", err: errors.New(`ent: missing required edge "RuleGroup.benefit"`)}
+	}
+	return nil
+}
+
+func (_c *RuleGroupCreate) sqlSave(ctx context.Context) (*RuleGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RuleGroupCreate) createSpec() (*RuleGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RuleGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rulegroup.Table, sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Operator(); ok {
+		_spec.SetField(rulegroup.FieldOperator, field.TypeEnum, value)
+		_node.Operator = value
+	}
+	if nodes := _c.mutation.BenefitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.BenefitTable,
+			Columns: []string{rulegroup.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BenefitID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.ParentTable,
+			Columns: []string{rulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BenefitFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RuleGroupCreateBulk is the builder for creating many RuleGroup entities in bulk.
+type RuleGroupCreateBulk struct {
+	config
+	err      error
+	builders []*RuleGroupCreate
+}
+
+// Save creates the RuleGroup entities in the database.
+func (_c *RuleGroupCreateBulk) Save(ctx context.Context) ([]*RuleGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RuleGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RuleGroupCreateBulk) SaveX(ctx context.Context) []*RuleGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
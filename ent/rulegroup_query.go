@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
+)
+
+// RuleGroupQuery is the builder for querying RuleGroup entities.
+type RuleGroupQuery struct {
+	config
+	ctx                *QueryContext
+	order              []rulegroup.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.RuleGroup
+	withBenefit        *BenefitQuery
+	withParent         *RuleGroupQuery
+	withChildren       *RuleGroupQuery
+	withBenefitFilters *BenefitFilterQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RuleGroupQuery builder.
+func (_q *RuleGroupQuery) Where(ps ...predicate.RuleGroup) *RuleGroupQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RuleGroupQuery) Limit(limit int) *RuleGroupQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RuleGroupQuery) Offset(offset int) *RuleGroupQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RuleGroupQuery) Unique(unique bool) *RuleGroupQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RuleGroupQuery) Order(o ...rulegroup.OrderOption) *RuleGroupQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryBenefit chains the current query on the "benefit" edge.
+func (_q *RuleGroupQuery) QueryBenefit() *BenefitQuery {
+	query := (&BenefitClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, selector),
+			sqlgraph.To(benefit.Table, benefit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rulegroup.BenefitTable, rulegroup.BenefitColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (_q *RuleGroupQuery) QueryParent() *RuleGroupQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, selector),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rulegroup.ParentTable, rulegroup.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (_q *RuleGroupQuery) QueryChildren() *RuleGroupQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, selector),
+			sqlgraph.To(rulegroup.Table, rulegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rulegroup.ChildrenTable, rulegroup.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBenefitFilters chains the current query on the "benefit_filters" edge.
+func (_q *RuleGroupQuery) QueryBenefitFilters() *BenefitFilterQuery {
+	query := (&BenefitFilterClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rulegroup.Table, rulegroup.FieldID, selector),
+			sqlgraph.To(benefitfilter.Table, benefitfilter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rulegroup.BenefitFiltersTable, rulegroup.BenefitFiltersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RuleGroup entity from the query.
+// Returns a *NotFoundError when no RuleGroup was found.
+func (_q *RuleGroupQuery) First(ctx context.Context) (*RuleGroup, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rulegroup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RuleGroupQuery) FirstX(ctx context.Context) *RuleGroup {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RuleGroup ID from the query.
+// Returns a *NotFoundError when no RuleGroup ID was found.
+func (_q *RuleGroupQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rulegroup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RuleGroupQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RuleGroup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RuleGroup entity is found.
+// Returns a *NotFoundError when no RuleGroup entities are found.
+func (_q *RuleGroupQuery) Only(ctx context.Context) (*RuleGroup, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rulegroup.Label}
+	default:
+		return nil, &NotSingularError{rulegroup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RuleGroupQuery) OnlyX(ctx context.Context) *RuleGroup {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RuleGroup ID in the query.
+// Returns a *NotSingularError when more than one RuleGroup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RuleGroupQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rulegroup.Label}
+	default:
+		err = &NotSingularError{rulegroup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RuleGroupQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RuleGroups.
+func (_q *RuleGroupQuery) All(ctx context.Context) ([]*RuleGroup, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RuleGroup, *RuleGroupQuery]()
+	return withInterceptors[[]*RuleGroup](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RuleGroupQuery) AllX(ctx context.Context) []*RuleGroup {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RuleGroup IDs.
+func (_q *RuleGroupQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(rulegroup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RuleGroupQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RuleGroupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RuleGroupQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RuleGroupQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RuleGroupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RuleGroupQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RuleGroupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RuleGroupQuery) Clone() *RuleGroupQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RuleGroupQuery{
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]rulegroup.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.RuleGroup{}, _q.predicates...),
+		withBenefit:        _q.withBenefit.Clone(),
+		withParent:         _q.withParent.Clone(),
+		withChildren:       _q.withChildren.Clone(),
+		withBenefitFilters: _q.withBenefitFilters.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithBenefit tells the query-builder to eager-load the nodes that are connected to
+// the "benefit" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleGroupQuery) WithBenefit(opts ...func(*BenefitQuery)) *RuleGroupQuery {
+	query := (&BenefitClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBenefit = query
+	return _q
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleGroupQuery) WithParent(opts ...func(*RuleGroupQuery)) *RuleGroupQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParent = query
+	return _q
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleGroupQuery) WithChildren(opts ...func(*RuleGroupQuery)) *RuleGroupQuery {
+	query := (&RuleGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildren = query
+	return _q
+}
+
+// WithBenefitFilters tells the query-builder to eager-load the nodes that are connected to
+// the "benefit_filters" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleGroupQuery) WithBenefitFilters(opts ...func(*BenefitFilterQuery)) *RuleGroupQuery {
+	query := (&BenefitFilterClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBenefitFilters = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BenefitID int `json:"benefit_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RuleGroup.Query().
+//		GroupBy(rulegroup.FieldBenefitID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RuleGroupQuery) GroupBy(field string, fields ...string) *RuleGroupGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RuleGroupGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = rulegroup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BenefitID int `json:"benefit_id,omitempty"`
+//	}
+//
+//	client.RuleGroup.Query().
+//		Select(rulegroup.FieldBenefitID).
+//		Scan(ctx, &v)
+func (_q *RuleGroupQuery) Select(fields ...string) *RuleGroupSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RuleGroupSelect{RuleGroupQuery: _q}
+	sbuild.label = rulegroup.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RuleGroupSelect configured with the given aggregations.
+func (_q *RuleGroupQuery) Aggregate(fns ...AggregateFunc) *RuleGroupSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RuleGroupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !rulegroup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *RuleGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RuleGroup, error) {
+	var (
+		nodes       = []*RuleGroup{}
+		_spec       = _q.querySpec()
+		loadedTypes = [4]bool{
+			_q.withBenefit != nil,
+			_q.withParent != nil,
+			_q.withChildren != nil,
+			_q.withBenefitFilters != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RuleGroup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RuleGroup{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withBenefit; query != nil {
+		if err := _q.loadBenefit(ctx, query, nodes, nil,
+			func(n *RuleGroup, e *Benefit) { n.Edges.Benefit = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withParent; query != nil {
+		if err := _q.loadParent(ctx, query, nodes, nil,
+			func(n *RuleGroup, e *RuleGroup) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChildren; query != nil {
+		if err := _q.loadChildren(ctx, query, nodes,
+			func(n *RuleGroup) { n.Edges.Children = []*RuleGroup{} },
+			func(n *RuleGroup, e *RuleGroup) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBenefitFilters; query != nil {
+		if err := _q.loadBenefitFilters(ctx, query, nodes,
+			func(n *RuleGroup) { n.Edges.BenefitFilters = []*BenefitFilter{} },
+			func(n *RuleGroup, e *BenefitFilter) { n.Edges.BenefitFilters = append(n.Edges.BenefitFilters, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RuleGroupQuery) loadBenefit(ctx context.Context, query *BenefitQuery, nodes []*RuleGroup, init func(*RuleGroup), assign func(*RuleGroup, *Benefit)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RuleGroup)
+	for i := range nodes {
+		fk := nodes[i].BenefitID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(benefit.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "benefit_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RuleGroupQuery) loadParent(ctx context.Context, query *RuleGroupQuery, nodes []*RuleGroup, init func(*RuleGroup), assign func(*RuleGroup, *RuleGroup)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RuleGroup)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(rulegroup.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RuleGroupQuery) loadChildren(ctx context.Context, query *RuleGroupQuery, nodes []*RuleGroup, init func(*RuleGroup), assign func(*RuleGroup, *RuleGroup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*RuleGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rulegroup.FieldParentID)
+	}
+	query.Where(predicate.RuleGroup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(rulegroup.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RuleGroupQuery) loadBenefitFilters(ctx context.Context, query *BenefitFilterQuery, nodes []*RuleGroup, init func(*RuleGroup), assign func(*RuleGroup, *BenefitFilter)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*RuleGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(benefitfilter.FieldRuleGroupID)
+	}
+	query.Where(predicate.BenefitFilter(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(rulegroup.BenefitFiltersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RuleGroupID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "rule_group_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "rule_group_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *RuleGroupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RuleGroupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rulegroup.Table, rulegroup.Columns, sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rulegroup.FieldID)
+		for i := range fields {
+			if fields[i] != rulegroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withBenefit != nil {
+			_spec.Node.AddColumnOnce(rulegroup.FieldBenefitID)
+		}
+		if _q.withParent != nil {
+			_spec.Node.AddColumnOnce(rulegroup.FieldParentID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RuleGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(rulegroup.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = rulegroup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RuleGroupGroupBy is the group-by builder for RuleGroup entities.
+type RuleGroupGroupBy struct {
+	selector
+	build *RuleGroupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RuleGroupGroupBy) Aggregate(fns ...AggregateFunc) *RuleGroupGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RuleGroupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RuleGroupQuery, *RuleGroupGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RuleGroupGroupBy) sqlScan(ctx context.Context, root *RuleGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RuleGroupSelect is the builder for selecting fields of RuleGroup entities.
+type RuleGroupSelect struct {
+	*RuleGroupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RuleGroupSelect) Aggregate(fns ...AggregateFunc) *RuleGroupSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RuleGroupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RuleGroupQuery, *RuleGroupSelect](ctx, _s.RuleGroupQuery, _s, _s.inters, v)
+}
+
+func (_s *RuleGroupSelect) sqlScan(ctx context.Context, root *RuleGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
+)
+
+// RuleGroupUpdate is the builder for updating RuleGroup entities.
+type RuleGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleGroupMutation
+}
+
+// Where appends a list predicates to the RuleGroupUpdate builder.
+func (_u *RuleGroupUpdate) Where(ps ...predicate.RuleGroup) *RuleGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBenefitID sets the "benefit_id" field.
+func (_u *RuleGroupUpdate) SetBenefitID(v int) *RuleGroupUpdate {
+	_u.mutation.SetBenefitID(v)
+	return _u
+}
+
+// SetNillableBenefitID sets the "benefit_id" field if the given value is not nil.
+func (_u *RuleGroupUpdate) SetNillableBenefitID(v *int) *RuleGroupUpdate {
+	if v != nil {
+		_u.SetBenefitID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *RuleGroupUpdate) SetParentID(v int) *RuleGroupUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *RuleGroupUpdate) SetNillableParentID(v *int) *RuleGroupUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *RuleGroupUpdate) ClearParentID() *RuleGroupUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetOperator sets the "operator" field.
+func (_u *RuleGroupUpdate) SetOperator(v rulegroup.Operator) *RuleGroupUpdate {
+	_u.mutation.SetOperator(v)
+	return _u
+}
+
+// SetNillableOperator sets the "operator" field if the given value is not nil.
+func (_u *RuleGroupUpdate) SetNillableOperator(v *rulegroup.Operator) *RuleGroupUpdate {
+	if v != nil {
+		_u.SetOperator(*v)
+	}
+	return _u
+}
+
+// SetBenefit sets the "benefit" edge to the Benefit entity.
+func (_u *RuleGroupUpdate) SetBenefit(v *Benefit) *RuleGroupUpdate {
+	return _u.SetBenefitID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the RuleGroup entity.
+func (_u *RuleGroupUpdate) SetParent(v *RuleGroup) *RuleGroupUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the RuleGroup entity by IDs.
+func (_u *RuleGroupUpdate) AddChildIDs(ids ...int) *RuleGroupUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the RuleGroup entity.
+func (_u *RuleGroupUpdate) AddChildren(v ...*RuleGroup) *RuleGroupUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddBenefitFilterIDs adds the "benefit_filters" edge to the BenefitFilter entity by IDs.
+func (_u *RuleGroupUpdate) AddBenefitFilterIDs(ids ...int) *RuleGroupUpdate {
+	_u.mutation.AddBenefitFilterIDs(ids...)
+	return _u
+}
+
+// AddBenefitFilters adds the "benefit_filters" edges to the BenefitFilter entity.
+func (_u *RuleGroupUpdate) AddBenefitFilters(v ...*BenefitFilter) *RuleGroupUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBenefitFilterIDs(ids...)
+}
+
+// Mutation returns the RuleGroupMutation object of the builder.
+func (_u *RuleGroupUpdate) Mutation() *RuleGroupMutation {
+	return _u.mutation
+}
+
+// ClearBenefit clears the "benefit" edge to the Benefit entity.
+func (_u *RuleGroupUpdate) ClearBenefit() *RuleGroupUpdate {
+	_u.mutation.ClearBenefit()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the RuleGroup entity.
+func (_u *RuleGroupUpdate) ClearParent() *RuleGroupUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the RuleGroup entity.
+func (_u *RuleGroupUpdate) ClearChildren() *RuleGroupUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to RuleGroup entities by IDs.
+func (_u *RuleGroupUpdate) RemoveChildIDs(ids ...int) *RuleGroupUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to RuleGroup entities.
+func (_u *RuleGroupUpdate) RemoveChildren(v ...*RuleGroup) *RuleGroupUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearBenefitFilters clears all "benefit_filters" edges to the BenefitFilter entity.
+func (_u *RuleGroupUpdate) ClearBenefitFilters() *RuleGroupUpdate {
+	_u.mutation.ClearBenefitFilters()
+	return _u
+}
+
+// RemoveBenefitFilterIDs removes the "benefit_filters" edge to BenefitFilter entities by IDs.
+func (_u *RuleGroupUpdate) RemoveBenefitFilterIDs(ids ...int) *RuleGroupUpdate {
+	_u.mutation.RemoveBenefitFilterIDs(ids...)
+	return _u
+}
+
+// RemoveBenefitFilters removes "benefit_filters" edges to BenefitFilter entities.
+func (_u *RuleGroupUpdate) RemoveBenefitFilters(v ...*BenefitFilter) *RuleGroupUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBenefitFilterIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RuleGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RuleGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleGroupUpdate) check() error {
+	if v, ok := _u.mutation.Operator(); ok {
+		if err := rulegroup.OperatorValidator(v); err != nil {
+			return &ValidationError{Name: "operator", err: fmt.Errorf(`ent: validator failed for field "RuleGroup.operator": %w`, err)}
+		}
+	}
+	if _u.mutation.BenefitCleared() && len(_u.mutation.BenefitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RuleGroup.benefit"`)
+	}
+	return nil
+}
+
+func (_u *RuleGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rulegroup.Table, rulegroup.Columns, sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Operator(); ok {
+		_spec.SetField(rulegroup.FieldOperator, field.TypeEnum, value)
+	}
+	if _u.mutation.BenefitCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.BenefitTable,
+			Columns: []string{rulegroup.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BenefitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.BenefitTable,
+			Columns: []string{rulegroup.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.ParentTable,
+			Columns: []string{rulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.ParentTable,
+			Columns: []string{rulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BenefitFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBenefitFiltersIDs(); len(nodes) > 0 && !_u.mutation.BenefitFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BenefitFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rulegroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RuleGroupUpdateOne is the builder for updating a single RuleGroup entity.
+type RuleGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleGroupMutation
+}
+
+// SetBenefitID sets the "benefit_id" field.
+func (_u *RuleGroupUpdateOne) SetBenefitID(v int) *RuleGroupUpdateOne {
+	_u.mutation.SetBenefitID(v)
+	return _u
+}
+
+// SetNillableBenefitID sets the "benefit_id" field if the given value is not nil.
+func (_u *RuleGroupUpdateOne) SetNillableBenefitID(v *int) *RuleGroupUpdateOne {
+	if v != nil {
+		_u.SetBenefitID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *RuleGroupUpdateOne) SetParentID(v int) *RuleGroupUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *RuleGroupUpdateOne) SetNillableParentID(v *int) *RuleGroupUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *RuleGroupUpdateOne) ClearParentID() *RuleGroupUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetOperator sets the "operator" field.
+func (_u *RuleGroupUpdateOne) SetOperator(v rulegroup.Operator) *RuleGroupUpdateOne {
+	_u.mutation.SetOperator(v)
+	return _u
+}
+
+// SetNillableOperator sets the "operator" field if the given value is not nil.
+func (_u *RuleGroupUpdateOne) SetNillableOperator(v *rulegroup.Operator) *RuleGroupUpdateOne {
+	if v != nil {
+		_u.SetOperator(*v)
+	}
+	return _u
+}
+
+// SetBenefit sets the "benefit" edge to the Benefit entity.
+func (_u *RuleGroupUpdateOne) SetBenefit(v *Benefit) *RuleGroupUpdateOne {
+	return _u.SetBenefitID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the RuleGroup entity.
+func (_u *RuleGroupUpdateOne) SetParent(v *RuleGroup) *RuleGroupUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the RuleGroup entity by IDs.
+func (_u *RuleGroupUpdateOne) AddChildIDs(ids ...int) *RuleGroupUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the RuleGroup entity.
+func (_u *RuleGroupUpdateOne) AddChildren(v ...*RuleGroup) *RuleGroupUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddBenefitFilterIDs adds the "benefit_filters" edge to the BenefitFilter entity by IDs.
+func (_u *RuleGroupUpdateOne) AddBenefitFilterIDs(ids ...int) *RuleGroupUpdateOne {
+	_u.mutation.AddBenefitFilterIDs(ids...)
+	return _u
+}
+
+// AddBenefitFilters adds the "benefit_filters" edges to the BenefitFilter entity.
+func (_u *RuleGroupUpdateOne) AddBenefitFilters(v ...*BenefitFilter) *RuleGroupUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBenefitFilterIDs(ids...)
+}
+
+// Mutation returns the RuleGroupMutation object of the builder.
+func (_u *RuleGroupUpdateOne) Mutation() *RuleGroupMutation {
+	return _u.mutation
+}
+
+// ClearBenefit clears the "benefit" edge to the Benefit entity.
+func (_u *RuleGroupUpdateOne) ClearBenefit() *RuleGroupUpdateOne {
+	_u.mutation.ClearBenefit()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the RuleGroup entity.
+func (_u *RuleGroupUpdateOne) ClearParent() *RuleGroupUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the RuleGroup entity.
+func (_u *RuleGroupUpdateOne) ClearChildren() *RuleGroupUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to RuleGroup entities by IDs.
+func (_u *RuleGroupUpdateOne) RemoveChildIDs(ids ...int) *RuleGroupUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to RuleGroup entities.
+func (_u *RuleGroupUpdateOne) RemoveChildren(v ...*RuleGroup) *RuleGroupUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearBenefitFilters clears all "benefit_filters" edges to the BenefitFilter entity.
+func (_u *RuleGroupUpdateOne) ClearBenefitFilters() *RuleGroupUpdateOne {
+	_u.mutation.ClearBenefitFilters()
+	return _u
+}
+
+// RemoveBenefitFilterIDs removes the "benefit_filters" edge to BenefitFilter entities by IDs.
+func (_u *RuleGroupUpdateOne) RemoveBenefitFilterIDs(ids ...int) *RuleGroupUpdateOne {
+	_u.mutation.RemoveBenefitFilterIDs(ids...)
+	return _u
+}
+
+// RemoveBenefitFilters removes "benefit_filters" edges to BenefitFilter entities.
+func (_u *RuleGroupUpdateOne) RemoveBenefitFilters(v ...*BenefitFilter) *RuleGroupUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBenefitFilterIDs(ids...)
+}
+
+// Where appends a list predicates to the RuleGroupUpdate builder.
+func (_u *RuleGroupUpdateOne) Where(ps ...predicate.RuleGroup) *RuleGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RuleGroupUpdateOne) Select(field string, fields ...string) *RuleGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RuleGroup entity.
+func (_u *RuleGroupUpdateOne) Save(ctx context.Context) (*RuleGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleGroupUpdateOne) SaveX(ctx context.Context) *RuleGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RuleGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.Operator(); ok {
+		if err := rulegroup.OperatorValidator(v); err != nil {
+			return &ValidationError{Name: "operator", err: fmt.Errorf(`ent: validator failed for field "RuleGroup.operator": %w`, err)}
+		}
+	}
+	if _u.mutation.BenefitCleared() && len(_u.mutation.BenefitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RuleGroup.benefit"`)
+	}
+	return nil
+}
+
+func (_u *RuleGroupUpdateOne) sqlSave(ctx context.Context) (_node *RuleGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rulegroup.Table, rulegroup.Columns, sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RuleGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rulegroup.FieldID)
+		for _, f := range fields {
+			if !rulegroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rulegroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Operator(); ok {
+		_spec.SetField(rulegroup.FieldOperator, field.TypeEnum, value)
+	}
+	if _u.mutation.BenefitCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.BenefitTable,
+			Columns: []string{rulegroup.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BenefitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.BenefitTable,
+			Columns: []string{rulegroup.BenefitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.ParentTable,
+			Columns: []string{rulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rulegroup.ParentTable,
+			Columns: []string{rulegroup.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.ChildrenTable,
+			Columns: []string{rulegroup.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rulegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BenefitFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBenefitFiltersIDs(); len(nodes) > 0 && !_u.mutation.BenefitFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BenefitFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rulegroup.BenefitFiltersTable,
+			Columns: []string{rulegroup.BenefitFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(benefitfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RuleGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rulegroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
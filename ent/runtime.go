@@ -7,7 +7,11 @@ import (
 
 	"github.com/citizenkz/core/ent/attempt"
 	"github.com/citizenkz/core/ent/child"
+	"github.com/citizenkz/core/ent/emailverification"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/outboxemail"
+	"github.com/citizenkz/core/ent/recoverycode"
+	"github.com/citizenkz/core/ent/refreshtoken"
 	"github.com/citizenkz/core/ent/schema"
 	"github.com/citizenkz/core/ent/user"
 	"github.com/google/uuid"
@@ -27,6 +31,14 @@ func init() {
 	attemptDescEmail := attemptFields[2].Descriptor()
 	// attempt.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	attempt.EmailValidator = attemptDescEmail.Validators[0].(func(string) error)
+	// attemptDescTries is the schema descriptor for tries field.
+	attemptDescTries := attemptFields[3].Descriptor()
+	// attempt.DefaultTries holds the default value on creation for the tries field.
+	attempt.DefaultTries = attemptDescTries.Default.(int)
+	// attemptDescCreatedAt is the schema descriptor for created_at field.
+	attemptDescCreatedAt := attemptFields[6].Descriptor()
+	// attempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attempt.DefaultCreatedAt = attemptDescCreatedAt.Default.(func() time.Time)
 	// attemptDescID is the schema descriptor for id field.
 	attemptDescID := attemptFields[0].Descriptor()
 	// attempt.DefaultID holds the default value on creation for the id field.
@@ -37,12 +49,84 @@ func init() {
 	childDescCreatedAt := childFields[4].Descriptor()
 	// child.DefaultCreatedAt holds the default value on creation for the created_at field.
 	child.DefaultCreatedAt = childDescCreatedAt.Default.(func() time.Time)
+	emailverificationFields := schema.EmailVerification{}.Fields()
+	_ = emailverificationFields
+	// emailverificationDescEmail is the schema descriptor for email field.
+	emailverificationDescEmail := emailverificationFields[1].Descriptor()
+	// emailverification.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailverification.EmailValidator = emailverificationDescEmail.Validators[0].(func(string) error)
+	// emailverificationDescOtp is the schema descriptor for otp field.
+	emailverificationDescOtp := emailverificationFields[2].Descriptor()
+	// emailverification.OtpValidator is a validator for the "otp" field. It is called by the builders before save.
+	emailverification.OtpValidator = emailverificationDescOtp.Validators[0].(func(string) error)
+	// emailverificationDescTries is the schema descriptor for tries field.
+	emailverificationDescTries := emailverificationFields[3].Descriptor()
+	// emailverification.DefaultTries holds the default value on creation for the tries field.
+	emailverification.DefaultTries = emailverificationDescTries.Default.(int)
+	// emailverificationDescSentAt is the schema descriptor for sent_at field.
+	emailverificationDescSentAt := emailverificationFields[4].Descriptor()
+	// emailverification.DefaultSentAt holds the default value on creation for the sent_at field.
+	emailverification.DefaultSentAt = emailverificationDescSentAt.Default.(func() time.Time)
+	// emailverificationDescCreatedAt is the schema descriptor for created_at field.
+	emailverificationDescCreatedAt := emailverificationFields[5].Descriptor()
+	// emailverification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailverification.DefaultCreatedAt = emailverificationDescCreatedAt.Default.(func() time.Time)
 	filterFields := schema.Filter{}.Fields()
 	_ = filterFields
 	// filterDescName is the schema descriptor for name field.
 	filterDescName := filterFields[0].Descriptor()
 	// filter.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	filter.NameValidator = filterDescName.Validators[0].(func(string) error)
+	// filterDescIsAge is the schema descriptor for is_age field.
+	filterDescIsAge := filterFields[4].Descriptor()
+	// filter.DefaultIsAge holds the default value on creation for the is_age field.
+	filter.DefaultIsAge = filterDescIsAge.Default.(bool)
+	outboxemailFields := schema.OutboxEmail{}.Fields()
+	_ = outboxemailFields
+	// outboxemailDescKind is the schema descriptor for kind field.
+	outboxemailDescKind := outboxemailFields[0].Descriptor()
+	// outboxemail.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	outboxemail.KindValidator = outboxemailDescKind.Validators[0].(func(string) error)
+	// outboxemailDescRecipient is the schema descriptor for recipient field.
+	outboxemailDescRecipient := outboxemailFields[1].Descriptor()
+	// outboxemail.RecipientValidator is a validator for the "recipient" field. It is called by the builders before save.
+	outboxemail.RecipientValidator = outboxemailDescRecipient.Validators[0].(func(string) error)
+	// outboxemailDescTextBody is the schema descriptor for text_body field.
+	outboxemailDescTextBody := outboxemailFields[4].Descriptor()
+	// outboxemail.DefaultTextBody holds the default value on creation for the text_body field.
+	outboxemail.DefaultTextBody = outboxemailDescTextBody.Default.(string)
+	// outboxemailDescAttempts is the schema descriptor for attempts field.
+	outboxemailDescAttempts := outboxemailFields[6].Descriptor()
+	// outboxemail.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxemail.DefaultAttempts = outboxemailDescAttempts.Default.(int)
+	// outboxemailDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxemailDescNextAttemptAt := outboxemailFields[7].Descriptor()
+	// outboxemail.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxemail.DefaultNextAttemptAt = outboxemailDescNextAttemptAt.Default.(func() time.Time)
+	// outboxemailDescCreatedAt is the schema descriptor for created_at field.
+	outboxemailDescCreatedAt := outboxemailFields[11].Descriptor()
+	// outboxemail.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxemail.DefaultCreatedAt = outboxemailDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[1].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[5].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescFirstName is the schema descriptor for first_name field.
@@ -85,8 +169,16 @@ func init() {
 	userDescEmail := userFields[3].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[11].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescTotpRequired is the schema descriptor for totp_required field.
+	userDescTotpRequired := userFields[12].Descriptor()
+	// user.DefaultTotpRequired holds the default value on creation for the totp_required field.
+	user.DefaultTotpRequired = userDescTotpRequired.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[13].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"github.com/citizenkz/core/utils/gen"
@@ -18,9 +20,25 @@ func (Attempt) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default((func() uuid.UUID)(gen.UUID())),
 		field.String("otp").
-			NotEmpty(),
+			NotEmpty().
+			Sensitive(),
 		field.String("email").
 			NotEmpty(),
+		// OTP codes entered so far
+		field.Int("tries").
+			Default(0),
+		// SHA-256 of the reset token issued once the OTP is confirmed
+		field.String("reset_token_hash").
+			Nillable().
+			Optional().
+			Unique().
+			Sensitive(),
+		field.Time("verified_at").
+			Nillable().
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
 	}
 }
 
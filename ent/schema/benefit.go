@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -31,5 +32,9 @@ func (Benefit) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("benefit_filters", BenefitFilter.Type),
 		edge.To("benefit_categories", BenefitCategory.Type),
+		edge.To("rule_groups", RuleGroup.Type),
+		// Translations go with the benefit
+		edge.To("translations", BenefitTranslation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -25,6 +25,9 @@ func (BenefitFilter) Fields() []ent.Field {
 		field.String("to").
 			Nillable().
 			Optional(),
+		field.Int("rule_group_id").
+			Nillable().
+			Optional(),
 	}
 }
 
@@ -41,5 +44,9 @@ func (BenefitFilter) Edges() []ent.Edge {
 			Field("filter_id").
 			Required().
 			Unique(),
+		edge.From("rule_group", RuleGroup.Type).
+			Ref("benefit_filters").
+			Field("rule_group_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+// BenefitTranslation holds the schema definition for the BenefitTranslation entity.
+type BenefitTranslation struct {
+	ent.Schema
+}
+
+// Fields of the BenefitTranslation. Empty fields aren't translated yet and
+// fall back to another locale.
+func (BenefitTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("benefit_id"),
+		field.Enum("locale").
+			Values(
+				locale.Kazakh.String(),
+				locale.Russian.String(),
+			),
+		field.String("title").
+			Optional(),
+		field.Text("content").
+			Optional(),
+		field.String("bonus").
+			Optional(),
+	}
+}
+
+// Edges of the BenefitTranslation.
+func (BenefitTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("benefit", Benefit.Type).
+			Ref("translations").
+			Field("benefit_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the BenefitTranslation.
+func (BenefitTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("benefit_id", "locale").
+			Unique(),
+	}
+}
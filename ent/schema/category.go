@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -26,5 +27,8 @@ func (Category) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("benefit_categories",
 			BenefitCategory.Type),
+		// Translations go with the category
+		edge.To("translations", CategoryTranslation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
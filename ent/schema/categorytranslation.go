@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+// CategoryTranslation holds the schema definition for the CategoryTranslation entity.
+type CategoryTranslation struct {
+	ent.Schema
+}
+
+// Fields of the CategoryTranslation. Empty fields aren't translated yet and
+// fall back to another locale.
+func (CategoryTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("category_id"),
+		field.Enum("locale").
+			Values(
+				locale.Kazakh.String(),
+				locale.Russian.String(),
+			),
+		field.String("name").
+			Optional(),
+		field.String("description").
+			Optional(),
+	}
+}
+
+// Edges of the CategoryTranslation.
+func (CategoryTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("category", Category.Type).
+			Ref("translations").
+			Field("category_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CategoryTranslation.
+func (CategoryTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category_id", "locale").
+			Unique(),
+	}
+}
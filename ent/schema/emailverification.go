@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailVerification holds the schema definition for the EmailVerification entity.
+type EmailVerification struct {
+	ent.Schema
+}
+
+// Fields of the EmailVerification.
+func (EmailVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// The address being confirmed, the current or the pending one
+		field.String("email").
+			NotEmpty(),
+		field.String("otp").
+			NotEmpty().
+			Sensitive(),
+		// OTP codes entered so far
+		field.Int("tries").
+			Default(0),
+		// When the code was last emailed, resends wait a cooldown after it
+		field.Time("sent_at").
+			Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the EmailVerification.
+func (EmailVerification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_verifications").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/citizenkz/core/services/filter/consts"
@@ -27,6 +28,10 @@ func (Filter) Fields() []ent.Field {
 				consts.StringRange.String(),
 			),
 		field.JSON("values", []string{}),
+		// is_age marks a NUMBER_RANGE filter whose value is derived from
+		// the birth date of the user or child instead of being entered.
+		field.Bool("is_age").
+			Default(false),
 	}
 }
 
@@ -36,5 +41,8 @@ func (Filter) Edges() []ent.Edge {
 		edge.To("user_filters", UserFilter.Type),
 		edge.To("benefit_filters", BenefitFilter.Type),
 		edge.To("child_filters", ChildFilter.Type),
+		// Translations go with the filter
+		edge.To("translations", FilterTranslation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
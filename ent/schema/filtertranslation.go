@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+// FilterTranslation holds the schema definition for the FilterTranslation entity.
+type FilterTranslation struct {
+	ent.Schema
+}
+
+// Fields of the FilterTranslation. Empty fields aren't translated yet and
+// fall back to another locale.
+func (FilterTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("filter_id"),
+		field.Enum("locale").
+			Values(
+				locale.Kazakh.String(),
+				locale.Russian.String(),
+			),
+		field.String("name").
+			Optional(),
+		field.String("hint").
+			Optional(),
+		// values labels the filter's values in the same order. Users still
+		// answer with the untranslated values.
+		field.JSON("values", []string{}).
+			Optional(),
+	}
+}
+
+// Edges of the FilterTranslation.
+func (FilterTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("filter", Filter.Type).
+			Ref("translations").
+			Field("filter_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the FilterTranslation.
+func (FilterTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("filter_id", "locale").
+			Unique(),
+	}
+}
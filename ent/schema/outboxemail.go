@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/citizenkz/core/services/outbox/consts"
+)
+
+// OutboxEmail holds the schema definition for the OutboxEmail entity.
+type OutboxEmail struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEmail.
+func (OutboxEmail) Fields() []ent.Field {
+	return []ent.Field{
+		// What the email is about, e.g. password_reset_otp
+		field.String("kind").
+			NotEmpty(),
+		field.String("recipient").
+			NotEmpty(),
+		field.String("subject"),
+		// Rendered HTML; it may hold one-time codes
+		field.Text("body").
+			Sensitive(),
+		// Plain-text alternative of body
+		field.Text("text_body").
+			Default("").
+			Sensitive(),
+		field.Enum("status").
+			Values(
+				consts.Pending.String(),
+				consts.Sent.String(),
+				consts.Dead.String(),
+			).
+			Default(consts.Pending.String()),
+		// Delivery attempts made so far
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		// Set while a worker delivers the email, so no other one picks it up
+		field.Time("locked_until").
+			Nillable().
+			Optional(),
+		field.Text("last_error").
+			Nillable().
+			Optional(),
+		field.Time("sent_at").
+			Nillable().
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OutboxEmail.
+func (OutboxEmail) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the OutboxEmail.
+func (OutboxEmail) Indexes() []ent.Index {
+	return []ent.Index{
+		// The worker looks for due pending emails
+		index.Fields("status", "next_attempt_at"),
+	}
+}
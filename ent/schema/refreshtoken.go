@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		// SHA-256 of the opaque token, the token itself is never stored
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Sensitive(),
+		field.Int("user_id"),
+		// Tokens rotated from the same login share a family
+		field.UUID("family_id", uuid.UUID{}),
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Nillable().
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
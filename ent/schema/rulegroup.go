@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/services/benefit/consts"
+)
+
+// RuleGroup holds the schema definition for the RuleGroup entity.
+type RuleGroup struct {
+	ent.Schema
+}
+
+// Fields of the RuleGroup.
+func (RuleGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("benefit_id"),
+		field.Int("parent_id").
+			Nillable().
+			Optional(),
+		field.Enum("operator").
+			Values(
+				consts.And.String(),
+				consts.Or.String(),
+				consts.Not.String(),
+			),
+	}
+}
+
+// Edges of the RuleGroup.
+func (RuleGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("benefit", Benefit.Type).
+			Ref("rule_groups").
+			Field("benefit_id").
+			Required().
+			Unique(),
+		edge.To("children", RuleGroup.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("benefit_filters", BenefitFilter.Type),
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/citizenkz/core/services/auth/consts"
+	"github.com/citizenkz/core/utils/locale"
 )
 
 // User holds the schema definition for the User entity.
@@ -31,9 +33,53 @@ func (User) Fields() []ent.Field {
 			Unique().
 			NotEmpty(),
 
+		field.Time("email_verified_at").
+			Nillable().
+			Optional(),
+
+		// New address waiting for confirmation before it replaces email
+		field.String("pending_email").
+			Nillable().
+			Optional(),
+
 		field.String("password").
 			Sensitive(),
 
+		field.Enum("role").
+			Values(
+				consts.Citizen.String(),
+				consts.Editor.String(),
+				consts.Admin.String(),
+			).
+			Default(consts.Citizen.String()),
+
+		// Language of the emails the user gets
+		field.Enum("locale").
+			Values(
+				locale.English.String(),
+				locale.Kazakh.String(),
+				locale.Russian.String(),
+			).
+			Default(locale.Default.String()),
+
+		// Base32 TOTP secret, set on enrollment and kept while 2FA is on
+		field.String("totp_secret").
+			Nillable().
+			Optional().
+			Sensitive(),
+
+		field.Time("totp_enabled_at").
+			Nillable().
+			Optional(),
+
+		// Last accepted TOTP time step, a code is never accepted twice
+		field.Int64("totp_last_step").
+			Default(0),
+
+		// Set by an admin to make the user enroll before logging in
+		field.Bool("totp_required").
+			Default(false),
+
 		field.Time("created_at").
 			Default(time.Now),
 	}
@@ -44,5 +90,8 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("user_filters", UserFilter.Type),
 		edge.To("children", Child.Type),
+		edge.To("refresh_tokens", RefreshToken.Type),
+		edge.To("email_verifications", EmailVerification.Type),
+		edge.To("recovery_codes", RecoveryCode.Type),
 	}
 }
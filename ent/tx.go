@@ -20,14 +20,30 @@ type Tx struct {
 	BenefitCategory *BenefitCategoryClient
 	// BenefitFilter is the client for interacting with the BenefitFilter builders.
 	BenefitFilter *BenefitFilterClient
+	// BenefitTranslation is the client for interacting with the BenefitTranslation builders.
+	BenefitTranslation *BenefitTranslationClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategoryTranslation is the client for interacting with the CategoryTranslation builders.
+	CategoryTranslation *CategoryTranslationClient
 	// Child is the client for interacting with the Child builders.
 	Child *ChildClient
 	// ChildFilter is the client for interacting with the ChildFilter builders.
 	ChildFilter *ChildFilterClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
 	// Filter is the client for interacting with the Filter builders.
 	Filter *FilterClient
+	// FilterTranslation is the client for interacting with the FilterTranslation builders.
+	FilterTranslation *FilterTranslationClient
+	// OutboxEmail is the client for interacting with the OutboxEmail builders.
+	OutboxEmail *OutboxEmailClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// RuleGroup is the client for interacting with the RuleGroup builders.
+	RuleGroup *RuleGroupClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserFilter is the client for interacting with the UserFilter builders.
@@ -167,10 +183,18 @@ func (tx *Tx) init() {
 	tx.Benefit = NewBenefitClient(tx.config)
 	tx.BenefitCategory = NewBenefitCategoryClient(tx.config)
 	tx.BenefitFilter = NewBenefitFilterClient(tx.config)
+	tx.BenefitTranslation = NewBenefitTranslationClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
+	tx.CategoryTranslation = NewCategoryTranslationClient(tx.config)
 	tx.Child = NewChildClient(tx.config)
 	tx.ChildFilter = NewChildFilterClient(tx.config)
+	tx.EmailVerification = NewEmailVerificationClient(tx.config)
 	tx.Filter = NewFilterClient(tx.config)
+	tx.FilterTranslation = NewFilterTranslationClient(tx.config)
+	tx.OutboxEmail = NewOutboxEmailClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.RuleGroup = NewRuleGroupClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserFilter = NewUserFilterClient(tx.config)
 }
@@ -1,30 +1,42 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/citizenkz/core/app"
 	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 func main() {
 	cfg := config.MustLoad()
 
-	log := slog.New(
-		slog.NewTextHandler(
-			os.Stdout,
-			&slog.HandlerOptions{
-				Level: slog.LevelDebug,
-			},
-		),
-	)
+	log := logger.New(cfg.Env, os.Stdout)
+
+	// SIGTERM is how the orchestrator asks us to stop
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Arguments after the flags name a subcommand, e.g. `migrate up`
+	if args := flag.Args(); len(args) > 0 {
+		if err := app.RunCommand(ctx, cfg, log, args); err != nil {
+			log.Error("command failed", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
 
 	log.Info("starting application")
 
 	application := app.New(cfg, log)
 
-	if err := application.Run(); err != nil {
-		panic(err)
+	if err := application.Run(ctx); err != nil {
+		log.Error("application failed", slog.String("error", err.Error()))
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,34 @@
+-- reverse: create "user_filters" table
+DROP TABLE "user_filters";
+-- reverse: create index "refresh_tokens_token_hash_key" to table: "refresh_tokens"
+DROP INDEX "refresh_tokens_token_hash_key";
+-- reverse: create "refresh_tokens" table
+DROP TABLE "refresh_tokens";
+-- reverse: create "recovery_codes" table
+DROP TABLE "recovery_codes";
+-- reverse: create "email_verifications" table
+DROP TABLE "email_verifications";
+-- reverse: create "child_filters" table
+DROP TABLE "child_filters";
+-- reverse: create "childs" table
+DROP TABLE "childs";
+-- reverse: create index "users_email_key" to table: "users"
+DROP INDEX "users_email_key";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create "benefit_filters" table
+DROP TABLE "benefit_filters";
+-- reverse: create "rule_groups" table
+DROP TABLE "rule_groups";
+-- reverse: create "filters" table
+DROP TABLE "filters";
+-- reverse: create "benefit_categories" table
+DROP TABLE "benefit_categories";
+-- reverse: create "categories" table
+DROP TABLE "categories";
+-- reverse: create index "attempts_reset_token_hash_key" to table: "attempts"
+DROP INDEX "attempts_reset_token_hash_key";
+-- reverse: create "attempts" table
+DROP TABLE "attempts";
+-- reverse: create "benefits" table
+DROP TABLE "benefits";
@@ -0,0 +1,34 @@
+-- create "benefits" table
+CREATE TABLE "benefits" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "title" character varying NOT NULL, "content" text NOT NULL, "bonus" character varying NOT NULL, "video_url" character varying NULL, "source_url" character varying NULL, PRIMARY KEY ("id"));
+-- create "attempts" table
+CREATE TABLE "attempts" ("id" uuid NOT NULL, "otp" character varying NOT NULL, "email" character varying NOT NULL, "tries" bigint NOT NULL DEFAULT 0, "reset_token_hash" character varying NULL, "verified_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "attempts_reset_token_hash_key" to table: "attempts"
+CREATE UNIQUE INDEX "attempts_reset_token_hash_key" ON "attempts" ("reset_token_hash");
+-- create "categories" table
+CREATE TABLE "categories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "description" character varying NULL, PRIMARY KEY ("id"));
+-- create "benefit_categories" table
+CREATE TABLE "benefit_categories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "benefit_id" bigint NOT NULL, "category_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "benefit_categories_benefits_benefit_categories" FOREIGN KEY ("benefit_id") REFERENCES "benefits" ("id") ON DELETE NO ACTION, CONSTRAINT "benefit_categories_categories_benefit_categories" FOREIGN KEY ("category_id") REFERENCES "categories" ("id") ON DELETE NO ACTION);
+-- create "filters" table
+CREATE TABLE "filters" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "hint" character varying NULL, "type" character varying NOT NULL, "values" jsonb NOT NULL, "is_age" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create "rule_groups" table
+CREATE TABLE "rule_groups" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "operator" character varying NOT NULL, "benefit_id" bigint NOT NULL, "parent_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "rule_groups_benefits_rule_groups" FOREIGN KEY ("benefit_id") REFERENCES "benefits" ("id") ON DELETE NO ACTION, CONSTRAINT "rule_groups_rule_groups_children" FOREIGN KEY ("parent_id") REFERENCES "rule_groups" ("id") ON DELETE SET NULL);
+-- create "benefit_filters" table
+CREATE TABLE "benefit_filters" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "value" character varying NULL, "from" character varying NULL, "to" character varying NULL, "benefit_id" bigint NOT NULL, "filter_id" bigint NOT NULL, "rule_group_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "benefit_filters_benefits_benefit_filters" FOREIGN KEY ("benefit_id") REFERENCES "benefits" ("id") ON DELETE NO ACTION, CONSTRAINT "benefit_filters_filters_benefit_filters" FOREIGN KEY ("filter_id") REFERENCES "filters" ("id") ON DELETE NO ACTION, CONSTRAINT "benefit_filters_rule_groups_benefit_filters" FOREIGN KEY ("rule_group_id") REFERENCES "rule_groups" ("id") ON DELETE SET NULL);
+-- create "users" table
+CREATE TABLE "users" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "first_name" character varying NOT NULL, "last_name" character varying NOT NULL, "birth_date" timestamptz NULL, "email" character varying NOT NULL, "email_verified_at" timestamptz NULL, "pending_email" character varying NULL, "password" character varying NOT NULL, "role" character varying NOT NULL DEFAULT 'citizen', "totp_secret" character varying NULL, "totp_enabled_at" timestamptz NULL, "totp_last_step" bigint NOT NULL DEFAULT 0, "totp_required" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
+-- create "childs" table
+CREATE TABLE "childs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "first_name" character varying NOT NULL, "last_name" character varying NOT NULL, "birth_date" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "childs_users_children" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create "child_filters" table
+CREATE TABLE "child_filters" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "value" character varying NOT NULL, "child_id" bigint NOT NULL, "filter_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "child_filters_childs_child_filters" FOREIGN KEY ("child_id") REFERENCES "childs" ("id") ON DELETE NO ACTION, CONSTRAINT "child_filters_filters_child_filters" FOREIGN KEY ("filter_id") REFERENCES "filters" ("id") ON DELETE NO ACTION);
+-- create "email_verifications" table
+CREATE TABLE "email_verifications" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "email" character varying NOT NULL, "otp" character varying NOT NULL, "tries" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "email_verifications_users_email_verifications" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create "recovery_codes" table
+CREATE TABLE "recovery_codes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "code_hash" character varying NOT NULL, "used_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "recovery_codes_users_recovery_codes" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create "refresh_tokens" table
+CREATE TABLE "refresh_tokens" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "token_hash" character varying NOT NULL, "family_id" uuid NOT NULL, "expires_at" timestamptz NOT NULL, "revoked_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "refresh_tokens_users_refresh_tokens" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "refresh_tokens_token_hash_key" to table: "refresh_tokens"
+CREATE UNIQUE INDEX "refresh_tokens_token_hash_key" ON "refresh_tokens" ("token_hash");
+-- create "user_filters" table
+CREATE TABLE "user_filters" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "value" character varying NOT NULL, "filter_id" bigint NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "user_filters_filters_user_filters" FOREIGN KEY ("filter_id") REFERENCES "filters" ("id") ON DELETE NO ACTION, CONSTRAINT "user_filters_users_user_filters" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
@@ -0,0 +1,4 @@
+-- reverse: create index "outboxemail_status_next_attempt_at" to table: "outbox_emails"
+DROP INDEX "outboxemail_status_next_attempt_at";
+-- reverse: create "outbox_emails" table
+DROP TABLE "outbox_emails";
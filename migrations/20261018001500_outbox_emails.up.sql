@@ -0,0 +1,4 @@
+-- create "outbox_emails" table
+CREATE TABLE "outbox_emails" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "kind" character varying NOT NULL, "recipient" character varying NOT NULL, "subject" character varying NOT NULL, "body" text NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "locked_until" timestamptz NULL, "last_error" text NULL, "sent_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "outboxemail_status_next_attempt_at" to table: "outbox_emails"
+CREATE INDEX "outboxemail_status_next_attempt_at" ON "outbox_emails" ("status", "next_attempt_at");
@@ -0,0 +1,4 @@
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "locale";
+-- reverse: modify "outbox_emails" table
+ALTER TABLE "outbox_emails" DROP COLUMN "text_body";
@@ -0,0 +1,4 @@
+-- modify "outbox_emails" table
+ALTER TABLE "outbox_emails" ADD COLUMN "text_body" text NOT NULL DEFAULT '';
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "locale" character varying NOT NULL DEFAULT 'en';
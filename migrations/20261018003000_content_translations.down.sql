@@ -0,0 +1,12 @@
+-- reverse: create index "filtertranslation_filter_id_locale" to table: "filter_translations"
+DROP INDEX "filtertranslation_filter_id_locale";
+-- reverse: create "filter_translations" table
+DROP TABLE "filter_translations";
+-- reverse: create index "categorytranslation_category_id_locale" to table: "category_translations"
+DROP INDEX "categorytranslation_category_id_locale";
+-- reverse: create "category_translations" table
+DROP TABLE "category_translations";
+-- reverse: create index "benefittranslation_benefit_id_locale" to table: "benefit_translations"
+DROP INDEX "benefittranslation_benefit_id_locale";
+-- reverse: create "benefit_translations" table
+DROP TABLE "benefit_translations";
@@ -0,0 +1,12 @@
+-- create "benefit_translations" table
+CREATE TABLE "benefit_translations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "locale" character varying NOT NULL, "title" character varying NULL, "content" text NULL, "bonus" character varying NULL, "benefit_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "benefit_translations_benefits_translations" FOREIGN KEY ("benefit_id") REFERENCES "benefits" ("id") ON DELETE CASCADE);
+-- create index "benefittranslation_benefit_id_locale" to table: "benefit_translations"
+CREATE UNIQUE INDEX "benefittranslation_benefit_id_locale" ON "benefit_translations" ("benefit_id", "locale");
+-- create "category_translations" table
+CREATE TABLE "category_translations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "locale" character varying NOT NULL, "name" character varying NULL, "description" character varying NULL, "category_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "category_translations_categories_translations" FOREIGN KEY ("category_id") REFERENCES "categories" ("id") ON DELETE CASCADE);
+-- create index "categorytranslation_category_id_locale" to table: "category_translations"
+CREATE UNIQUE INDEX "categorytranslation_category_id_locale" ON "category_translations" ("category_id", "locale");
+-- create "filter_translations" table
+CREATE TABLE "filter_translations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "locale" character varying NOT NULL, "name" character varying NULL, "hint" character varying NULL, "values" jsonb NULL, "filter_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "filter_translations_filters_translations" FOREIGN KEY ("filter_id") REFERENCES "filters" ("id") ON DELETE CASCADE);
+-- create index "filtertranslation_filter_id_locale" to table: "filter_translations"
+CREATE UNIQUE INDEX "filtertranslation_filter_id_locale" ON "filter_translations" ("filter_id", "locale");
@@ -0,0 +1,2 @@
+-- reverse: modify "email_verifications" table
+ALTER TABLE "email_verifications" DROP COLUMN "sent_at";
@@ -0,0 +1,4 @@
+-- modify "email_verifications" table
+ALTER TABLE "email_verifications" ADD COLUMN "sent_at" timestamptz NULL;
+UPDATE "email_verifications" SET "sent_at" = "created_at";
+ALTER TABLE "email_verifications" ALTER COLUMN "sent_at" SET NOT NULL;
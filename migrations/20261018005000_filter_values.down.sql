@@ -0,0 +1 @@
+-- reverse: the values removed by the up migration can't be restored
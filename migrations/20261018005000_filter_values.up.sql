@@ -0,0 +1,28 @@
+-- Filter values that don't cast the way the benefit query casts them made the
+-- whole query fail. They are rejected on write now; this clears the old ones.
+CREATE FUNCTION pg_temp.valid_date(value text) RETURNS boolean LANGUAGE plpgsql AS $$
+BEGIN
+  IF value IS NULL THEN
+    RETURN TRUE;
+  END IF;
+  IF value !~ '^\s*[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[-+][0-9]{2}:[0-9]{2}))?\s*$' THEN
+    RETURN FALSE;
+  END IF;
+  IF LENGTH(TRIM(value)) = 10 THEN
+    PERFORM CAST(TRIM(value) AS DATE);
+  ELSE
+    PERFORM CAST(CAST(TRIM(value) AS TIMESTAMPTZ) AT TIME ZONE 'UTC' AS DATE);
+  END IF;
+  RETURN TRUE;
+EXCEPTION WHEN others THEN
+  RETURN FALSE;
+END;
+$$;
+-- drop answers that can't be compared, users and children are asked again
+DELETE FROM "user_filters" WHERE "filter_id" IN (SELECT "id" FROM "filters" WHERE "type" = 'DATE_RANGE') AND NOT pg_temp.valid_date("value");
+DELETE FROM "child_filters" WHERE "filter_id" IN (SELECT "id" FROM "filters" WHERE "type" = 'DATE_RANGE') AND NOT pg_temp.valid_date("value");
+DELETE FROM "user_filters" WHERE "filter_id" IN (SELECT "id" FROM "filters" WHERE "type" = 'NUMBER_RANGE') AND "value" !~ '^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$';
+DELETE FROM "child_filters" WHERE "filter_id" IN (SELECT "id" FROM "filters" WHERE "type" = 'NUMBER_RANGE') AND "value" !~ '^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$';
+-- turn benefit conditions on a bad date into an empty range, which never
+-- matches, as the bad date never did; saving the benefit asks for a valid one
+UPDATE "benefit_filters" SET "value" = NULL, "from" = '9999-12-31', "to" = '0001-01-01' WHERE "filter_id" IN (SELECT "id" FROM "filters" WHERE "type" = 'DATE_RANGE') AND NOT (pg_temp.valid_date("value") AND pg_temp.valid_date("from") AND pg_temp.valid_date("to"));
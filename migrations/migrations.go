@@ -0,0 +1,9 @@
+// Package migrations embeds the versioned SQL migrations generated from
+// ent/schema. Add one with `make migration name=<name>`; never edit a file
+// that has been applied somewhere, atlas.sum will reject it.
+package migrations
+
+import "embed"
+
+//go:embed *.sql atlas.sum
+var FS embed.FS
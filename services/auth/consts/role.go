@@ -0,0 +1,22 @@
+package consts
+
+type Role string
+
+const (
+	Citizen Role = "citizen"
+	Editor  Role = "editor"
+	Admin   Role = "admin"
+)
+
+func (role Role) String() string {
+	return string(role)
+}
+
+func (role Role) IsValid() bool {
+	switch role {
+	case Citizen, Editor, Admin:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package entity
+
+type (
+	CreateAdminRequest struct {
+		FirstName string `json:"first_name" validate:"required,max=100"`
+		LastName  string `json:"last_name" validate:"required,max=100"`
+		Email     string `json:"email" validate:"required,email"`
+		Password  string `json:"password" validate:"required,min=8"`
+	}
+
+	CreateAdminResponse struct {
+		Profile User `json:"profile"`
+	}
+
+	PurgeAttemptsResponse struct {
+		Deleted int `json:"deleted"`
+	}
+
+	ResendVerificationByEmailRequest struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+)
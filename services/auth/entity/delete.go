@@ -2,7 +2,7 @@ package entity
 
 type (
 	DeleteRequest struct {
-		Password string `json:"password"`
+		Password string `json:"password" validate:"required"`
 		Token    string `json:"-"`
 	}
 
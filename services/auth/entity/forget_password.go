@@ -1,21 +1,37 @@
 package entity
 
+import "github.com/google/uuid"
+
 type (
 	ForgetPasswordRequest struct {
-		Email string `json:"email"`
+		IP    string `json:"-"`
+		Email string `json:"email" validate:"required,email"`
 	}
 
 	ForgetPasswordResponse struct {
-		AttemptID int `json:"attempt_id"`
-		RetryTime int `json:"retry_time"`
+		AttemptID uuid.UUID `json:"attempt_id"`
+		RetryTime int       `json:"retry_time"`
 	}
 
 	ForgetPasswordConfirmRequest struct {
-		AttemptID int    `json:"attempt_id"`
-		OtpCode   string `json:"otp_code"`
+		IP        string    `json:"-"`
+		AttemptID uuid.UUID `json:"attempt_id" validate:"required"`
+		OtpCode   string    `json:"otp_code" validate:"required"`
 	}
 
 	ForgetPasswordConfirmResponse struct {
+		ResetToken string `json:"reset_token"`
+		ExpiresIn  int    `json:"expires_in"`
+	}
+
+	ResetPasswordRequest struct {
+		IP              string `json:"-"`
+		ResetToken      string `json:"reset_token" validate:"required"`
+		Password        string `json:"password" validate:"required,min=8"`
+		ConfirmPassword string `json:"confirm_password" validate:"eqfield=Password"`
+	}
+
+	ResetPasswordResponse struct {
 		Profile User `json:"profile"`
 	}
 )
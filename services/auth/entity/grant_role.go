@@ -0,0 +1,15 @@
+package entity
+
+import "github.com/citizenkz/core/services/auth/consts"
+
+type (
+	GrantRoleRequest struct {
+		Token  string      `json:"-"`
+		UserID int         `json:"user_id" validate:"required"`
+		Role   consts.Role `json:"role" validate:"required,oneof=citizen editor admin"`
+	}
+
+	GrantRoleResponse struct {
+		Profile User `json:"profile"`
+	}
+)
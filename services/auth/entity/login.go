@@ -2,12 +2,20 @@ package entity
 
 type (
 	LoginRequest struct {
-		Email    string `json:"email"`
-		Password string `json:"password"`
+		IP       string `json:"-"`
+		Email    string `json:"email" validate:"required"`
+		Password string `json:"password" validate:"required"`
 	}
 
+	// LoginResponse carries either the tokens and profile or, for accounts
+	// with two-factor authentication, only a challenge token to finish the
+	// login with. The profile waits until the second factor is checked.
 	LoginResponse struct {
-		Profile User   `json:"profile"`
-		Token   string `json:"token"`
+		Profile                *User  `json:"profile,omitempty"`
+		Token                  string `json:"token,omitempty"`
+		RefreshToken           string `json:"refresh_token,omitempty"`
+		ChallengeToken         string `json:"challenge_token,omitempty"`
+		TwoFactorRequired      bool   `json:"two_factor_required,omitempty"`
+		TwoFactorSetupRequired bool   `json:"two_factor_setup_required,omitempty"`
 	}
 )
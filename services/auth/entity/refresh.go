@@ -0,0 +1,21 @@
+package entity
+
+type (
+	RefreshRequest struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+	}
+
+	RefreshResponse struct {
+		Token        string `json:"token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	LogoutRequest struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+		All          bool   `json:"all"`
+	}
+
+	LogoutResponse struct {
+		Success bool `json:"success"`
+	}
+)
@@ -1,19 +1,26 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/citizenkz/core/utils/locale"
+)
 
 type (
 	RegisterRequest struct {
-		FirstName       string    `json:"first_name"`
-		LastName        string    `json:"last_name"`
-		Email           string    `json:"email"`
-		Password        string    `json:"password"`
-		ConfirmPassword string    `json:"confirm_password"`
-		BirthDate       *time.Time `json:"birth_date"`
+		FirstName       string     `json:"first_name" validate:"required,max=100"`
+		LastName        string     `json:"last_name" validate:"required,max=100"`
+		Email           string     `json:"email" validate:"required,email"`
+		Password        string     `json:"password" validate:"required,min=8"`
+		ConfirmPassword string     `json:"confirm_password" validate:"eqfield=Password"`
+		BirthDate       *time.Time `json:"birth_date" validate:"past"`
+		// Language of the user's emails, from Accept-Language when left out
+		Locale locale.Locale `json:"locale,omitempty" validate:"oneof=en kk ru"`
 	}
 
 	RegisterResponse struct {
-		Profile User   `json:"profile"`
-		Token   string `json:"token"`
+		Profile      User   `json:"profile"`
+		Token        string `json:"token"`
+		RefreshToken string `json:"refresh_token"`
 	}
 )
@@ -0,0 +1,50 @@
+package entity
+
+type (
+	EnrollTOTPRequest struct {
+		Token string `json:"-"`
+	}
+
+	EnrollTOTPResponse struct {
+		Secret     string `json:"secret"`
+		OtpauthURI string `json:"otpauth_uri"`
+	}
+
+	ConfirmTOTPRequest struct {
+		Token   string `json:"-"`
+		OtpCode string `json:"otp_code" validate:"required"`
+	}
+
+	ConfirmTOTPResponse struct {
+		Profile       User     `json:"profile"`
+		RecoveryCodes []string `json:"recovery_codes"`
+		Token         string   `json:"token,omitempty"`
+		RefreshToken  string   `json:"refresh_token,omitempty"`
+	}
+
+	DisableTOTPRequest struct {
+		Token    string `json:"-"`
+		Password string `json:"password" validate:"required"`
+		OtpCode  string `json:"otp_code"`
+	}
+
+	DisableTOTPResponse struct {
+		Profile User `json:"profile"`
+	}
+
+	LoginTwoFactorRequest struct {
+		IP             string `json:"-"`
+		ChallengeToken string `json:"challenge_token" validate:"required"`
+		OtpCode        string `json:"otp_code"`
+		RecoveryCode   string `json:"recovery_code"`
+	}
+
+	RequireTOTPRequest struct {
+		UserID   int  `json:"user_id" validate:"required"`
+		Required bool `json:"required"`
+	}
+
+	RequireTOTPResponse struct {
+		Profile User `json:"profile"`
+	}
+)
@@ -5,9 +5,9 @@ import "time"
 type (
 	UpdateRequest struct {
 		ID        int        `json:"user_id"`
-		FirstName string     `json:"first_name"`
-		LastName  string     `json:"last_name"`
-		BirthDate *time.Time `json:"birth_date"`
+		FirstName string     `json:"first_name" validate:"max=100"`
+		LastName  string     `json:"last_name" validate:"max=100"`
+		BirthDate *time.Time `json:"birth_date" validate:"past"`
 	}
 
 	UpdateResponse struct {
@@ -3,8 +3,8 @@ package entity
 type (
 	UpdateEmailRequest struct {
 		Token    string `json:"-"`
-		Email    string `json:"email"`
-		Password string `json:"password"`
+		Email    string `json:"email" validate:"required,email"`
+		Password string `json:"password" validate:"required"`
 	}
 
 	UpdateEmailResponse struct {
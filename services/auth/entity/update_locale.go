@@ -0,0 +1,14 @@
+package entity
+
+import "github.com/citizenkz/core/utils/locale"
+
+type (
+	UpdateLocaleRequest struct {
+		Token  string        `json:"-"`
+		Locale locale.Locale `json:"locale" validate:"required,oneof=en kk ru"`
+	}
+
+	UpdateLocaleResponse struct {
+		Profile User `json:"profile"`
+	}
+)
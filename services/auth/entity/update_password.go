@@ -3,9 +3,9 @@ package entity
 type (
 	UpdatePasswordRequest struct {
 		Token           string `json:"-"`
-		OldPassword     string `json:"old_password"`
-		Password        string `json:"password"`
-		ConfirmPassword string `json:"confirm_password"`
+		OldPassword     string `json:"old_password" validate:"required"`
+		Password        string `json:"password" validate:"required,min=8"`
+		ConfirmPassword string `json:"confirm_password" validate:"eqfield=Password"`
 	}
 
 	UpdatePasswordResponse struct {
@@ -4,29 +4,47 @@ import (
 	"time"
 
 	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/auth/consts"
+	"github.com/citizenkz/core/utils/locale"
 )
 
 type (
 	User struct {
-		ID        int       `json:"id"`
-		FirstName string    `json:"first_name"`
-		LastName  string    `json:"last_name"`
-		Email     string    `json:"email"`
-		Password  string    `json:"-"`
-		BirthDate time.Time `json:"birth_date"`
-		CreatedAt time.Time `json:"created_at"`
+		ID              int           `json:"id"`
+		FirstName       string        `json:"first_name"`
+		LastName        string        `json:"last_name"`
+		Email           string        `json:"email"`
+		EmailVerifiedAt *time.Time    `json:"email_verified_at"`
+		PendingEmail    *string       `json:"pending_email,omitempty"`
+		Password        string        `json:"-"`
+		BirthDate       time.Time     `json:"birth_date"`
+		Role            consts.Role   `json:"role"`
+		Locale          locale.Locale `json:"locale"`
+		TOTPSecret      *string       `json:"-"`
+		TOTPEnabledAt   *time.Time    `json:"totp_enabled_at"`
+		TOTPLastStep    int64         `json:"-"`
+		TOTPRequired    bool          `json:"totp_required"`
+		CreatedAt       time.Time     `json:"created_at"`
 	}
 )
 
 func MakeStorageUserToEntity(user *ent.User) *User {
 	return &User{
-		ID:        user.ID,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Email:     user.Email,
-		Password:  user.Password,
-		BirthDate: user.BirthDate,
-		CreatedAt: user.CreatedAt,
+		ID:              user.ID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		PendingEmail:    user.PendingEmail,
+		Password:        user.Password,
+		BirthDate:       user.BirthDate,
+		Role:            consts.Role(user.Role),
+		Locale:          locale.Locale(user.Locale),
+		TOTPSecret:      user.TotpSecret,
+		TOTPEnabledAt:   user.TotpEnabledAt,
+		TOTPLastStep:    user.TotpLastStep,
+		TOTPRequired:    user.TotpRequired,
+		CreatedAt:       user.CreatedAt,
 	}
 }
 
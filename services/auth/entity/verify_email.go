@@ -0,0 +1,23 @@
+package entity
+
+type (
+	VerifyEmailRequest struct {
+		Token   string `json:"-"`
+		IP      string `json:"-"`
+		OtpCode string `json:"otp_code" validate:"required"`
+	}
+
+	VerifyEmailResponse struct {
+		Profile User `json:"profile"`
+	}
+
+	ResendVerificationRequest struct {
+		Token string `json:"-"`
+		IP    string `json:"-"`
+	}
+
+	ResendVerificationResponse struct {
+		Email     string `json:"email"`
+		RetryTime int    `json:"retry_time"`
+	}
+)
@@ -1,13 +1,17 @@
 package server
 
 import (
+	"context"
 	"log/slog"
+	"net"
 	"net/http"
 
 	"github.com/citizenkz/core/services/auth/entity"
 	"github.com/citizenkz/core/services/auth/usecase"
 	"github.com/citizenkz/core/utils/json"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 type server struct {
@@ -21,9 +25,21 @@ type Server interface {
 	HandleGet(w http.ResponseWriter, r *http.Request)
 	HandleUpdatePassword(w http.ResponseWriter, r *http.Request)
 	HandleUpdateEmail(w http.ResponseWriter, r *http.Request)
+	HandleUpdateLocale(w http.ResponseWriter, r *http.Request)
 	HandleDelete(w http.ResponseWriter, r *http.Request)
 	HandleForgetPassword(w http.ResponseWriter, r *http.Request)
 	HandleForgetPasswordConfirm(w http.ResponseWriter, r *http.Request)
+	HandleResetPassword(w http.ResponseWriter, r *http.Request)
+	HandleGrantRole(w http.ResponseWriter, r *http.Request)
+	HandleRefresh(w http.ResponseWriter, r *http.Request)
+	HandleLogout(w http.ResponseWriter, r *http.Request)
+	HandleVerifyEmail(w http.ResponseWriter, r *http.Request)
+	HandleResendVerification(w http.ResponseWriter, r *http.Request)
+	HandleEnrollTOTP(w http.ResponseWriter, r *http.Request)
+	HandleConfirmTOTP(w http.ResponseWriter, r *http.Request)
+	HandleDisableTOTP(w http.ResponseWriter, r *http.Request)
+	HandleLoginTwoFactor(w http.ResponseWriter, r *http.Request)
+	HandleRequireTOTP(w http.ResponseWriter, r *http.Request)
 }
 
 func New(log *slog.Logger, usecase usecase.UseCase) Server {
@@ -33,24 +49,31 @@ func New(log *slog.Logger, usecase usecase.UseCase) Server {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *server) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *server) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	req := &entity.LoginRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
+	req.IP = clientIP(r)
+
 	resp, err := s.usecase.Login(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Login", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Login", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -59,21 +82,24 @@ func (s *server) HandleLogin(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleRegister(w http.ResponseWriter, r *http.Request) {
 	req := &entity.RegisterRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
+	if req.Locale == "" {
+		req.Locale = locale.FromContext(r.Context())
+	}
 
 	resp, err := s.usecase.Register(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Register", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Register", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -82,7 +108,7 @@ func (s *server) HandleRegister(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
@@ -92,14 +118,14 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.GetProfile(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.GetProfile", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.GetProfile", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -108,29 +134,29 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleUpdatePassword(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 
 	req := &entity.UpdatePasswordRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 	req.Token = token
 
 	resp, err := s.usecase.UpdatePassword(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.UpdatePassword", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.UpdatePassword", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -139,29 +165,60 @@ func (s *server) HandleUpdatePassword(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleUpdateEmail(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 
 	req := &entity.UpdateEmailRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 	req.Token = token
 
 	resp, err := s.usecase.UpdateEmail(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.UpdateEmail", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.UpdateEmail", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleUpdateLocale(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.UpdateLocaleRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+	req.Token = token
+
+	resp, err := s.usecase.UpdateLocale(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.UpdateLocale", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -170,29 +227,29 @@ func (s *server) HandleUpdateEmail(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 
 	req := &entity.DeleteRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 	req.Token = token
 
 	resp, err := s.usecase.Delete(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Delete", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Delete", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -201,21 +258,23 @@ func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleForgetPassword(w http.ResponseWriter, r *http.Request) {
 	req := &entity.ForgetPasswordRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
+	req.IP = clientIP(r)
+
 	resp, err := s.usecase.ForgetPassword(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.ForgetPassword", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.ForgetPassword", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -224,22 +283,337 @@ func (s *server) HandleForgetPassword(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleForgetPasswordConfirm(w http.ResponseWriter, r *http.Request) {
 	req := &entity.ForgetPasswordConfirmRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
+	req.IP = clientIP(r)
+
 	resp, err := s.usecase.ForgetPasswordConfirm(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.ForgetPasswordConfirm", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.ForgetPasswordConfirm", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
 }
+
+func (s *server) HandleResetPassword(w http.ResponseWriter, r *http.Request) {
+	req := &entity.ResetPasswordRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.IP = clientIP(r)
+
+	resp, err := s.usecase.ResetPassword(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.ResetPassword", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleGrantRole(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.GrantRoleRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.Token = token
+
+	resp, err := s.usecase.GrantRole(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.GrantRole", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleRefresh(w http.ResponseWriter, r *http.Request) {
+	req := &entity.RefreshRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	resp, err := s.usecase.Refresh(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.Refresh", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	req := &entity.LogoutRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	resp, err := s.usecase.Logout(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.Logout", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.VerifyEmailRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.Token = token
+	req.IP = clientIP(r)
+
+	resp, err := s.usecase.VerifyEmail(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.VerifyEmail", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleResendVerification(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.ResendVerificationRequest{
+		Token: token,
+		IP:    clientIP(r),
+	}
+
+	resp, err := s.usecase.ResendVerification(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.ResendVerification", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleEnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.EnrollTOTPRequest{
+		Token: token,
+	}
+
+	resp, err := s.usecase.EnrollTOTP(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.EnrollTOTP", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.ConfirmTOTPRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.Token = token
+
+	resp, err := s.usecase.ConfirmTOTP(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.ConfirmTOTP", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleDisableTOTP(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.DisableTOTPRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.Token = token
+
+	resp, err := s.usecase.DisableTOTP(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.DisableTOTP", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleLoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	req := &entity.LoginTwoFactorRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.IP = clientIP(r)
+
+	resp, err := s.usecase.LoginTwoFactor(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.LoginTwoFactor", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleRequireTOTP(w http.ResponseWriter, r *http.Request) {
+	req := &entity.RequireTOTPRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	resp, err := s.usecase.RequireTOTP(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.RequireTOTP", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+// clientIP returns the address the request came from, without the port.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -3,11 +3,21 @@ package storage
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/citizenkz/core/ent"
 	"github.com/citizenkz/core/ent/attempt"
+	"github.com/citizenkz/core/ent/emailverification"
+	"github.com/citizenkz/core/ent/recoverycode"
+	"github.com/citizenkz/core/ent/refreshtoken"
 	"github.com/citizenkz/core/ent/user"
+	"github.com/citizenkz/core/services/auth/consts"
 	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/dberr"
+	"github.com/citizenkz/core/utils/dbtx"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/citizenkz/core/utils/logger"
 	"github.com/google/uuid"
 )
 
@@ -17,16 +27,41 @@ type storage struct {
 }
 
 type Storage interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 	CreateUser(ctx context.Context, req *entity.RegisterRequest) (*entity.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
 	GetUserByID(ctx context.Context, userID int) (*entity.User, error)
 	UpdateUser(ctx context.Context, req *entity.UpdateRequest) (*entity.User, error)
 	UpdateUserPassword(ctx context.Context, userID int, password string) (*entity.User, error)
 	UpdateUserEmail(ctx context.Context, userID int, email string) (*entity.User, error)
+	UpdateUserRole(ctx context.Context, userID int, role consts.Role) (*entity.User, error)
+	UpdateUserLocale(ctx context.Context, userID int, loc locale.Locale) (*entity.User, error)
 	DeleteUser(ctx context.Context, userID int) error
-	CreateAttempt(ctx context.Context, email, otp string) (uuid.UUID, error)
+	CreateAttempt(ctx context.Context, attemptID uuid.UUID, email, otp string) error
 	GetAttempt(ctx context.Context, attemptID uuid.UUID) (*ent.Attempt, error)
 	DeleteAttempt(ctx context.Context, attemptID uuid.UUID) error
+	DeleteAttemptsByEmail(ctx context.Context, email string) error
+	UseAttemptTry(ctx context.Context, attemptID uuid.UUID, maxTries int) (bool, error)
+	VerifyAttempt(ctx context.Context, attemptID uuid.UUID, resetToken string) error
+	GetAttemptByResetToken(ctx context.Context, resetToken string) (*ent.Attempt, error)
+	DeleteExpiredAttempts(ctx context.Context, codeExpiry, resetExpiry time.Time) (int, error)
+	SetUserPendingEmail(ctx context.Context, userID int, email string) (*entity.User, error)
+	CreateEmailVerification(ctx context.Context, userID int, email, otp string) error
+	GetEmailVerification(ctx context.Context, userID int) (*ent.EmailVerification, error)
+	UseEmailVerificationTry(ctx context.Context, id, maxTries int) (int, bool, error)
+	MarkEmailVerificationSent(ctx context.Context, id int, cooldown time.Duration) (bool, error)
+	ConfirmUserEmail(ctx context.Context, userID int, email string) (*entity.User, error)
+	SetUserTOTPSecret(ctx context.Context, userID int, secret string) error
+	EnableUserTOTP(ctx context.Context, userID int, step int64, recoveryCodes []string) (*entity.User, error)
+	DisableUserTOTP(ctx context.Context, userID int) (*entity.User, error)
+	UseTOTPStep(ctx context.Context, userID int, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID int, code string) (bool, error)
+	SetUserTOTPRequired(ctx context.Context, userID int, required bool) (*entity.User, error)
+	CreateRefreshToken(ctx context.Context, userID int, familyID uuid.UUID, token string, expiresAt time.Time) error
+	GetRefreshToken(ctx context.Context, token string) (*ent.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, id int) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int) error
 }
 
 func New(client *ent.Client, log *slog.Logger) Storage {
@@ -36,125 +71,671 @@ func New(client *ent.Client, log *slog.Logger) Storage {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *storage) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
+// db returns the client to query through, joining the transaction of WithTx.
+func (s *storage) db(ctx context.Context) *ent.Client {
+	return dbtx.Client(ctx, s.client)
+}
+
+// WithTx runs fn in one transaction. Storage calls made with the context fn
+// gets, here or in other storages, commit or roll back together.
+func (s *storage) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return dbtx.Run(ctx, s.client, s.logger(ctx), fn)
+}
+
 func (s *storage) CreateUser(ctx context.Context, req *entity.RegisterRequest) (*entity.User, error) {
-	user, err := s.client.User.Create().
+	create := s.db(ctx).User.Create().
 		SetFirstName(req.FirstName).
 		SetLastName(req.LastName).
 		SetNillableBirthDate(req.BirthDate).
 		SetPassword(req.Password).
-		SetEmail(req.Email).
-		Save(ctx)
+		SetEmail(req.Email)
+	if req.Locale != "" {
+		create = create.SetLocale(user.Locale(req.Locale.String()))
+	}
+
+	user, err := create.Save(ctx)
 	if err != nil {
-		s.log.Error("failed to save user", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to save user", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return entity.MakeStorageUserToEntity(user), nil
 }
 
 func (s *storage) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
-	user, err := s.client.User.Query().
+	user, err := s.db(ctx).User.Query().
 		Where(
 			user.Email(email),
 		).First(ctx)
 	if err != nil {
-		s.log.Error("failed to get user email", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get user email", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return entity.MakeStorageUserToEntity(user), nil
 }
 
 func (s *storage) GetUserByID(ctx context.Context, userID int) (*entity.User, error) {
-	user, err := s.client.User.Get(ctx, userID)
+	user, err := s.db(ctx).User.Get(ctx, userID)
 	if err != nil {
-		s.log.Error("failed to get user by id", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get user by id", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return entity.MakeStorageUserToEntity(user), nil
 }
 
 func (s *storage) UpdateUser(ctx context.Context, req *entity.UpdateRequest) (*entity.User, error) {
-	user, err := s.client.User.UpdateOneID(req.ID).
+	user, err := s.db(ctx).User.UpdateOneID(req.ID).
 		SetFirstName(req.FirstName).
 		SetLastName(req.LastName).
 		SetNillableBirthDate(req.BirthDate).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update user", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update user", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return entity.MakeStorageUserToEntity(user), nil
 }
 
 func (s *storage) UpdateUserPassword(ctx context.Context, userID int, password string) (*entity.User, error) {
-	user, err := s.client.User.UpdateOneID(userID).
+	user, err := s.db(ctx).User.UpdateOneID(userID).
 		SetPassword(password).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update user's password", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update user's password", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return entity.MakeStorageUserToEntity(user), nil
 }
 
 func (s *storage) UpdateUserEmail(ctx context.Context, userID int, email string) (*entity.User, error) {
-	user, err := s.client.User.UpdateOneID(userID).
+	user, err := s.db(ctx).User.UpdateOneID(userID).
 		SetEmail(email).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update user's email", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update user's email", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+func (s *storage) UpdateUserRole(ctx context.Context, userID int, role consts.Role) (*entity.User, error) {
+	user, err := s.db(ctx).User.UpdateOneID(userID).
+		SetRole(user.Role(role.String())).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to update user's role", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+func (s *storage) UpdateUserLocale(ctx context.Context, userID int, loc locale.Locale) (*entity.User, error) {
+	user, err := s.db(ctx).User.UpdateOneID(userID).
+		SetLocale(user.Locale(loc.String())).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to update user's locale", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return entity.MakeStorageUserToEntity(user), nil
 }
 
+// DeleteUser deletes the user together with their refresh tokens, which
+// revokes every session of the account, pending email verifications and
+// recovery codes.
 func (s *storage) DeleteUser(ctx context.Context, userID int) error {
-	err := s.client.User.DeleteOneID(userID).Exec(ctx)
+	tx, err := dbtx.Begin(ctx, s.client)
+	if err != nil {
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	_, err = tx.RecoveryCode.Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete recovery codes", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return dberr.Tag(err)
+	}
+
+	_, err = tx.EmailVerification.Delete().
+		Where(emailverification.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete email verifications", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return dberr.Tag(err)
+	}
+
+	_, err = tx.RefreshToken.Delete().
+		Where(refreshtoken.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete refresh tokens", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return dberr.Tag(err)
+	}
+
+	err = tx.User.DeleteOneID(userID).Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete user", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete user", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return dberr.Tag(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	return nil
 }
 
-func (s *storage) CreateAttempt(ctx context.Context, email, otp string) (uuid.UUID, error) {
-	attempt, err := s.client.Attempt.Create().
+func (s *storage) CreateAttempt(ctx context.Context, attemptID uuid.UUID, email, otp string) error {
+	err := s.db(ctx).Attempt.Create().
+		SetID(attemptID).
 		SetEmail(email).
 		SetOtp(otp).
-		Save(ctx)
+		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to create attempt", slog.String("error", err.Error()))
-		return uuid.Nil, err
+		s.logger(ctx).Error("failed to create attempt", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
-	return attempt.ID, nil
+	return nil
 }
 
 func (s *storage) GetAttempt(ctx context.Context, attemptID uuid.UUID) (*ent.Attempt, error) {
-	attempt, err := s.client.Attempt.Query().
+	attempt, err := s.db(ctx).Attempt.Query().
 		Where(attempt.ID(attemptID)).
 		First(ctx)
 	if err != nil {
-		s.log.Error("failed to get attempt", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get attempt", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return attempt, nil
 }
 
 func (s *storage) DeleteAttempt(ctx context.Context, attemptID uuid.UUID) error {
-	_, err := s.client.Attempt.Delete().
+	_, err := s.db(ctx).Attempt.Delete().
 		Where(attempt.ID(attemptID)).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete attempt", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete attempt", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) DeleteAttemptsByEmail(ctx context.Context, email string) error {
+	_, err := s.db(ctx).Attempt.Delete().
+		Where(attempt.Email(email)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete attempts", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+// UseAttemptTry counts a guess of the attempt's code. It reports false once
+// maxTries guesses were made, so concurrent guesses can't go over the limit.
+func (s *storage) UseAttemptTry(ctx context.Context, attemptID uuid.UUID, maxTries int) (bool, error) {
+	n, err := s.db(ctx).Attempt.Update().
+		Where(
+			attempt.ID(attemptID),
+			attempt.TriesLT(maxTries),
+		).
+		AddTries(1).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to use attempt try", slog.String("error", err.Error()))
+		return false, dberr.Tag(err)
+	}
+
+	return n > 0, nil
+}
+
+// VerifyAttempt marks the OTP as confirmed and stores the hash of the reset
+// token that may now set the new password.
+func (s *storage) VerifyAttempt(ctx context.Context, attemptID uuid.UUID, resetToken string) error {
+	err := s.db(ctx).Attempt.UpdateOneID(attemptID).
+		SetResetTokenHash(gen.Hash(resetToken)).
+		SetVerifiedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to verify attempt", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) GetAttemptByResetToken(ctx context.Context, resetToken string) (*ent.Attempt, error) {
+	attempt, err := s.db(ctx).Attempt.Query().
+		Where(attempt.ResetTokenHash(gen.Hash(resetToken))).
+		Only(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get attempt by reset token", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return attempt, nil
+}
+
+// DeleteExpiredAttempts drops unconfirmed attempts created before codeExpiry
+// and confirmed ones verified before resetExpiry.
+func (s *storage) DeleteExpiredAttempts(ctx context.Context, codeExpiry, resetExpiry time.Time) (int, error) {
+	deleted, err := s.db(ctx).Attempt.Delete().
+		Where(attempt.Or(
+			attempt.And(attempt.VerifiedAtIsNil(), attempt.CreatedAtLT(codeExpiry)),
+			attempt.VerifiedAtLT(resetExpiry),
+		)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete expired attempts", slog.String("error", err.Error()))
+		return 0, dberr.Tag(err)
+	}
+
+	return deleted, nil
+}
+
+func (s *storage) SetUserPendingEmail(ctx context.Context, userID int, email string) (*entity.User, error) {
+	user, err := s.db(ctx).User.UpdateOneID(userID).
+		SetPendingEmail(email).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to set user's pending email", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+// CreateEmailVerification replaces any earlier verification of the user, so
+// only the latest code is valid.
+func (s *storage) CreateEmailVerification(ctx context.Context, userID int, email, otp string) error {
+	tx, err := dbtx.Begin(ctx, s.client)
+	if err != nil {
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	_, err = tx.EmailVerification.Delete().
+		Where(emailverification.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete email verifications", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return dberr.Tag(err)
+	}
+
+	_, err = tx.EmailVerification.Create().
+		SetUserID(userID).
+		SetEmail(email).
+		SetOtp(otp).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to create email verification", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return dberr.Tag(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) GetEmailVerification(ctx context.Context, userID int) (*ent.EmailVerification, error) {
+	verification, err := s.db(ctx).EmailVerification.Query().
+		Where(emailverification.UserID(userID)).
+		Order(ent.Desc(emailverification.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get email verification", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return verification, nil
+}
+
+// UseEmailVerificationTry counts a guess of the verification's code and
+// returns the guesses made so far. It reports false once maxTries guesses were
+// made, so concurrent guesses can't go over the limit.
+func (s *storage) UseEmailVerificationTry(ctx context.Context, id, maxTries int) (int, bool, error) {
+	verification, err := s.db(ctx).EmailVerification.UpdateOneID(id).
+		Where(emailverification.TriesLT(maxTries)).
+		AddTries(1).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		s.logger(ctx).Error("failed to use email verification try", slog.String("error", err.Error()))
+		return 0, false, dberr.Tag(err)
+	}
+
+	return verification.Tries, true, nil
+}
+
+// MarkEmailVerificationSent records that the verification's code is emailed
+// again. It reports false while the last email is younger than cooldown.
+func (s *storage) MarkEmailVerificationSent(ctx context.Context, id int, cooldown time.Duration) (bool, error) {
+	now := time.Now()
+	n, err := s.db(ctx).EmailVerification.Update().
+		Where(
+			emailverification.ID(id),
+			emailverification.SentAtLTE(now.Add(-cooldown)),
+		).
+		SetSentAt(now).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to mark email verification sent", slog.String("error", err.Error()))
+		return false, dberr.Tag(err)
+	}
+
+	return n > 0, nil
+}
+
+// ConfirmUserEmail makes email the user's verified address, replacing the
+// current one when it was pending, and drops the used verification.
+func (s *storage) ConfirmUserEmail(ctx context.Context, userID int, email string) (*entity.User, error) {
+	tx, err := dbtx.Begin(ctx, s.client)
+	if err != nil {
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	_, err = tx.EmailVerification.Delete().
+		Where(emailverification.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete email verifications", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	user, err := tx.User.UpdateOneID(userID).
+		SetEmail(email).
+		ClearPendingEmail().
+		SetEmailVerifiedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to confirm user's email", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+// SetUserTOTPSecret stores the secret of an enrollment that still has to be
+// confirmed with a code.
+func (s *storage) SetUserTOTPSecret(ctx context.Context, userID int, secret string) error {
+	err := s.db(ctx).User.UpdateOneID(userID).
+		SetTotpSecret(secret).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to set user's totp secret", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+// EnableUserTOTP turns 2FA on and replaces the user's recovery codes.
+func (s *storage) EnableUserTOTP(ctx context.Context, userID int, step int64, recoveryCodes []string) (*entity.User, error) {
+	tx, err := dbtx.Begin(ctx, s.client)
+	if err != nil {
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	_, err = tx.RecoveryCode.Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete recovery codes", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	builders := make([]*ent.RecoveryCodeCreate, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		builders = append(builders, tx.RecoveryCode.Create().
+			SetUserID(userID).
+			SetCodeHash(gen.Hash(code)))
+	}
+
+	if err := tx.RecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		s.logger(ctx).Error("failed to create recovery codes", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	user, err := tx.User.UpdateOneID(userID).
+		SetTotpEnabledAt(time.Now()).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to enable user's totp", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+func (s *storage) DisableUserTOTP(ctx context.Context, userID int) (*entity.User, error) {
+	tx, err := dbtx.Begin(ctx, s.client)
+	if err != nil {
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	_, err = tx.RecoveryCode.Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete recovery codes", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	user, err := tx.User.UpdateOneID(userID).
+		ClearTotpSecret().
+		ClearTotpEnabledAt().
+		SetTotpLastStep(0).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to disable user's totp", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+// UseTOTPStep records an accepted TOTP step. It reports false when the step
+// or a later one was already used, so a code can't be replayed.
+func (s *storage) UseTOTPStep(ctx context.Context, userID int, step int64) (bool, error) {
+	n, err := s.db(ctx).User.Update().
+		Where(
+			user.ID(userID),
+			user.TotpLastStepLT(step),
+		).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to use totp step", slog.String("error", err.Error()))
+		return false, dberr.Tag(err)
+	}
+
+	return n > 0, nil
+}
+
+// UseRecoveryCode spends an unused recovery code of the user.
+func (s *storage) UseRecoveryCode(ctx context.Context, userID int, code string) (bool, error) {
+	n, err := s.db(ctx).RecoveryCode.Update().
+		Where(
+			recoverycode.UserID(userID),
+			recoverycode.CodeHash(gen.Hash(code)),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to use recovery code", slog.String("error", err.Error()))
+		return false, dberr.Tag(err)
+	}
+
+	return n > 0, nil
+}
+
+func (s *storage) SetUserTOTPRequired(ctx context.Context, userID int, required bool) (*entity.User, error) {
+	user, err := s.db(ctx).User.UpdateOneID(userID).
+		SetTotpRequired(required).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to set user's totp requirement", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageUserToEntity(user), nil
+}
+
+func (s *storage) CreateRefreshToken(ctx context.Context, userID int, familyID uuid.UUID, token string, expiresAt time.Time) error {
+	_, err := s.db(ctx).RefreshToken.Create().
+		SetUserID(userID).
+		SetFamilyID(familyID).
+		SetTokenHash(gen.Hash(token)).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to create refresh token", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) GetRefreshToken(ctx context.Context, token string) (*ent.RefreshToken, error) {
+	refreshToken, err := s.db(ctx).RefreshToken.Query().
+		Where(refreshtoken.TokenHash(gen.Hash(token))).
+		Only(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get refresh token", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return refreshToken, nil
+}
+
+// RevokeRefreshToken revokes a token that is still active. It reports false
+// when the token was already revoked, e.g. by a concurrent refresh.
+func (s *storage) RevokeRefreshToken(ctx context.Context, id int) (bool, error) {
+	n, err := s.db(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.ID(id),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to revoke refresh token", slog.String("error", err.Error()))
+		return false, dberr.Tag(err)
+	}
+
+	return n > 0, nil
+}
+
+func (s *storage) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := s.db(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.FamilyID(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to revoke refresh token family", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	_, err := s.db(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.UserID(userID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to revoke user's refresh tokens", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	return nil
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/citizenkz/core/services/auth/consts"
+	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/tracing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The methods in this file back the operator commands, which are trusted and
+// have no token. Like HTTP handlers, the commands validate their input.
+
+// CreateAdmin creates an admin account. The operator vouches for the email,
+// so it starts verified.
+func (u *usecase) CreateAdmin(ctx context.Context, req *entity.CreateAdminRequest) (*entity.CreateAdminResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.CreateAdmin")
+	defer span.End()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		u.logger(ctx).Error("failed to bcrypt.GenerateFromPassword", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to bcrypt.GenerateFromPassword: %w", err)
+	}
+
+	user, err := u.storage.CreateUser(ctx, &entity.RegisterRequest{
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Email:     req.Email,
+		Password:  string(hashedPassword),
+	})
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.CreateUser", slog.String("error", err.Error()))
+		if apperr.CodeOf(err) == apperr.CodeConflict {
+			return nil, apperr.Conflict("email already in use")
+		}
+		return nil, fmt.Errorf("failed to storage.CreateUser: %w", err)
+	}
+
+	if _, err := u.storage.ConfirmUserEmail(ctx, user.ID, user.Email); err != nil {
+		u.logger(ctx).Error("failed to storage.ConfirmUserEmail", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.ConfirmUserEmail: %w", err)
+	}
+
+	user, err = u.storage.UpdateUserRole(ctx, user.ID, consts.Admin)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.UpdateUserRole", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.UpdateUserRole: %w", err)
+	}
+
+	return &entity.CreateAdminResponse{
+		Profile: *user,
+	}, nil
+}
+
+// PurgeExpiredAttempts deletes password reset attempts whose code or reset
+// token can no longer be used.
+func (u *usecase) PurgeExpiredAttempts(ctx context.Context) (*entity.PurgeAttemptsResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.PurgeExpiredAttempts")
+	defer span.End()
+
+	now := time.Now()
+
+	deleted, err := u.storage.DeleteExpiredAttempts(ctx, now.Add(-otpTTL), now.Add(-resetTokenTTL))
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.DeleteExpiredAttempts", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.DeleteExpiredAttempts: %w", err)
+	}
+
+	return &entity.PurgeAttemptsResponse{
+		Deleted: deleted,
+	}, nil
+}
+
+// ResendVerificationByEmail emails the user with email a new code. Unlike
+// ResendVerification it replaces a valid code and skips the cooldown, so an
+// operator can help a user who used up their tries.
+func (u *usecase) ResendVerificationByEmail(ctx context.Context, req *entity.ResendVerificationByEmailRequest) (*entity.ResendVerificationResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.ResendVerificationByEmail")
+	defer span.End()
+
+	user, err := u.storage.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	return u.resendVerification(ctx, user)
+}
+
+// ResendPasswordResetByEmail is ForgetPassword for operators. It queues the
+// code before returning and reports unknown emails, which an operator should
+// hear about.
+func (u *usecase) ResendPasswordResetByEmail(ctx context.Context, req *entity.ForgetPasswordRequest) (*entity.ForgetPasswordResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.ResendPasswordResetByEmail")
+	defer span.End()
+
+	user, err := u.storage.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	attemptID := gen.UUID()()
+	if err := u.sendPasswordReset(ctx, user, attemptID); err != nil {
+		return nil, err
+	}
+
+	return &entity.ForgetPasswordResponse{
+		AttemptID: attemptID,
+		RetryTime: int(otpTTL.Seconds()),
+	}, nil
+}
@@ -6,41 +6,57 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/email"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func (u *usecase) Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.Delete")
+	defer span.End()
+
 	// Parse user ID from token
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("invalid token")
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	// Get user
 	user, err := u.storage.GetUserByID(ctx, userID)
 	if err != nil {
-		u.log.Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("user not found")
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
 	}
 
 	// Verify password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		u.log.Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("incorrect password")
+		u.logger(ctx).Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("incorrect password")
 	}
 
-	// Delete user
-	if err := u.storage.DeleteUser(ctx, userID); err != nil {
-		u.log.Error("failed to storage.DeleteUser", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to delete user: %w", err)
-	}
+	// Delete user and queue the confirmation email
+	err = u.storage.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.storage.DeleteUser(ctx, userID); err != nil {
+			u.logger(ctx).Error("failed to storage.DeleteUser", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		msg, err := email.AccountDeleted(user.Locale, user.Email)
+		if err != nil {
+			return fmt.Errorf("failed to email.AccountDeleted: %w", err)
+		}
 
-	// Send confirmation email
-	if err := u.emailService.SendAccountDeleted(user.Email); err != nil {
-		u.log.Error("failed to send account deleted email", slog.String("error", err.Error()))
-		// Continue even if email fails
+		if err := u.outboxStorage.Enqueue(ctx, msg); err != nil {
+			return fmt.Errorf("failed to outboxStorage.Enqueue: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &entity.DeleteResponse{
@@ -2,81 +2,204 @@ package usecase
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"log/slog"
-	"math/rand"
 	"time"
 
 	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/tracing"
 	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// errInvalidAttempt is the one answer for unknown, expired and wrong codes
+var errInvalidAttempt = apperr.Unauthorized("invalid or expired reset attempt")
+
+const (
+	otpDigits     = 6
+	otpTTL        = 10 * time.Minute
+	otpMaxTries   = 5
+	resetTokenTTL = 10 * time.Minute
 )
 
 func (u *usecase) ForgetPassword(ctx context.Context, req *entity.ForgetPasswordRequest) (*entity.ForgetPasswordResponse, error) {
-	// Check if user exists
-	_, err := u.storage.GetUserByEmail(ctx, req.Email)
-	if err != nil {
-		u.log.Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("user with this email not found")
+	ctx, span := tracing.Start(ctx, "auth.ForgetPassword")
+	defer span.End()
+
+	if err := u.reserve(ctx, scopeReset, req.IP, req.Email); err != nil {
+		return nil, err
 	}
 
-	// Generate 6-digit OTP
-	otp := fmt.Sprintf("%06d", rand.Intn(1000000))
+	// The reservation is kept, each request may send an email
+	// The account is looked up after answering, so unknown emails get the
+	// same answer in the same time and accounts can't be probed
+	attemptID := gen.UUID()()
+	u.goBackground(ctx, func(ctx context.Context) {
+		user, err := u.storage.GetUserByEmail(ctx, req.Email)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
+			return
+		}
+
+		if err := u.sendPasswordReset(ctx, user, attemptID); err != nil {
+			u.logger(ctx).Error("failed to send password reset", slog.String("error", err.Error()))
+		}
+	})
 
-	// Create attempt record
-	attemptID, err := u.storage.CreateAttempt(ctx, req.Email, otp)
+	return &entity.ForgetPasswordResponse{
+		AttemptID: attemptID,
+		RetryTime: int(otpTTL.Seconds()),
+	}, nil
+}
+
+// sendPasswordReset replaces the user's reset attempts with attemptID and
+// queues its code.
+func (u *usecase) sendPasswordReset(ctx context.Context, user *entity.User, attemptID uuid.UUID) error {
+	otp, err := gen.OTP(otpDigits)
 	if err != nil {
-		u.log.Error("failed to storage.CreateAttempt", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to create reset attempt: %w", err)
+		u.logger(ctx).Error("failed to gen.OTP", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to gen.OTP: %w", err)
 	}
 
-	// Send OTP via email
-	if err := u.emailService.SendOTP(req.Email, otp); err != nil {
-		u.log.Error("failed to send OTP email", slog.String("error", err.Error()))
-		// Continue even if email fails - user can request another OTP
-	}
+	return u.storage.WithTx(ctx, func(ctx context.Context) error {
+		// Only the latest code for an email stays valid
+		if err := u.storage.DeleteAttemptsByEmail(ctx, user.Email); err != nil {
+			u.logger(ctx).Error("failed to storage.DeleteAttemptsByEmail", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to create reset attempt: %w", err)
+		}
 
-	return &entity.ForgetPasswordResponse{
-		AttemptID: int(attemptID.ID()), // Convert UUID to int for response
-		RetryTime: 600,                 // 10 minutes in seconds
-	}, nil
+		// Create attempt record
+		if err := u.storage.CreateAttempt(ctx, attemptID, user.Email, otp); err != nil {
+			u.logger(ctx).Error("failed to storage.CreateAttempt", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to create reset attempt: %w", err)
+		}
+
+		// Queue the OTP email
+		msg, err := email.PasswordResetOTP(user.Locale, user.Email, otp)
+		if err != nil {
+			return fmt.Errorf("failed to email.PasswordResetOTP: %w", err)
+		}
+
+		if err := u.outboxStorage.Enqueue(ctx, msg); err != nil {
+			return fmt.Errorf("failed to outboxStorage.Enqueue: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func (u *usecase) ForgetPasswordConfirm(ctx context.Context, req *entity.ForgetPasswordConfirmRequest) (*entity.ForgetPasswordConfirmResponse, error) {
-	// Convert int ID back to UUID
-	attemptUUID, err := uuid.Parse(fmt.Sprintf("%d", req.AttemptID))
-	if err != nil {
-		return nil, fmt.Errorf("invalid attempt ID")
+	ctx, span := tracing.Start(ctx, "auth.ForgetPasswordConfirm")
+	defer span.End()
+
+	if err := u.reserve(ctx, scopeResetConfirm, req.IP, ""); err != nil {
+		return nil, err
 	}
 
 	// Get attempt
-	attempt, err := u.storage.GetAttempt(ctx, attemptUUID)
+	attempt, err := u.storage.GetAttempt(ctx, req.AttemptID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetAttempt", slog.String("error", err.Error()))
+		return nil, errInvalidAttempt
+	}
+
+	if attempt.VerifiedAt != nil || time.Since(attempt.CreatedAt) > otpTTL {
+		if err := u.storage.DeleteAttempt(ctx, attempt.ID); err != nil {
+			u.logger(ctx).Error("failed to delete attempt", slog.String("error", err.Error()))
+		}
+		return nil, errInvalidAttempt
+	}
+
+	// The guess is counted before the code is compared
+	ok, err := u.storage.UseAttemptTry(ctx, attempt.ID, otpMaxTries)
 	if err != nil {
-		u.log.Error("failed to storage.GetAttempt", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("invalid or expired reset attempt")
+		u.logger(ctx).Error("failed to storage.UseAttemptTry", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.UseAttemptTry: %w", err)
+	}
+	if !ok {
+		if err := u.storage.DeleteAttempt(ctx, attempt.ID); err != nil {
+			u.logger(ctx).Error("failed to delete attempt", slog.String("error", err.Error()))
+		}
+		return nil, errInvalidAttempt
 	}
 
 	// Verify OTP
-	if attempt.Otp != req.OtpCode {
-		return nil, fmt.Errorf("invalid OTP code")
+	if subtle.ConstantTimeCompare([]byte(attempt.Otp), []byte(req.OtpCode)) != 1 {
+		return nil, errInvalidAttempt
 	}
 
-	// Get user by email
-	user, err := u.storage.GetUserByEmail(ctx, attempt.Email)
+	u.release(ctx, scopeResetConfirm, req.IP, "")
+
+	resetToken, err := gen.Token()
 	if err != nil {
-		u.log.Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("user not found")
+		u.logger(ctx).Error("failed to gen.Token", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to gen.Token: %w", err)
 	}
 
-	// Delete the attempt
-	if err := u.storage.DeleteAttempt(ctx, attemptUUID); err != nil {
-		u.log.Error("failed to delete attempt", slog.String("error", err.Error()))
+	if err := u.storage.VerifyAttempt(ctx, attempt.ID, resetToken); err != nil {
+		u.logger(ctx).Error("failed to storage.VerifyAttempt", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.VerifyAttempt: %w", err)
 	}
 
 	return &entity.ForgetPasswordConfirmResponse{
-		Profile: *user,
+		ResetToken: resetToken,
+		ExpiresIn:  int(resetTokenTTL.Seconds()),
 	}, nil
 }
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
+func (u *usecase) ResetPassword(ctx context.Context, req *entity.ResetPasswordRequest) (*entity.ResetPasswordResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.ResetPassword")
+	defer span.End()
+
+	// Validate passwords match
+	if req.Password != req.ConfirmPassword {
+		return nil, apperr.Validation("passwords do not match")
+	}
+
+	if err := u.reserve(ctx, scopeResetConfirm, req.IP, ""); err != nil {
+		return nil, err
+	}
+
+	attempt, err := u.storage.GetAttemptByResetToken(ctx, req.ResetToken)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetAttemptByResetToken", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid or expired reset token")
+	}
+	u.release(ctx, scopeResetConfirm, req.IP, "")
+
+	// The token is single use, whatever happens next
+	if err := u.storage.DeleteAttempt(ctx, attempt.ID); err != nil {
+		u.logger(ctx).Error("failed to storage.DeleteAttempt", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.DeleteAttempt: %w", err)
+	}
+
+	if attempt.VerifiedAt == nil || time.Since(*attempt.VerifiedAt) > resetTokenTTL {
+		return nil, apperr.Unauthorized("invalid or expired reset token")
+	}
+
+	user, err := u.storage.GetUserByEmail(ctx, attempt.Email)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	// Hash new password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		u.logger(ctx).Error("failed to bcrypt.GenerateFromPassword", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	updatedUser, err := u.changePassword(ctx, user.ID, string(hashedPassword))
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ResetPasswordResponse{
+		Profile: *updatedUser,
+	}, nil
 }
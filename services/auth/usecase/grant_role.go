@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+func (u *usecase) GrantRole(ctx context.Context, req *entity.GrantRoleRequest) (*entity.GrantRoleResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.GrantRole")
+	defer span.End()
+
+	if !req.Role.IsValid() {
+		return nil, apperr.Validation("unknown role %q", req.Role)
+	}
+
+	adminID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+	}
+
+	// Keeps the last admin from locking everyone out by demoting themselves
+	if adminID == req.UserID {
+		return nil, apperr.Forbidden("can't change your own role")
+	}
+
+	user, err := u.storage.UpdateUserRole(ctx, req.UserID, req.Role)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.UpdateUserRole", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.UpdateUserRole: %w", err)
+	}
+
+	return &entity.GrantRoleResponse{
+		Profile: *user,
+	}, nil
+}
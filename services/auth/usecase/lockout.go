@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/citizenkz/core/utils/lockout"
+)
+
+// Lockout scopes, failures in one don't count against another
+const (
+	scopeLogin        = "login"
+	scopeTwoFactor    = "2fa"
+	scopeReset        = "reset"
+	scopeResetConfirm = "reset-confirm"
+	scopeVerifyEmail  = "verify-email"
+	scopeResendVerify = "verify-email-resend"
+)
+
+// reserve counts the request as a failure of its client address and account
+// before it is checked, and rejects it while either is locked or still
+// waiting out the delay after a failure. A request that succeeds hands its
+// reservation back with release. Store errors let the request through rather
+// than locking everyone out.
+func (u *usecase) reserve(ctx context.Context, scope, ip, account string) error {
+	if ip != "" {
+		if err := u.ipLimiter.Reserve(ctx, lockoutKey(scope, "ip", ip)); err != nil {
+			return u.lockoutError(ctx, err)
+		}
+	}
+
+	if account != "" {
+		if err := u.accountLimiter.Reserve(ctx, lockoutKey(scope, "account", account)); err != nil {
+			// Only the locked account is to blame
+			u.releaseIP(ctx, scope, ip)
+			return u.lockoutError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+// release hands back the reservation of a request that succeeded. The
+// account's failures are forgotten; the address only gets its one attempt
+// back, or an attacker could clear it by logging into their own account.
+func (u *usecase) release(ctx context.Context, scope, ip, account string) {
+	u.releaseIP(ctx, scope, ip)
+
+	if account != "" {
+		if err := u.accountLimiter.Reset(ctx, lockoutKey(scope, "account", account)); err != nil {
+			u.logger(ctx).Error("failed to accountLimiter.Reset", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (u *usecase) releaseIP(ctx context.Context, scope, ip string) {
+	if ip == "" {
+		return
+	}
+
+	if err := u.ipLimiter.Release(ctx, lockoutKey(scope, "ip", ip)); err != nil {
+		u.logger(ctx).Error("failed to ipLimiter.Release", slog.String("error", err.Error()))
+	}
+}
+
+func (u *usecase) lockoutError(ctx context.Context, err error) error {
+	if errors.Is(err, lockout.ErrLocked) {
+		return err
+	}
+
+	u.logger(ctx).Error("failed to check lockout", slog.String("error", err.Error()))
+	return nil
+}
+
+func lockoutKey(scope, kind, value string) string {
+	return scope + ":" + kind + ":" + strings.ToLower(strings.TrimSpace(value))
+}
@@ -6,30 +6,58 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/services/auth/entity"
-	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var errInvalidCredentials = apperr.Unauthorized("invalid email or password")
+
+// dummyPasswordHash is compared against when the email is unknown, so the
+// response takes as long as for a wrong password.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
 func (u *usecase) Login(ctx context.Context, req *entity.LoginRequest) (*entity.LoginResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.Login")
+	defer span.End()
+
+	if err := u.reserve(ctx, scopeLogin, req.IP, req.Email); err != nil {
+		return nil, err
+	}
+
 	user, err := u.storage.GetUserByEmail(ctx, req.Email)
 	if err != nil {
-		u.log.Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.GetUserByEmail: %w", err)
+		u.logger(ctx).Error("failed to storage.GetUserByEmail", slog.String("error", err.Error()))
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+		metrics.Logins.WithLabelValues("password", metrics.ResultFailure).Inc()
+		return nil, errInvalidCredentials
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		u.log.Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("incorrect password")
+		u.logger(ctx).Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
+		metrics.Logins.WithLabelValues("password", metrics.ResultFailure).Inc()
+		return nil, errInvalidCredentials
+	}
+
+	u.release(ctx, scopeLogin, req.IP, req.Email)
+
+	// Two-factor accounts, or ones that must enroll, finish in a second step
+	if user.TOTPEnabledAt != nil || user.TOTPRequired {
+		metrics.Logins.WithLabelValues("password", metrics.ResultChallenged).Inc()
+		return u.challenge(ctx, user)
 	}
 
-	token, err := jwt.Generate(ctx, user.ID, u.cfg.JwtSecret)
+	token, refreshToken, err := u.issueTokens(ctx, user, gen.UUID()())
 	if err != nil {
-		u.log.Error("failed to jwt.Generate", slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
+	metrics.Logins.WithLabelValues("password", metrics.ResultSuccess).Inc()
 
 	return &entity.LoginResponse{
-		Token:   token,
-		Profile: *user,
+		Token:        token,
+		RefreshToken: refreshToken,
+		Profile:      user,
 	}, nil
 }
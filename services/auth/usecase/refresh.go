@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
+	"github.com/google/uuid"
+)
+
+// issueTokens signs an access token and stores a new refresh token in the
+// family. A login starts a new family, every refresh continues it.
+func (u *usecase) issueTokens(ctx context.Context, user *entity.User, familyID uuid.UUID) (string, string, error) {
+	token, err := jwt.Generate(ctx, user.ID, user.Role.String(), user.EmailVerifiedAt != nil, u.cfg.JwtSecret, u.cfg.Token.AccessTTL)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.Generate", slog.String("error", err.Error()))
+		return "", "", fmt.Errorf("failed to jwt.Generate: %w", err)
+	}
+
+	refreshToken, err := gen.Token()
+	if err != nil {
+		u.logger(ctx).Error("failed to gen.Token", slog.String("error", err.Error()))
+		return "", "", fmt.Errorf("failed to gen.Token: %w", err)
+	}
+
+	err = u.storage.CreateRefreshToken(ctx, user.ID, familyID, refreshToken, time.Now().Add(u.cfg.Token.RefreshTTL))
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.CreateRefreshToken", slog.String("error", err.Error()))
+		return "", "", fmt.Errorf("failed to storage.CreateRefreshToken: %w", err)
+	}
+
+	return token, refreshToken, nil
+}
+
+func (u *usecase) Refresh(ctx context.Context, req *entity.RefreshRequest) (*entity.RefreshResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.Refresh")
+	defer span.End()
+
+	refreshToken, err := u.storage.GetRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetRefreshToken", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid refresh token")
+	}
+
+	// A revoked token coming back means it was copied, so the whole family goes
+	if refreshToken.RevokedAt != nil {
+		u.logger(ctx).Warn("refresh token reuse detected", slog.Int("user_id", refreshToken.UserID))
+		if err := u.storage.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+			u.logger(ctx).Error("failed to storage.RevokeRefreshTokenFamily", slog.String("error", err.Error()))
+		}
+		return nil, apperr.Unauthorized("invalid refresh token")
+	}
+
+	if time.Now().After(refreshToken.ExpiresAt) {
+		return nil, apperr.Unauthorized("refresh token expired")
+	}
+
+	revoked, err := u.storage.RevokeRefreshToken(ctx, refreshToken.ID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.RevokeRefreshToken", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.RevokeRefreshToken: %w", err)
+	}
+	// Another request rotated the same token first
+	if !revoked {
+		u.logger(ctx).Warn("refresh token reuse detected", slog.Int("user_id", refreshToken.UserID))
+		if err := u.storage.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+			u.logger(ctx).Error("failed to storage.RevokeRefreshTokenFamily", slog.String("error", err.Error()))
+		}
+		return nil, apperr.Unauthorized("invalid refresh token")
+	}
+
+	user, err := u.storage.GetUserByID(ctx, refreshToken.UserID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	token, newRefreshToken, err := u.issueTokens(ctx, user, refreshToken.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.RefreshResponse{
+		Token:        token,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+func (u *usecase) Logout(ctx context.Context, req *entity.LogoutRequest) (*entity.LogoutResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.Logout")
+	defer span.End()
+
+	refreshToken, err := u.storage.GetRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetRefreshToken", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid refresh token")
+	}
+
+	if req.All {
+		if err := u.storage.RevokeUserRefreshTokens(ctx, refreshToken.UserID); err != nil {
+			u.logger(ctx).Error("failed to storage.RevokeUserRefreshTokens", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to storage.RevokeUserRefreshTokens: %w", err)
+		}
+	} else {
+		if err := u.storage.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+			u.logger(ctx).Error("failed to storage.RevokeRefreshTokenFamily", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to storage.RevokeRefreshTokenFamily: %w", err)
+		}
+	}
+
+	return &entity.LogoutResponse{
+		Success: true,
+	}, nil
+}
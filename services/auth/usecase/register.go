@@ -6,14 +6,20 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/services/auth/entity"
-	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func (u *usecase) Register(ctx context.Context, req *entity.RegisterRequest) (*entity.RegisterResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.Register")
+	defer span.End()
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
-		u.log.Error("failed to bcrypt.GenerateFromPassword", slog.String("error", err.Error()))
+		u.logger(ctx).Error("failed to bcrypt.GenerateFromPassword", slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to bcrypt.GenerateFromPassword: %w", err)
 	}
 
@@ -21,19 +27,27 @@ func (u *usecase) Register(ctx context.Context, req *entity.RegisterRequest) (*e
 
 	user, err := u.storage.CreateUser(ctx, req)
 	if err != nil {
-		u.log.Error("failed to storage.CreateUser", slog.String("error", err.Error()))
+		u.logger(ctx).Error("failed to storage.CreateUser", slog.String("error", err.Error()))
+		if apperr.CodeOf(err) == apperr.CodeConflict {
+			return nil, apperr.Conflict("email already in use")
+		}
 		return nil, fmt.Errorf("failed to storage.CreateUser: %w", err)
 	}
+	metrics.Registrations.Inc()
+
+	// The account exists either way, the code can be requested again
+	if err := u.sendEmailVerification(ctx, user, user.Email); err != nil {
+		u.logger(ctx).Error("failed to sendEmailVerification", slog.String("error", err.Error()))
+	}
 
-	token, err := jwt.Generate(ctx, user.ID, u.cfg.JwtSecret)
+	token, refreshToken, err := u.issueTokens(ctx, user, gen.UUID()())
 	if err != nil {
-		u.log.Error("failed to jwt.Generate", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.Generate: %w", err)
+		return nil, err
 	}
-	
+
 	return &entity.RegisterResponse{
-		Profile: *user,
-		Token: token,
+		Profile:      *user,
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
-
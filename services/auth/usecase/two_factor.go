@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/totp"
+	"github.com/citizenkz/core/utils/tracing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const recoveryCodeCount = 10
+
+// twoFactorUserID accepts an access token or, for users an admin requires to
+// enroll, the challenge token returned by Login.
+func (u *usecase) twoFactorUserID(ctx context.Context, token string) (int, bool, error) {
+	if userID, err := jwt.ParseUserID(ctx, token, u.cfg.JwtSecret); err == nil {
+		return userID, false, nil
+	}
+
+	userID, err := jwt.ParseChallenge(ctx, token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseChallenge", slog.String("error", err.Error()))
+		return 0, false, apperr.Unauthorized("invalid token")
+	}
+
+	return userID, true, nil
+}
+
+// challenge answers a password login that still needs a second factor.
+func (u *usecase) challenge(ctx context.Context, user *entity.User) (*entity.LoginResponse, error) {
+	challengeToken, err := jwt.GenerateChallenge(ctx, user.ID, u.cfg.JwtSecret, u.cfg.TwoFactor.ChallengeTTL)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.GenerateChallenge", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &entity.LoginResponse{
+		ChallengeToken:         challengeToken,
+		TwoFactorRequired:      user.TOTPEnabledAt != nil,
+		TwoFactorSetupRequired: user.TOTPEnabledAt == nil,
+	}, nil
+}
+
+func (u *usecase) EnrollTOTP(ctx context.Context, req *entity.EnrollTOTPRequest) (*entity.EnrollTOTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.EnrollTOTP")
+	defer span.End()
+
+	userID, _, err := u.twoFactorUserID(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	if user.TOTPEnabledAt != nil {
+		return nil, apperr.Conflict("two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		u.logger(ctx).Error("failed to totp.GenerateSecret", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to totp.GenerateSecret: %w", err)
+	}
+
+	if err := u.storage.SetUserTOTPSecret(ctx, userID, secret); err != nil {
+		u.logger(ctx).Error("failed to storage.SetUserTOTPSecret", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.SetUserTOTPSecret: %w", err)
+	}
+
+	return &entity.EnrollTOTPResponse{
+		Secret:     secret,
+		OtpauthURI: totp.URI(u.cfg.TwoFactor.Issuer, user.Email, secret),
+	}, nil
+}
+
+func (u *usecase) ConfirmTOTP(ctx context.Context, req *entity.ConfirmTOTPRequest) (*entity.ConfirmTOTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.ConfirmTOTP")
+	defer span.End()
+
+	userID, challenge, err := u.twoFactorUserID(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	if user.TOTPEnabledAt != nil {
+		return nil, apperr.Conflict("two-factor authentication is already enabled")
+	}
+	if user.TOTPSecret == nil {
+		return nil, apperr.Conflict("two-factor enrollment not started")
+	}
+
+	step, ok := totp.Validate(*user.TOTPSecret, req.OtpCode, time.Now())
+	if !ok {
+		return nil, apperr.Unauthorized("invalid OTP code")
+	}
+
+	recoveryCodes, err := newRecoveryCodes()
+	if err != nil {
+		u.logger(ctx).Error("failed to newRecoveryCodes", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to newRecoveryCodes: %w", err)
+	}
+
+	updatedUser, err := u.storage.EnableUserTOTP(ctx, userID, step, recoveryCodes)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.EnableUserTOTP", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.EnableUserTOTP: %w", err)
+	}
+
+	resp := &entity.ConfirmTOTPResponse{
+		Profile:       *updatedUser,
+		RecoveryCodes: recoveryCodes,
+	}
+
+	// Enrolling from a login challenge finishes that login
+	if challenge {
+		resp.Token, resp.RefreshToken, err = u.issueTokens(ctx, updatedUser, gen.UUID()())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+func (u *usecase) DisableTOTP(ctx context.Context, req *entity.DisableTOTPRequest) (*entity.DisableTOTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.DisableTOTP")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	user, err := u.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	if user.TOTPRequired {
+		return nil, apperr.Forbidden("two-factor authentication is required by an administrator")
+	}
+	if user.TOTPEnabledAt == nil || user.TOTPSecret == nil {
+		return nil, apperr.Conflict("two-factor authentication is not enabled")
+	}
+
+	// Verify password
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		u.logger(ctx).Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("incorrect password")
+	}
+
+	if err := u.verifyTOTP(ctx, user, req.OtpCode); err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := u.storage.DisableUserTOTP(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.DisableUserTOTP", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.DisableUserTOTP: %w", err)
+	}
+
+	return &entity.DisableTOTPResponse{
+		Profile: *updatedUser,
+	}, nil
+}
+
+func (u *usecase) LoginTwoFactor(ctx context.Context, req *entity.LoginTwoFactorRequest) (*entity.LoginResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.LoginTwoFactor")
+	defer span.End()
+
+	userID, err := jwt.ParseChallenge(ctx, req.ChallengeToken, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseChallenge", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid or expired challenge token")
+	}
+
+	user, err := u.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	if user.TOTPEnabledAt == nil || user.TOTPSecret == nil {
+		return nil, apperr.Conflict("two-factor authentication is not enabled")
+	}
+
+	account := strconv.Itoa(userID)
+	if err := u.reserve(ctx, scopeTwoFactor, req.IP, account); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.OtpCode != "":
+		if err := u.verifyTOTP(ctx, user, req.OtpCode); err != nil {
+			metrics.Logins.WithLabelValues("two_factor", metrics.ResultFailure).Inc()
+			return nil, err
+		}
+	case req.RecoveryCode != "":
+		used, err := u.storage.UseRecoveryCode(ctx, userID, normalizeRecoveryCode(req.RecoveryCode))
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.UseRecoveryCode", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to storage.UseRecoveryCode: %w", err)
+		}
+		if !used {
+			metrics.Logins.WithLabelValues("two_factor", metrics.ResultFailure).Inc()
+			return nil, apperr.Unauthorized("invalid recovery code")
+		}
+	default:
+		u.release(ctx, scopeTwoFactor, req.IP, account)
+		return nil, apperr.Validation("otp_code or recovery_code is required")
+	}
+
+	u.release(ctx, scopeTwoFactor, req.IP, account)
+
+	token, refreshToken, err := u.issueTokens(ctx, user, gen.UUID()())
+	if err != nil {
+		return nil, err
+	}
+	metrics.Logins.WithLabelValues("two_factor", metrics.ResultSuccess).Inc()
+
+	return &entity.LoginResponse{
+		Profile:      user,
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (u *usecase) RequireTOTP(ctx context.Context, req *entity.RequireTOTPRequest) (*entity.RequireTOTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.RequireTOTP")
+	defer span.End()
+
+	user, err := u.storage.SetUserTOTPRequired(ctx, req.UserID, req.Required)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.SetUserTOTPRequired", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.SetUserTOTPRequired: %w", err)
+	}
+
+	return &entity.RequireTOTPResponse{
+		Profile: *user,
+	}, nil
+}
+
+// verifyTOTP checks a code of an enrolled user and burns its time step.
+func (u *usecase) verifyTOTP(ctx context.Context, user *entity.User, code string) error {
+	step, ok := totp.Validate(*user.TOTPSecret, code, time.Now())
+	if !ok {
+		return apperr.Unauthorized("invalid OTP code")
+	}
+
+	fresh, err := u.storage.UseTOTPStep(ctx, user.ID, step)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.UseTOTPStep", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to storage.UseTOTPStep: %w", err)
+	}
+	if !fresh {
+		return apperr.Unauthorized("OTP code already used")
+	}
+
+	return nil
+}
+
+// newRecoveryCodes returns codes like "k7fq2-m3xpa" that are easy to type.
+func newRecoveryCodes() ([]string, error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(encoding.EncodeToString(b))[:10]
+		codes = append(codes, code[:5]+"-"+code[5:])
+	}
+
+	return codes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.Update")
+	defer span.End()
+
 	return nil, nil
 }
 
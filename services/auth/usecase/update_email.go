@@ -6,47 +6,69 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/email"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func (u *usecase) UpdateEmail(ctx context.Context, req *entity.UpdateEmailRequest) (*entity.UpdateEmailResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.UpdateEmail")
+	defer span.End()
+
 	// Parse user ID from token
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("invalid token")
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	// Get user
 	user, err := u.storage.GetUserByID(ctx, userID)
 	if err != nil {
-		u.log.Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("user not found")
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
 	}
 
 	// Verify password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		u.log.Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("incorrect password")
+		u.logger(ctx).Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("incorrect password")
 	}
 
-	oldEmail := user.Email
-
-	// Update email
-	updatedUser, err := u.storage.UpdateUserEmail(ctx, userID, req.Email)
-	if err != nil {
-		u.log.Error("failed to storage.UpdateUserEmail", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to update email: %w", err)
+	// The address must not belong to someone else
+	if _, err := u.storage.GetUserByEmail(ctx, req.Email); err == nil {
+		return nil, apperr.Conflict("email already in use")
 	}
 
-	// Send confirmation emails to both old and new addresses
-	if err := u.emailService.SendEmailChanged(oldEmail, req.Email); err != nil {
-		u.log.Error("failed to send email changed notification to old address", slog.String("error", err.Error()))
-	}
+	var updatedUser *entity.User
+	err = u.storage.WithTx(ctx, func(ctx context.Context) error {
+		// The change stays pending until the new address confirms it
+		updatedUser, err = u.storage.SetUserPendingEmail(ctx, userID, req.Email)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.SetUserPendingEmail", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to update email: %w", err)
+		}
 
-	if err := u.emailService.SendEmailChanged(req.Email, req.Email); err != nil {
-		u.log.Error("failed to send email changed notification to new address", slog.String("error", err.Error()))
+		if err := u.sendEmailVerification(ctx, user, req.Email); err != nil {
+			return err
+		}
+
+		// Warn the old address in case the change isn't the owner's doing
+		msg, err := email.EmailChangeRequested(user.Locale, user.Email, req.Email)
+		if err != nil {
+			return fmt.Errorf("failed to email.EmailChangeRequested: %w", err)
+		}
+
+		if err := u.outboxStorage.Enqueue(ctx, msg); err != nil {
+			return fmt.Errorf("failed to outboxStorage.Enqueue: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &entity.UpdateEmailResponse{
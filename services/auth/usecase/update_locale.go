@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+func (u *usecase) UpdateLocale(ctx context.Context, req *entity.UpdateLocaleRequest) (*entity.UpdateLocaleResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.UpdateLocale")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	updatedUser, err := u.storage.UpdateUserLocale(ctx, userID, req.Locale)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.UpdateUserLocale", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to update locale: %w", err)
+	}
+
+	return &entity.UpdateLocaleResponse{
+		Profile: *updatedUser,
+	}, nil
+}
@@ -6,57 +6,91 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/email"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func (u *usecase) UpdatePassword(ctx context.Context, req *entity.UpdatePasswordRequest) (*entity.UpdatePasswordResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.UpdatePassword")
+	defer span.End()
+
 	// Validate passwords match
 	if req.Password != req.ConfirmPassword {
-		return nil, fmt.Errorf("passwords do not match")
+		return nil, apperr.Validation("passwords do not match")
 	}
 
 	// Parse user ID from token
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("invalid token")
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	// Get user
 	user, err := u.storage.GetUserByID(ctx, userID)
 	if err != nil {
-		u.log.Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("user not found")
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
 	}
 
 	// Verify old password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.OldPassword)); err != nil {
-		u.log.Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("incorrect old password")
+		u.logger(ctx).Error("failed to bcrypt.CompareHashAndPassword", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("incorrect old password")
 	}
 
 	// Hash new password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
-		u.log.Error("failed to bcrypt.GenerateFromPassword", slog.String("error", err.Error()))
+		u.logger(ctx).Error("failed to bcrypt.GenerateFromPassword", slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	// Update password
-	updatedUser, err := u.storage.UpdateUserPassword(ctx, userID, string(hashedPassword))
+	updatedUser, err := u.changePassword(ctx, userID, string(hashedPassword))
 	if err != nil {
-		u.log.Error("failed to storage.UpdateUserPassword", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to update password: %w", err)
-	}
-
-	// Send confirmation email
-	if err := u.emailService.SendPasswordChanged(updatedUser.Email); err != nil {
-		u.log.Error("failed to send password changed email", slog.String("error", err.Error()))
-		// Continue even if email fails
+		return nil, err
 	}
 
 	return &entity.UpdatePasswordResponse{
 		Profile: *updatedUser,
 	}, nil
 }
+
+// changePassword stores the new password hash, signs out every device that
+// knew the old password and queues the confirmation email, all or nothing.
+func (u *usecase) changePassword(ctx context.Context, userID int, hashedPassword string) (*entity.User, error) {
+	var updatedUser *entity.User
+	err := u.storage.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		updatedUser, err = u.storage.UpdateUserPassword(ctx, userID, hashedPassword)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.UpdateUserPassword", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+
+		if err := u.storage.RevokeUserRefreshTokens(ctx, userID); err != nil {
+			u.logger(ctx).Error("failed to storage.RevokeUserRefreshTokens", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		msg, err := email.PasswordChanged(updatedUser.Locale, updatedUser.Email)
+		if err != nil {
+			return fmt.Errorf("failed to email.PasswordChanged: %w", err)
+		}
+
+		if err := u.outboxStorage.Enqueue(ctx, msg); err != nil {
+			return fmt.Errorf("failed to outboxStorage.Enqueue: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedUser, nil
+}
@@ -3,18 +3,28 @@ package usecase
 import (
 	"context"
 	"log/slog"
+	"sync"
 
 	"github.com/citizenkz/core/config"
 	"github.com/citizenkz/core/services/auth/entity"
 	"github.com/citizenkz/core/services/auth/storage"
-	"github.com/citizenkz/core/utils/email"
+	outboxStorage "github.com/citizenkz/core/services/outbox/storage"
+	"github.com/citizenkz/core/utils/lockout"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 type usecase struct {
-	log          *slog.Logger
-	storage      storage.Storage
-	cfg          *config.Config
-	emailService *email.EmailService
+	log     *slog.Logger
+	storage storage.Storage
+	// outboxStorage queues emails in the transaction of the change they
+	// report
+	outboxStorage outboxStorage.Storage
+	cfg           *config.Config
+	// accountLimiter throttles one email or user, ipLimiter one client address
+	accountLimiter *lockout.Limiter
+	ipLimiter      *lockout.Limiter
+	// background tracks work that outlives the request that started it
+	background sync.WaitGroup
 }
 
 type UseCase interface {
@@ -24,16 +34,68 @@ type UseCase interface {
 	Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error)
 	UpdateEmail(ctx context.Context, req *entity.UpdateEmailRequest) (*entity.UpdateEmailResponse, error)
 	UpdatePassword(ctx context.Context, req *entity.UpdatePasswordRequest) (*entity.UpdatePasswordResponse, error)
+	UpdateLocale(ctx context.Context, req *entity.UpdateLocaleRequest) (*entity.UpdateLocaleResponse, error)
 	Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error)
 	ForgetPassword(ctx context.Context, req *entity.ForgetPasswordRequest) (*entity.ForgetPasswordResponse, error)
 	ForgetPasswordConfirm(ctx context.Context, req *entity.ForgetPasswordConfirmRequest) (*entity.ForgetPasswordConfirmResponse, error)
+	ResetPassword(ctx context.Context, req *entity.ResetPasswordRequest) (*entity.ResetPasswordResponse, error)
+	GrantRole(ctx context.Context, req *entity.GrantRoleRequest) (*entity.GrantRoleResponse, error)
+	Refresh(ctx context.Context, req *entity.RefreshRequest) (*entity.RefreshResponse, error)
+	Logout(ctx context.Context, req *entity.LogoutRequest) (*entity.LogoutResponse, error)
+	VerifyEmail(ctx context.Context, req *entity.VerifyEmailRequest) (*entity.VerifyEmailResponse, error)
+	ResendVerification(ctx context.Context, req *entity.ResendVerificationRequest) (*entity.ResendVerificationResponse, error)
+	EnrollTOTP(ctx context.Context, req *entity.EnrollTOTPRequest) (*entity.EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, req *entity.ConfirmTOTPRequest) (*entity.ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, req *entity.DisableTOTPRequest) (*entity.DisableTOTPResponse, error)
+	LoginTwoFactor(ctx context.Context, req *entity.LoginTwoFactorRequest) (*entity.LoginResponse, error)
+	RequireTOTP(ctx context.Context, req *entity.RequireTOTPRequest) (*entity.RequireTOTPResponse, error)
+	CreateAdmin(ctx context.Context, req *entity.CreateAdminRequest) (*entity.CreateAdminResponse, error)
+	PurgeExpiredAttempts(ctx context.Context) (*entity.PurgeAttemptsResponse, error)
+	ResendVerificationByEmail(ctx context.Context, req *entity.ResendVerificationByEmailRequest) (*entity.ResendVerificationResponse, error)
+	ResendPasswordResetByEmail(ctx context.Context, req *entity.ForgetPasswordRequest) (*entity.ForgetPasswordResponse, error)
+	// Wait blocks until the work requests left running after their answer
+	// is done.
+	Wait()
 }
 
-func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase {
+func New(log *slog.Logger, storage storage.Storage, outboxStorage outboxStorage.Storage, lockoutStore lockout.Store, cfg *config.Config) UseCase {
+	policy := lockout.Policy{
+		MaxFailures:  cfg.Lockout.MaxFailures,
+		LockDuration: cfg.Lockout.LockDuration,
+		BaseDelay:    cfg.Lockout.BaseDelay,
+		MaxDelay:     cfg.Lockout.MaxDelay,
+	}
+	ipPolicy := policy
+	ipPolicy.MaxFailures = cfg.Lockout.IPMaxFailures
+	// Many users can share an address, so it only gets the lockout
+	ipPolicy.BaseDelay = 0
+
 	return &usecase{
-		log:          log,
-		storage:      storage,
-		cfg:          cfg,
-		emailService: email.New(cfg),
+		log:            log,
+		storage:        storage,
+		outboxStorage:  outboxStorage,
+		cfg:            cfg,
+		accountLimiter: lockout.New(lockoutStore, policy),
+		ipLimiter:      lockout.New(lockoutStore, ipPolicy),
 	}
 }
+
+func (u *usecase) Wait() {
+	u.background.Wait()
+}
+
+// goBackground runs fn after the request has been answered. ctx keeps its
+// values, such as the request's logger, but not its cancellation.
+func (u *usecase) goBackground(ctx context.Context, fn func(ctx context.Context)) {
+	ctx = context.WithoutCancel(ctx)
+	u.background.Add(1)
+	go func() {
+		defer u.background.Done()
+		fn(ctx)
+	}()
+}
+
+// logger returns the logger of the request ctx belongs to.
+func (u *usecase) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, u.log)
+}
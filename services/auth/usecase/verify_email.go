@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/citizenkz/core/services/auth/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/gen"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/lockout"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+const (
+	emailVerificationTTL      = 24 * time.Hour
+	emailVerificationMaxTries = 5
+	emailVerificationCooldown = time.Minute
+)
+
+// sendEmailVerification stores a fresh code confirming address for the user
+// and queues the email carrying it, in the user's locale.
+func (u *usecase) sendEmailVerification(ctx context.Context, user *entity.User, address string) error {
+	otp, err := gen.OTP(otpDigits)
+	if err != nil {
+		u.logger(ctx).Error("failed to gen.OTP", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to gen.OTP: %w", err)
+	}
+
+	return u.storage.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.storage.CreateEmailVerification(ctx, user.ID, address, otp); err != nil {
+			u.logger(ctx).Error("failed to storage.CreateEmailVerification", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to storage.CreateEmailVerification: %w", err)
+		}
+
+		return u.queueEmailVerification(ctx, user, address, otp)
+	})
+}
+
+func (u *usecase) queueEmailVerification(ctx context.Context, user *entity.User, address, otp string) error {
+	msg, err := email.EmailVerification(user.Locale, address, otp)
+	if err != nil {
+		return fmt.Errorf("failed to email.EmailVerification: %w", err)
+	}
+
+	if err := u.outboxStorage.Enqueue(ctx, msg); err != nil {
+		return fmt.Errorf("failed to outboxStorage.Enqueue: %w", err)
+	}
+
+	return nil
+}
+
+func (u *usecase) VerifyEmail(ctx context.Context, req *entity.VerifyEmailRequest) (*entity.VerifyEmailResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.VerifyEmail")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	account := strconv.Itoa(userID)
+	if err := u.reserve(ctx, scopeVerifyEmail, req.IP, account); err != nil {
+		return nil, err
+	}
+
+	user, err := u.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	verification, err := u.storage.GetEmailVerification(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetEmailVerification", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("no pending email verification")
+	}
+
+	// The code must still belong to the current or the pending address
+	pending := user.PendingEmail != nil && *user.PendingEmail == verification.Email
+	if !pending && (verification.Email != user.Email || user.EmailVerifiedAt != nil) {
+		return nil, apperr.NotFound("no pending email verification")
+	}
+
+	if time.Since(verification.CreatedAt) > emailVerificationTTL {
+		return nil, apperr.Unauthorized("verification code expired, request a new one")
+	}
+
+	// The guess is counted before the code is compared
+	tries, ok, err := u.storage.UseEmailVerificationTry(ctx, verification.ID, emailVerificationMaxTries)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.UseEmailVerificationTry", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.UseEmailVerificationTry: %w", err)
+	}
+	if !ok {
+		return nil, apperr.Unauthorized("no tries left, the code can be sent again once it expires")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(verification.Otp), []byte(req.OtpCode)) != 1 {
+		return nil, apperr.Unauthorized("invalid OTP code, %d tries left", emailVerificationMaxTries-tries)
+	}
+
+	u.release(ctx, scopeVerifyEmail, req.IP, account)
+
+	var updatedUser *entity.User
+	err = u.storage.WithTx(ctx, func(ctx context.Context) error {
+		updatedUser, err = u.storage.ConfirmUserEmail(ctx, userID, verification.Email)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.ConfirmUserEmail", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to confirm email: %w", err)
+		}
+
+		if !pending {
+			return nil
+		}
+
+		// Send confirmation emails to both old and new addresses
+		toOld, err := email.EmailChanged(user.Locale, user.Email, updatedUser.Email)
+		if err != nil {
+			return fmt.Errorf("failed to email.EmailChanged: %w", err)
+		}
+
+		toNew, err := email.EmailChanged(user.Locale, updatedUser.Email, updatedUser.Email)
+		if err != nil {
+			return fmt.Errorf("failed to email.EmailChanged: %w", err)
+		}
+
+		if err := u.outboxStorage.Enqueue(ctx, toOld, toNew); err != nil {
+			return fmt.Errorf("failed to outboxStorage.Enqueue: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.VerifyEmailResponse{
+		Profile: *updatedUser,
+	}, nil
+}
+
+// ResendVerification emails the user's current code again while it is valid,
+// so resending doesn't give more guesses, and a new code once it has expired.
+// The same code goes out at most once per cooldown.
+func (u *usecase) ResendVerification(ctx context.Context, req *entity.ResendVerificationRequest) (*entity.ResendVerificationResponse, error) {
+	ctx, span := tracing.Start(ctx, "auth.ResendVerification")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	// The reservation is kept, each request may send an email
+	if err := u.reserve(ctx, scopeResendVerify, req.IP, strconv.Itoa(userID)); err != nil {
+		return nil, err
+	}
+
+	user, err := u.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserByID", slog.String("error", err.Error()))
+		return nil, apperr.NotFound("user not found")
+	}
+
+	address, err := verificationAddress(user)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := u.storage.GetEmailVerification(ctx, userID)
+	if err != nil && apperr.CodeOf(err) != apperr.CodeNotFound {
+		u.logger(ctx).Error("failed to storage.GetEmailVerification", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.GetEmailVerification: %w", err)
+	}
+
+	if verification == nil || verification.Email != address || time.Since(verification.CreatedAt) > emailVerificationTTL {
+		if err := u.sendEmailVerification(ctx, user, address); err != nil {
+			return nil, err
+		}
+
+		return &entity.ResendVerificationResponse{
+			Email:     address,
+			RetryTime: int(emailVerificationTTL.Seconds()),
+		}, nil
+	}
+
+	expiresIn := time.Until(verification.CreatedAt.Add(emailVerificationTTL))
+	if verification.Tries >= emailVerificationMaxTries {
+		return nil, &lockout.LockedError{Wait: expiresIn}
+	}
+
+	err = u.storage.WithTx(ctx, func(ctx context.Context) error {
+		sent, err := u.storage.MarkEmailVerificationSent(ctx, verification.ID, emailVerificationCooldown)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.MarkEmailVerificationSent", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to storage.MarkEmailVerificationSent: %w", err)
+		}
+		if !sent {
+			return &lockout.LockedError{Wait: time.Until(verification.SentAt.Add(emailVerificationCooldown))}
+		}
+
+		return u.queueEmailVerification(ctx, user, address, verification.Otp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ResendVerificationResponse{
+		Email:     address,
+		RetryTime: int(expiresIn.Seconds()),
+	}, nil
+}
+
+// resendVerification emails a new code for the user's pending or unverified
+// email, with fresh tries and no cooldown.
+func (u *usecase) resendVerification(ctx context.Context, user *entity.User) (*entity.ResendVerificationResponse, error) {
+	address, err := verificationAddress(user)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.sendEmailVerification(ctx, user, address); err != nil {
+		return nil, err
+	}
+
+	return &entity.ResendVerificationResponse{
+		Email:     address,
+		RetryTime: int(emailVerificationTTL.Seconds()),
+	}, nil
+}
+
+// verificationAddress returns the user's address waiting to be confirmed.
+func verificationAddress(user *entity.User) (string, error) {
+	switch {
+	case user.PendingEmail != nil:
+		return *user.PendingEmail, nil
+	case user.EmailVerifiedAt == nil:
+		return user.Email, nil
+	default:
+		return "", apperr.Conflict("email already verified")
+	}
+}
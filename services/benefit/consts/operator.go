@@ -0,0 +1,13 @@
+package consts
+
+type Operator string
+
+const (
+	And Operator = "AND"
+	Or  Operator = "OR"
+	Not Operator = "NOT"
+)
+
+func (operator Operator) String() string {
+	return string(operator)
+}
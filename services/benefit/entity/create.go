@@ -1,21 +1,32 @@
 package entity
 
+import "github.com/citizenkz/core/services/benefit/consts"
+
 type (
 	BenefitFilterRequest struct {
-		FilterID int     `json:"filter_id"`
+		FilterID int     `json:"filter_id" validate:"required"`
 		Value    *string `json:"value,omitempty"`
 		From     *string `json:"from,omitempty"`
 		To       *string `json:"to,omitempty"`
 	}
 
+	// RuleGroupRequest nests filter conditions under an AND, OR or NOT node.
+	// A NOT group must have exactly one member.
+	RuleGroupRequest struct {
+		Operator consts.Operator        `json:"operator" validate:"required,oneof=AND OR NOT"`
+		Filters  []BenefitFilterRequest `json:"filters,omitempty"`
+		Groups   []RuleGroupRequest     `json:"groups,omitempty"`
+	}
+
 	CreateRequest struct {
-		Title      string                 `json:"title"`
+		Title      string                 `json:"title" validate:"required,max=255"`
 		Content    string                 `json:"content"`
 		Bonus      string                 `json:"bonus"`
 		VideoURL   *string                `json:"video_url,omitempty"`
 		SourceURL  *string                `json:"source_url,omitempty"`
 		Filters    []BenefitFilterRequest `json:"filters,omitempty"`
 		Categories []int                  `json:"categories,omitempty"`
+		Rules      *RuleGroupRequest      `json:"rules,omitempty"`
 	}
 
 	CreateResponse struct {
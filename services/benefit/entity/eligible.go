@@ -0,0 +1,12 @@
+package entity
+
+type (
+	EligibleRequest struct {
+		Token string `json:"-"`
+	}
+
+	EligibleResponse struct {
+		Benefits []*BenefitWithFilters `json:"benefits"`
+		Total    int                   `json:"total"`
+	}
+)
@@ -2,6 +2,9 @@ package entity
 
 import (
 	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/benefit/consts"
+	"github.com/citizenkz/core/services/eligibility"
+	"github.com/citizenkz/core/utils/locale"
 )
 
 type (
@@ -28,15 +31,24 @@ type (
 		Description *string `json:"description,omitempty"`
 	}
 
+	RuleGroup struct {
+		ID       int              `json:"id"`
+		Operator consts.Operator  `json:"operator"`
+		Filters  []*BenefitFilter `json:"filters,omitempty"`
+		Groups   []*RuleGroup     `json:"groups,omitempty"`
+	}
+
 	BenefitWithFilters struct {
-		ID         int                `json:"id"`
-		Title      string             `json:"title"`
-		Content    string             `json:"content"`
-		Bonus      string             `json:"bonus"`
-		VideoURL   *string            `json:"video_url"`
-		SourceURL  *string            `json:"source_url"`
-		Filters    []*BenefitFilter   `json:"filters,omitempty"`
-		Categories []*BenefitCategory `json:"categories,omitempty"`
+		ID          int                            `json:"id"`
+		Title       string                         `json:"title"`
+		Content     string                         `json:"content"`
+		Bonus       string                         `json:"bonus"`
+		VideoURL    *string                        `json:"video_url"`
+		SourceURL   *string                        `json:"source_url"`
+		Filters     []*BenefitFilter               `json:"filters,omitempty"`
+		Categories  []*BenefitCategory             `json:"categories,omitempty"`
+		Rules       *RuleGroup                     `json:"rules,omitempty"`
+		Eligibility *eligibility.EligibilityReport `json:"eligibility,omitempty"`
 	}
 )
 
@@ -61,7 +73,9 @@ func MakeStorageBenefitFilterToEntity(filter *ent.BenefitFilter) *BenefitFilter
 	}
 }
 
-func MakeStorageBenefitWithFiltersToEntity(benefit *ent.Benefit) *BenefitWithFilters {
+// MakeStorageBenefitWithFiltersToEntity returns benefit and its categories
+// in loc, as far as their loaded translations go.
+func MakeStorageBenefitWithFiltersToEntity(benefit *ent.Benefit, loc locale.Locale) *BenefitWithFilters {
 	result := &BenefitWithFilters{
 		ID:         benefit.ID,
 		Title:      benefit.Title,
@@ -73,23 +87,97 @@ func MakeStorageBenefitWithFiltersToEntity(benefit *ent.Benefit) *BenefitWithFil
 		Categories: make([]*BenefitCategory, 0),
 	}
 
+	overlays := locale.Overlays(loc, benefit.Edges.Translations, func(t *ent.BenefitTranslation) locale.Locale {
+		return locale.Locale(t.Locale)
+	})
+	for _, t := range overlays {
+		if t.Title != "" {
+			result.Title = t.Title
+		}
+		if t.Content != "" {
+			result.Content = t.Content
+		}
+		if t.Bonus != "" {
+			result.Bonus = t.Bonus
+		}
+	}
+
+	// Filters inside rule groups are returned as part of the rules tree
+	grouped := make(map[int][]*BenefitFilter)
 	if benefit.Edges.BenefitFilters != nil {
 		for _, filter := range benefit.Edges.BenefitFilters {
+			if filter.RuleGroupID != nil {
+				grouped[*filter.RuleGroupID] = append(grouped[*filter.RuleGroupID], MakeStorageBenefitFilterToEntity(filter))
+				continue
+			}
 			result.Filters = append(result.Filters, MakeStorageBenefitFilterToEntity(filter))
 		}
 	}
 
+	if len(benefit.Edges.RuleGroups) > 0 {
+		result.Rules = makeStorageRuleGroupsToEntity(benefit.Edges.RuleGroups, grouped)
+	}
+
 	if benefit.Edges.BenefitCategories != nil {
 		for _, bc := range benefit.Edges.BenefitCategories {
 			if bc.Edges.Category != nil {
-				result.Categories = append(result.Categories, &BenefitCategory{
-					ID:          bc.Edges.Category.ID,
-					Name:        bc.Edges.Category.Name,
-					Description: bc.Edges.Category.Description,
-				})
+				result.Categories = append(result.Categories, makeStorageCategoryToEntity(bc.Edges.Category, loc))
 			}
 		}
 	}
 
 	return result
 }
+
+// makeStorageCategoryToEntity returns category in loc, as far as its loaded
+// translations go.
+func makeStorageCategoryToEntity(category *ent.Category, loc locale.Locale) *BenefitCategory {
+	result := &BenefitCategory{
+		ID:          category.ID,
+		Name:        category.Name,
+		Description: category.Description,
+	}
+
+	overlays := locale.Overlays(loc, category.Edges.Translations, func(t *ent.CategoryTranslation) locale.Locale {
+		return locale.Locale(t.Locale)
+	})
+	for _, t := range overlays {
+		if t.Name != "" {
+			result.Name = t.Name
+		}
+		if t.Description != "" {
+			description := t.Description
+			result.Description = &description
+		}
+	}
+
+	return result
+}
+
+// makeStorageRuleGroupsToEntity assembles the flat rule group rows of a
+// benefit into a tree and returns its root.
+func makeStorageRuleGroupsToEntity(groups []*ent.RuleGroup, filters map[int][]*BenefitFilter) *RuleGroup {
+	nodes := make(map[int]*RuleGroup, len(groups))
+	for _, group := range groups {
+		nodes[group.ID] = &RuleGroup{
+			ID:       group.ID,
+			Operator: consts.Operator(group.Operator),
+			Filters:  filters[group.ID],
+		}
+	}
+
+	var root *RuleGroup
+	for _, group := range groups {
+		if group.ParentID == nil {
+			if root == nil {
+				root = nodes[group.ID]
+			}
+			continue
+		}
+		if parent, ok := nodes[*group.ParentID]; ok {
+			parent.Groups = append(parent.Groups, nodes[group.ID])
+		}
+	}
+
+	return root
+}
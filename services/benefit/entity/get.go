@@ -2,7 +2,9 @@ package entity
 
 type (
 	GetRequest struct {
-		ID int `json:"id"`
+		ID      int    `json:"id"`
+		Explain bool   `json:"-"`
+		Token   string `json:"-"`
 	}
 
 	GetResponse struct {
@@ -1,18 +1,21 @@
 package entity
 
+import "github.com/citizenkz/core/services/eligibility"
+
 type (
 	FilterCriteria struct {
-		FilterID int     `json:"filter_id"`
+		FilterID int     `json:"filter_id" validate:"required"`
 		Value    *string `json:"value,omitempty"`
 		From     *string `json:"from,omitempty"`
 		To       *string `json:"to,omitempty"`
 	}
 
 	ListRequest struct {
-		Limit   int              `json:"limit"`
-		Offset  int              `json:"offset"`
+		Limit   int              `json:"limit" validate:"min=0,max=100"`
+		Offset  int              `json:"offset" validate:"min=0"`
 		Search  string           `json:"search,omitempty"`
 		Filters []FilterCriteria `json:"filters,omitempty"`
+		Explain bool             `json:"-"`
 	}
 
 	ListResponse struct {
@@ -20,3 +23,17 @@ type (
 		Total    int                   `json:"total"`
 	}
 )
+
+func MakeFilterCriteriaToEligibility(filters []FilterCriteria) []eligibility.Criterion {
+	result := make([]eligibility.Criterion, 0, len(filters))
+	for _, f := range filters {
+		result = append(result, eligibility.Criterion{
+			FilterID: f.FilterID,
+			Value:    f.Value,
+			From:     f.From,
+			To:       f.To,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+type (
+	Translation struct {
+		Locale  locale.Locale `json:"locale"`
+		Title   string        `json:"title,omitempty"`
+		Content string        `json:"content,omitempty"`
+		Bonus   string        `json:"bonus,omitempty"`
+	}
+
+	ListTranslationsRequest struct {
+		ID int `json:"id"`
+	}
+
+	ListTranslationsResponse struct {
+		Translations []*Translation `json:"translations"`
+	}
+
+	// SaveTranslationRequest replaces the translation of a benefit. Fields
+	// left empty fall back to another locale.
+	SaveTranslationRequest struct {
+		ID      int           `json:"-"`
+		Locale  locale.Locale `json:"-"`
+		Title   string        `json:"title" validate:"max=255"`
+		Content string        `json:"content"`
+		Bonus   string        `json:"bonus" validate:"max=255"`
+	}
+
+	SaveTranslationResponse struct {
+		Translation *Translation `json:"translation"`
+	}
+
+	DeleteTranslationRequest struct {
+		ID     int           `json:"id"`
+		Locale locale.Locale `json:"locale"`
+	}
+
+	DeleteTranslationResponse struct {
+		Success bool `json:"success"`
+	}
+
+	// MissingTranslationsRequest asks for the benefits with untranslated
+	// fields, in Locale or in every translated locale when it's empty.
+	MissingTranslationsRequest struct {
+		Locale locale.Locale `json:"locale,omitempty" validate:"oneof=kk ru"`
+		Limit  int           `json:"limit" validate:"min=0,max=100"`
+		Offset int           `json:"offset" validate:"min=0"`
+	}
+
+	MissingTranslation struct {
+		ID     int           `json:"id"`
+		Title  string        `json:"title"`
+		Locale locale.Locale `json:"locale"`
+		Fields []string      `json:"fields"`
+	}
+
+	MissingTranslationsResponse struct {
+		Missing []*MissingTranslation `json:"missing"`
+		Total   int                   `json:"total"`
+	}
+)
+
+func MakeStorageTranslationToEntity(translation *ent.BenefitTranslation) *Translation {
+	return &Translation{
+		Locale:  locale.Locale(translation.Locale),
+		Title:   translation.Title,
+		Content: translation.Content,
+		Bonus:   translation.Bonus,
+	}
+}
+
+// MakeStorageMissingTranslationToEntity reports the fields of benefit that
+// have text but no translation into loc. It is nil when there are none.
+func MakeStorageMissingTranslationToEntity(benefit *ent.Benefit, loc locale.Locale) *MissingTranslation {
+	var translation *ent.BenefitTranslation
+	for _, t := range benefit.Edges.Translations {
+		if locale.Locale(t.Locale) == loc {
+			translation = t
+		}
+	}
+	if translation == nil {
+		translation = &ent.BenefitTranslation{}
+	}
+
+	fields := make([]string, 0)
+	if benefit.Title != "" && translation.Title == "" {
+		fields = append(fields, "title")
+	}
+	if benefit.Content != "" && translation.Content == "" {
+		fields = append(fields, "content")
+	}
+	if benefit.Bonus != "" && translation.Bonus == "" {
+		fields = append(fields, "bonus")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &MissingTranslation{
+		ID:     benefit.ID,
+		Title:  benefit.Title,
+		Locale: loc,
+		Fields: fields,
+	}
+}
@@ -3,13 +3,14 @@ package entity
 type (
 	UpdateRequest struct {
 		ID         int                    `json:"id"`
-		Title      string                 `json:"title"`
+		Title      string                 `json:"title" validate:"required,max=255"`
 		Content    string                 `json:"content"`
 		Bonus      string                 `json:"bonus"`
 		VideoURL   *string                `json:"video_url,omitempty"`
 		SourceURL  *string                `json:"source_url,omitempty"`
 		Filters    []BenefitFilterRequest `json:"filters,omitempty"`
 		Categories []int                  `json:"categories,omitempty"`
+		Rules      *RuleGroupRequest      `json:"rules,omitempty"`
 	}
 
 	UpdateResponse struct {
@@ -0,0 +1,306 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/citizenkz/core/ent/benefit"
+	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
+	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/predicate"
+	"github.com/citizenkz/core/ent/rulegroup"
+	benefitConsts "github.com/citizenkz/core/services/benefit/consts"
+	"github.com/citizenkz/core/services/eligibility"
+	"github.com/citizenkz/core/services/filter/consts"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+const (
+	numberPattern = `'^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$'`
+	datePattern   = `'^\s*[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[-+][0-9]{2}:[0-9]{2}))?\s*$'`
+)
+
+// Rule group outcomes as computed in SQL, ordered like eligibility's so that
+// AND is MIN and OR is MAX.
+const (
+	outcomeFailed  = 0
+	outcomeMissing = 1
+	outcomeMatched = 2
+)
+
+// groupCondition pairs a criterion's filter with the predicate matching
+// benefit filter rows against it.
+type groupCondition struct {
+	filterID int
+	match    predicate.BenefitFilter
+}
+
+// criteriaPredicates translates eligibility criteria into benefit predicates
+// with the same semantics as eligibility.Evaluate: a benefit passes a
+// criterion when it has no ungrouped row for the filter or at least one of
+// them matches, and passes its rule groups when none of the roots fails.
+func (s *storage) criteriaPredicates(ctx context.Context, criteria []eligibility.Criterion) ([]predicate.Benefit, error) {
+	if len(criteria) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int, 0, len(criteria))
+	for _, c := range criteria {
+		ids = append(ids, c.FilterID)
+	}
+
+	filters, err := s.client.Filter.Query().
+		Where(filter.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get filters", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	filterTypes := make(map[int]consts.FilterType, len(filters))
+	for _, f := range filters {
+		filterTypes[f.ID] = consts.FilterType(f.Type)
+	}
+
+	predicates := make([]predicate.Benefit, 0, len(criteria)+1)
+	conditions := make([]groupCondition, 0, len(criteria))
+	for _, c := range criteria {
+		filterType, ok := filterTypes[c.FilterID]
+		if !ok {
+			filterType = consts.StringRange
+		}
+
+		match, err := criterionPredicate(filterType, c)
+		if err != nil {
+			return nil, fmt.Errorf("filter %d: %w", c.FilterID, err)
+		}
+
+		// Filters inside rule groups are evaluated by ruleGroupsPredicate
+		predicates = append(predicates, benefit.Or(
+			benefit.Not(benefit.HasBenefitFiltersWith(benefitfilter.FilterID(c.FilterID), benefitfilter.RuleGroupIDIsNil())),
+			benefit.HasBenefitFiltersWith(benefitfilter.FilterID(c.FilterID), benefitfilter.RuleGroupIDIsNil(), match),
+		))
+		conditions = append(conditions, groupCondition{filterID: c.FilterID, match: match})
+	}
+
+	depth, err := s.ruleGroupDepth(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get rule group depth", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if depth > 0 {
+		predicates = append(predicates, ruleGroupsPredicate(conditions, depth))
+	}
+
+	return predicates, nil
+}
+
+// ruleGroupDepth returns the number of levels of the deepest rule group tree,
+// which is how far ruleGroupsPredicate has to nest.
+func (s *storage) ruleGroupDepth(ctx context.Context) (int, error) {
+	depth := 0
+	for level := rulegroup.ParentIDIsNil(); ; level = rulegroup.HasParentWith(level) {
+		exists, err := s.client.RuleGroup.Query().Where(level).Exist(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			return depth, nil
+		}
+		depth++
+	}
+}
+
+// ruleGroupsPredicate passes benefits none of whose root rule groups fails.
+// Trees nest differently per benefit, so every level is a subquery that
+// folds the outcomes of a group's conditions and child groups with the
+// group's operator, down to depth levels.
+func ruleGroupsPredicate(conditions []groupCondition, depth int) predicate.Benefit {
+	return func(s *sql.Selector) {
+		d := sql.Dialect(s.Dialect())
+		roots := d.Table(rulegroup.Table).As("g1")
+		s.Where(sql.Not(sql.Exists(
+			d.Select(roots.C(rulegroup.FieldID)).
+				From(roots).
+				Where(sql.And(
+					sql.ColumnsEQ(roots.C(rulegroup.FieldBenefitID), s.C(benefit.FieldID)),
+					sql.IsNull(roots.C(rulegroup.FieldParentID)),
+					sql.P(func(b *sql.Builder) {
+						writeGroupOutcome(b, roots, 1, depth, conditions)
+						b.WriteString(fmt.Sprintf(" = %d", outcomeFailed))
+					}),
+				)),
+		)))
+	}
+}
+
+// writeGroupOutcome writes the outcome of group at level. An empty group
+// matches, so NOT of an empty group fails, as in eligibility.Evaluate.
+func writeGroupOutcome(b *sql.Builder, group *sql.SelectTable, level, depth int, conditions []groupCondition) {
+	d := sql.Dialect(b.Dialect())
+	rows := d.Table(benefitfilter.Table).As(fmt.Sprintf("bf%d", level))
+	outcomes := fmt.Sprintf("o%d", level)
+	operator := group.C(rulegroup.FieldOperator)
+
+	b.WriteString("(SELECT CASE WHEN " + operator + " = ").Arg(benefitConsts.Not.String())
+	b.WriteString(fmt.Sprintf(" THEN %d - COALESCE(MIN(%s.outcome), %d)", outcomeMatched, outcomes, outcomeMatched))
+	b.WriteString(" WHEN " + operator + " = ").Arg(benefitConsts.Or.String())
+	b.WriteString(fmt.Sprintf(" THEN COALESCE(MAX(%s.outcome), %d)", outcomes, outcomeMatched))
+	b.WriteString(fmt.Sprintf(" ELSE COALESCE(MIN(%s.outcome), %d) END FROM (SELECT ", outcomes, outcomeMatched))
+	writeConditionOutcome(b, rows, conditions)
+	b.WriteString(" AS outcome FROM " + b.Quote(benefitfilter.Table) + " AS " + b.Quote(fmt.Sprintf("bf%d", level)))
+	b.WriteString(" WHERE " + rows.C(benefitfilter.FieldRuleGroupID) + " = " + group.C(rulegroup.FieldID))
+	if level < depth {
+		children := d.Table(rulegroup.Table).As(fmt.Sprintf("g%d", level+1))
+		b.WriteString(" UNION ALL SELECT ")
+		writeGroupOutcome(b, children, level+1, depth, conditions)
+		b.WriteString(" AS outcome FROM " + b.Quote(rulegroup.Table) + " AS " + b.Quote(fmt.Sprintf("g%d", level+1)))
+		b.WriteString(" WHERE " + children.C(rulegroup.FieldParentID) + " = " + group.C(rulegroup.FieldID))
+	}
+	b.WriteString(") AS " + outcomes + ")")
+}
+
+// writeConditionOutcome writes the outcome of a benefit filter row: missing
+// without a criterion for its filter, else whether the criterion matches.
+func writeConditionOutcome(b *sql.Builder, rows *sql.SelectTable, conditions []groupCondition) {
+	b.WriteString("CASE")
+	// The first WHEN wins, and the last criterion for a filter should
+	for i := len(conditions) - 1; i >= 0; i-- {
+		match := sql.Dialect(b.Dialect()).Select().From(rows)
+		conditions[i].match(match)
+		b.WriteString(" WHEN " + rows.C(benefitfilter.FieldFilterID) + " = ").Arg(conditions[i].filterID)
+		b.WriteString(" THEN (CASE WHEN ").Join(match.P())
+		b.WriteString(fmt.Sprintf(" THEN %d ELSE %d END)", outcomeMatched, outcomeFailed))
+	}
+	b.WriteString(fmt.Sprintf(" ELSE %d END", outcomeMissing))
+}
+
+// criterionPredicate matches benefit filter rows whose value or range accepts
+// the criterion, comparing the text columns as the filter type dictates.
+func criterionPredicate(filterType consts.FilterType, c eligibility.Criterion) (predicate.BenefitFilter, error) {
+	var value, from, to any
+	var err error
+
+	if c.Value != nil {
+		if value, err = criterionArg(filterType, *c.Value); err != nil {
+			return nil, err
+		}
+	}
+	if c.From != nil {
+		if from, err = criterionArg(filterType, *c.From); err != nil {
+			return nil, err
+		}
+	}
+	if c.To != nil {
+		if to, err = criterionArg(filterType, *c.To); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(s *sql.Selector) {
+		valueColumn := typedColumn(filterType, s.C(benefitfilter.FieldValue))
+		fromColumn := typedColumn(filterType, s.C(benefitfilter.FieldFrom))
+		toColumn := typedColumn(filterType, s.C(benefitfilter.FieldTo))
+		hasRange := fmt.Sprintf("%s IS NULL AND (%s IS NOT NULL OR %s IS NOT NULL)",
+			s.C(benefitfilter.FieldValue), s.C(benefitfilter.FieldFrom), s.C(benefitfilter.FieldTo))
+
+		s.Where(sql.P(func(b *sql.Builder) {
+			switch {
+			case c.Value != nil:
+				// Equal to the benefit's value, or inside its range bounds
+				b.WriteString("((" + valueColumn + " = ").Arg(typedArg(filterType, value)).WriteString(")")
+				b.WriteString(" OR (" + hasRange)
+				b.WriteString(" AND (" + s.C(benefitfilter.FieldFrom) + " IS NULL OR " + fromColumn + " <= ").Arg(typedArg(filterType, value)).WriteString(")")
+				b.WriteString(" AND (" + s.C(benefitfilter.FieldTo) + " IS NULL OR ").Arg(typedArg(filterType, value)).WriteString(" <= " + toColumn + ")))")
+			case c.From != nil || c.To != nil:
+				// Overlaps the benefit's range, open bounds overlap everything
+				b.WriteString("(" + hasRange)
+				if c.From != nil {
+					b.WriteString(" AND (" + s.C(benefitfilter.FieldTo) + " IS NULL OR ").Arg(typedArg(filterType, from)).WriteString(" <= " + toColumn + ")")
+				}
+				if c.To != nil {
+					b.WriteString(" AND (" + s.C(benefitfilter.FieldFrom) + " IS NULL OR " + fromColumn + " <= ").Arg(typedArg(filterType, to)).WriteString(")")
+				}
+				b.WriteString(")")
+			default:
+				b.WriteString("FALSE")
+			}
+		}))
+	}, nil
+}
+
+// criterionArg parses a criterion value into the argument compared in SQL.
+func criterionArg(filterType consts.FilterType, value string) (any, error) {
+	if filterType == consts.DateRange {
+		t, err := eligibility.ParseDate(value)
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(time.DateOnly), nil
+	}
+
+	if err := eligibility.ValidateValue(filterType, nil, value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// typedArg casts an argument to the SQL type the filter type compares with.
+// Strings compare bytewise, as in eligibility.Compare, whatever the database
+// collation.
+func typedArg(filterType consts.FilterType, arg any) sql.Querier {
+	switch filterType {
+	case consts.NumberRange:
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CAST(").Arg(arg).WriteString(" AS NUMERIC)")
+		})
+	case consts.DateRange:
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CAST(").Arg(arg).WriteString(" AS DATE)")
+		})
+	default:
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Arg(arg).WriteString(` COLLATE "C"`)
+		})
+	}
+}
+
+// typedColumn casts a text column to the SQL type the filter type compares
+// with. Values that don't look like one become NULL, so a malformed row never
+// matches instead of failing the whole query. A date that looks right but
+// doesn't exist would still fail the cast; those are rejected on write and
+// were cleaned up by the filter_values migration.
+func typedColumn(filterType consts.FilterType, column string) string {
+	switch filterType {
+	case consts.NumberRange:
+		return fmt.Sprintf("(CASE WHEN %[1]s ~ %[2]s THEN CAST(%[1]s AS NUMERIC) END)", column, numberPattern)
+	case consts.DateRange:
+		return fmt.Sprintf("(CASE WHEN %[1]s ~ %[2]s THEN (CASE WHEN LENGTH(TRIM(%[1]s)) = 10 THEN CAST(TRIM(%[1]s) AS DATE) "+
+			"ELSE CAST(CAST(TRIM(%[1]s) AS TIMESTAMPTZ) AT TIME ZONE 'UTC' AS DATE) END) END)", column, datePattern)
+	default:
+		return column + ` COLLATE "C"`
+	}
+}
+
+// missingTranslation passes benefits with a field that has text but none in
+// the loc translation. A NULL translation field never equals, so it counts as
+// missing.
+func missingTranslation(loc locale.Locale) predicate.Benefit {
+	translated := func(field predicate.BenefitTranslation) predicate.Benefit {
+		return benefit.HasTranslationsWith(
+			benefittranslation.LocaleEQ(benefittranslation.Locale(loc.String())),
+			field,
+		)
+	}
+
+	return benefit.Or(
+		benefit.And(benefit.TitleNEQ(""), benefit.Not(translated(benefittranslation.TitleNEQ("")))),
+		benefit.And(benefit.ContentNEQ(""), benefit.Not(translated(benefittranslation.ContentNEQ("")))),
+		benefit.And(benefit.BonusNEQ(""), benefit.Not(translated(benefittranslation.BonusNEQ("")))),
+	)
+}
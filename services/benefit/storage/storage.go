@@ -2,13 +2,26 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/citizenkz/core/ent"
 	"github.com/citizenkz/core/ent/benefit"
 	"github.com/citizenkz/core/ent/benefitcategory"
 	"github.com/citizenkz/core/ent/benefitfilter"
+	"github.com/citizenkz/core/ent/benefittranslation"
+	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/rulegroup"
+	"github.com/citizenkz/core/ent/userfilter"
+	benefitConsts "github.com/citizenkz/core/services/benefit/consts"
 	"github.com/citizenkz/core/services/benefit/entity"
+	"github.com/citizenkz/core/services/eligibility"
+	"github.com/citizenkz/core/services/filter/consts"
+	"github.com/citizenkz/core/utils/dberr"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/citizenkz/core/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type storage struct {
@@ -19,9 +32,19 @@ type storage struct {
 type Storage interface {
 	CreateBenefit(ctx context.Context, req *entity.CreateRequest) (*entity.BenefitWithFilters, error)
 	GetBenefit(ctx context.Context, id int) (*entity.BenefitWithFilters, error)
+	ExplainBenefit(ctx context.Context, id int, criteria []eligibility.Criterion) (*entity.BenefitWithFilters, error)
 	ListBenefits(ctx context.Context, req *entity.ListRequest) ([]*entity.BenefitWithFilters, int, error)
 	UpdateBenefit(ctx context.Context, req *entity.UpdateRequest) (*entity.BenefitWithFilters, error)
 	DeleteBenefit(ctx context.Context, id int) error
+	GetUserCriteria(ctx context.Context, userID int) ([]eligibility.Criterion, error)
+	ListEligibleBenefits(ctx context.Context, criteria []eligibility.Criterion) ([]*entity.BenefitWithFilters, error)
+	ListCandidateBenefits(ctx context.Context, criteria []eligibility.Criterion) ([]*ent.Benefit, error)
+	ListTranslations(ctx context.Context, id int) ([]*entity.Translation, error)
+	SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.Translation, error)
+	DeleteTranslation(ctx context.Context, id int, loc locale.Locale) error
+	// ListMissingTranslations lists the benefits with fields not yet
+	// translated into the requested locales.
+	ListMissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) ([]*entity.MissingTranslation, int, error)
 }
 
 func New(client *ent.Client, log *slog.Logger) Storage {
@@ -31,11 +54,16 @@ func New(client *ent.Client, log *slog.Logger) Storage {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *storage) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *storage) CreateBenefit(ctx context.Context, req *entity.CreateRequest) (*entity.BenefitWithFilters, error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
-		s.log.Error("failed to start transaction", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	// Create the benefit
@@ -48,16 +76,24 @@ func (s *storage) CreateBenefit(ctx context.Context, req *entity.CreateRequest)
 
 	benefit, err := benefitCreate.Save(ctx)
 	if err != nil {
-		s.log.Error("failed to save benefit", slog.String("error", err.Error()))
+		s.logger(ctx).Error("failed to save benefit", slog.String("error", err.Error()))
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 		}
-		return nil, err
+		return nil, dberr.Tag(err)
 	}
 
 	// Create benefit filters
 	if len(req.Filters) > 0 {
 		for _, filter := range req.Filters {
+			if err := s.validateBenefitFilter(ctx, tx, filter); err != nil {
+				s.logger(ctx).Error("failed to validate benefit filter", slog.String("error", err.Error()))
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+				}
+				return nil, dberr.Tag(err)
+			}
+
 			_, err := tx.BenefitFilter.Create().
 				SetBenefitID(benefit.ID).
 				SetFilterID(filter.FilterID).
@@ -66,12 +102,23 @@ func (s *storage) CreateBenefit(ctx context.Context, req *entity.CreateRequest)
 				SetNillableTo(filter.To).
 				Save(ctx)
 			if err != nil {
-				s.log.Error("failed to save benefit filter", slog.String("error", err.Error()))
+				s.logger(ctx).Error("failed to save benefit filter", slog.String("error", err.Error()))
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+					s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 				}
-				return nil, err
+				return nil, dberr.Tag(err)
+			}
+		}
+	}
+
+	// Create rule groups
+	if req.Rules != nil {
+		if err := s.createRuleGroup(ctx, tx, benefit.ID, nil, req.Rules); err != nil {
+			s.logger(ctx).Error("failed to save rule group", slog.String("error", err.Error()))
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 			}
+			return nil, dberr.Tag(err)
 		}
 	}
 
@@ -83,18 +130,18 @@ func (s *storage) CreateBenefit(ctx context.Context, req *entity.CreateRequest)
 				SetCategoryID(categoryID).
 				Save(ctx)
 			if err != nil {
-				s.log.Error("failed to save benefit category", slog.String("error", err.Error()))
+				s.logger(ctx).Error("failed to save benefit category", slog.String("error", err.Error()))
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+					s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 				}
-				return nil, err
+				return nil, dberr.Tag(err)
 			}
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		s.log.Error("failed to commit transaction", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	// Fetch the created benefit with filters
@@ -102,27 +149,42 @@ func (s *storage) CreateBenefit(ctx context.Context, req *entity.CreateRequest)
 }
 
 func (s *storage) GetBenefit(ctx context.Context, id int) (*entity.BenefitWithFilters, error) {
-	benefit, err := s.client.Benefit.Query().
-		Where(benefit.ID(id)).
-		WithBenefitFilters().
-		WithBenefitCategories(func(bcq *ent.BenefitCategoryQuery) {
-			bcq.WithCategory()
-		}).
+	benefit, err := s.getBenefit(ctx, id)
+	if err != nil {
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageBenefitWithFiltersToEntity(benefit, locale.FromContext(ctx)), nil
+}
+
+// ExplainBenefit returns the benefit along with a report of how each of its
+// filters compares with the criteria.
+func (s *storage) ExplainBenefit(ctx context.Context, id int, criteria []eligibility.Criterion) (*entity.BenefitWithFilters, error) {
+	benefit, err := s.getBenefit(ctx, id)
+	if err != nil {
+		return nil, dberr.Tag(err)
+	}
+
+	result := entity.MakeStorageBenefitWithFiltersToEntity(benefit, locale.FromContext(ctx))
+	result.Eligibility = eligibility.Explain(benefit, criteria, locale.FromContext(ctx))
+
+	return result, nil
+}
+
+func (s *storage) getBenefit(ctx context.Context, id int) (*ent.Benefit, error) {
+	benefit, err := withDetails(s.client.Benefit.Query().
+		Where(benefit.ID(id))).
 		First(ctx)
 	if err != nil {
-		s.log.Error("failed to get benefit", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get benefit", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageBenefitWithFiltersToEntity(benefit), nil
+	return benefit, nil
 }
 
 func (s *storage) ListBenefits(ctx context.Context, req *entity.ListRequest) ([]*entity.BenefitWithFilters, int, error) {
-	query := s.client.Benefit.Query().
-		WithBenefitFilters().
-		WithBenefitCategories(func(bcq *ent.BenefitCategoryQuery) {
-			bcq.WithCategory()
-		})
+	query := s.client.Benefit.Query()
 
 	// Apply search filter
 	if req.Search != "" {
@@ -131,119 +193,173 @@ func (s *storage) ListBenefits(ctx context.Context, req *entity.ListRequest) ([]
 				benefit.TitleContains(req.Search),
 				benefit.ContentContains(req.Search),
 				benefit.BonusContains(req.Search),
+				benefit.HasTranslationsWith(
+					benefittranslation.Or(
+						benefittranslation.TitleContains(req.Search),
+						benefittranslation.ContentContains(req.Search),
+						benefittranslation.BonusContains(req.Search),
+					),
+				),
 			),
 		)
 	}
 
-	// Fetch all benefits first (we'll filter by criteria in application logic)
-	allBenefits, err := query.All(ctx)
+	// Apply filter criteria
+	criteria := entity.MakeFilterCriteriaToEligibility(req.Filters)
+	predicates, err := s.criteriaPredicates(ctx, criteria)
 	if err != nil {
-		s.log.Error("failed to list benefits", slog.String("error", err.Error()))
-		return nil, 0, err
+		s.logger(ctx).Error("failed to build filter predicates", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
+	query = query.Where(predicates...)
 
-	// Filter benefits based on filter criteria
-	var filteredBenefits []*ent.Benefit
-	if len(req.Filters) > 0 {
-		for _, b := range allBenefits {
-			if s.benefitMatchesFilters(b, req.Filters) {
-				filteredBenefits = append(filteredBenefits, b)
-			}
-		}
-	} else {
-		filteredBenefits = allBenefits
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to count benefits", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
 
-	total := len(filteredBenefits)
-
 	// Apply pagination
-	start := req.Offset
-	if start > len(filteredBenefits) {
-		start = len(filteredBenefits)
+	query = query.Order(ent.Asc(benefit.FieldID)).Offset(req.Offset)
+	if req.Limit > 0 {
+		query = query.Limit(req.Limit)
 	}
 
-	end := start + req.Limit
-	if req.Limit == 0 || end > len(filteredBenefits) {
-		end = len(filteredBenefits)
+	benefits, err := withDetails(query).All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to list benefits", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
 
-	paginatedBenefits := filteredBenefits[start:end]
-
-	result := make([]*entity.BenefitWithFilters, 0, len(paginatedBenefits))
-	for _, b := range paginatedBenefits {
-		result = append(result, entity.MakeStorageBenefitWithFiltersToEntity(b))
+	_, span := tracing.Start(ctx, "benefit.buildResults",
+		attribute.Int("benefits", len(benefits)),
+		attribute.Bool("explain", req.Explain),
+	)
+	defer span.End()
+
+	result := make([]*entity.BenefitWithFilters, 0, len(benefits))
+	for _, b := range benefits {
+		item := entity.MakeStorageBenefitWithFiltersToEntity(b, locale.FromContext(ctx))
+		if req.Explain {
+			item.Eligibility = eligibility.Explain(b, criteria, locale.FromContext(ctx))
+		}
+		result = append(result, item)
 	}
 
 	return result, total, nil
 }
 
-// benefitMatchesFilters checks if a benefit matches the filter criteria
-// A benefit matches if:
-// - It doesn't have a filter record for a given filter ID (passes through), OR
-// - It has a filter record and the values match
-func (s *storage) benefitMatchesFilters(benefit *ent.Benefit, filters []entity.FilterCriteria) bool {
-	for _, filterCriteria := range filters {
-		// Find if benefit has this filter
-		hasFilter := false
-		matchesValue := false
-
-		if benefit.Edges.BenefitFilters != nil {
-			for _, bf := range benefit.Edges.BenefitFilters {
-				if bf.FilterID == filterCriteria.FilterID {
-					hasFilter = true
-					// Check if values match
-					if s.filterValuesMatch(bf, &filterCriteria) {
-						matchesValue = true
-						break
-					}
-				}
-			}
+// withDetails loads everything a benefit response and its eligibility
+// evaluation need, translations included.
+func withDetails(query *ent.BenefitQuery) *ent.BenefitQuery {
+	return query.
+		WithTranslations().
+		WithBenefitFilters(func(bfq *ent.BenefitFilterQuery) {
+			bfq.WithFilter(func(fq *ent.FilterQuery) {
+				fq.WithTranslations()
+			})
+		}).
+		WithBenefitCategories(func(bcq *ent.BenefitCategoryQuery) {
+			bcq.WithCategory(func(cq *ent.CategoryQuery) {
+				cq.WithTranslations()
+			})
+		}).
+		WithRuleGroups(func(rgq *ent.RuleGroupQuery) {
+			rgq.Order(ent.Asc(rulegroup.FieldID))
+		})
+}
+
+// GetUserCriteria builds eligibility criteria from the user's saved filter
+// values. Age filters are answered from the user's birth date when it is set.
+func (s *storage) GetUserCriteria(ctx context.Context, userID int) ([]eligibility.Criterion, error) {
+	user, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		s.logger(ctx).Error("failed to get user", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	userFilters, err := s.client.UserFilter.Query().
+		Where(userfilter.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get user filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	ageFilterIDs := make(map[int]bool)
+	if !user.BirthDate.IsZero() {
+		ids, err := s.client.Filter.Query().
+			Where(filter.IsAge(true)).
+			IDs(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to get age filters", slog.String("error", err.Error()))
+			return nil, dberr.Tag(err)
 		}
+		for _, id := range ids {
+			ageFilterIDs[id] = true
+		}
+	}
 
-		// If benefit has this filter but doesn't match the value, exclude it
-		if hasFilter && !matchesValue {
-			return false
+	criteria := make([]eligibility.Criterion, 0, len(userFilters)+len(ageFilterIDs))
+	for _, uf := range userFilters {
+		// Birth date takes precedence over a saved age value
+		if ageFilterIDs[uf.FilterID] {
+			continue
 		}
-		// If benefit doesn't have this filter, it passes through (continue to next filter)
+		value := uf.Value
+		criteria = append(criteria, eligibility.Criterion{
+			FilterID: uf.FilterID,
+			Value:    &value,
+		})
+	}
+
+	if len(ageFilterIDs) > 0 {
+		criteria = append(criteria, eligibility.AgeCriteria(user.BirthDate, ageFilterIDs)...)
 	}
 
-	return true
+	return criteria, nil
 }
 
-// filterValuesMatch compares benefit filter values with criteria
-func (s *storage) filterValuesMatch(bf *ent.BenefitFilter, criteria *entity.FilterCriteria) bool {
-	// For single value filters
-	if criteria.Value != nil {
-		return bf.Value != nil && *bf.Value == *criteria.Value
-	}
-
-	// For range filters (from/to)
-	if criteria.From != nil || criteria.To != nil {
-		// Check if the benefit filter range overlaps with criteria range
-		if bf.From != nil && bf.To != nil {
-			// Both benefit and criteria use ranges
-			if criteria.From != nil && criteria.To != nil {
-				// Check for range overlap
-				return !(*bf.To < *criteria.From || *bf.From > *criteria.To)
-			}
-			// Criteria has only from or to
-			if criteria.From != nil {
-				return *bf.To >= *criteria.From
-			}
-			if criteria.To != nil {
-				return *bf.From <= *criteria.To
-			}
-		}
+func (s *storage) ListEligibleBenefits(ctx context.Context, criteria []eligibility.Criterion) ([]*entity.BenefitWithFilters, error) {
+	benefits, err := s.ListCandidateBenefits(ctx, criteria)
+	if err != nil {
+		return nil, dberr.Tag(err)
+	}
+
+	result := make([]*entity.BenefitWithFilters, 0, len(benefits))
+	for _, b := range benefits {
+		result = append(result, entity.MakeStorageBenefitWithFiltersToEntity(b, locale.FromContext(ctx)))
 	}
 
-	return false
+	return result, nil
+}
+
+// ListCandidateBenefits returns the benefits still eligible for the criteria
+// with their filters and rule groups loaded for further evaluation.
+func (s *storage) ListCandidateBenefits(ctx context.Context, criteria []eligibility.Criterion) ([]*ent.Benefit, error) {
+	predicates, err := s.criteriaPredicates(ctx, criteria)
+	if err != nil {
+		s.logger(ctx).Error("failed to build filter predicates", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	query := s.client.Benefit.Query().
+		Where(predicates...)
+
+	benefits, err := withDetails(query.Order(ent.Asc(benefit.FieldID))).All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to list benefits", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return benefits, nil
 }
 
 func (s *storage) UpdateBenefit(ctx context.Context, req *entity.UpdateRequest) (*entity.BenefitWithFilters, error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
-		s.log.Error("failed to start transaction", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	// Update the benefit
@@ -255,11 +371,11 @@ func (s *storage) UpdateBenefit(ctx context.Context, req *entity.UpdateRequest)
 		SetNillableSourceURL(req.SourceURL).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update benefit", slog.String("error", err.Error()))
+		s.logger(ctx).Error("failed to update benefit", slog.String("error", err.Error()))
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 		}
-		return nil, err
+		return nil, dberr.Tag(err)
 	}
 
 	// Delete existing benefit filters
@@ -267,16 +383,24 @@ func (s *storage) UpdateBenefit(ctx context.Context, req *entity.UpdateRequest)
 		Where(benefitfilter.BenefitID(benefit.ID)).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete old benefit filters", slog.String("error", err.Error()))
+		s.logger(ctx).Error("failed to delete old benefit filters", slog.String("error", err.Error()))
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 		}
-		return nil, err
+		return nil, dberr.Tag(err)
 	}
 
 	// Create new benefit filters
 	if len(req.Filters) > 0 {
 		for _, filter := range req.Filters {
+			if err := s.validateBenefitFilter(ctx, tx, filter); err != nil {
+				s.logger(ctx).Error("failed to validate benefit filter", slog.String("error", err.Error()))
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+				}
+				return nil, dberr.Tag(err)
+			}
+
 			_, err := tx.BenefitFilter.Create().
 				SetBenefitID(benefit.ID).
 				SetFilterID(filter.FilterID).
@@ -285,12 +409,34 @@ func (s *storage) UpdateBenefit(ctx context.Context, req *entity.UpdateRequest)
 				SetNillableTo(filter.To).
 				Save(ctx)
 			if err != nil {
-				s.log.Error("failed to save benefit filter", slog.String("error", err.Error()))
+				s.logger(ctx).Error("failed to save benefit filter", slog.String("error", err.Error()))
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+					s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 				}
-				return nil, err
+				return nil, dberr.Tag(err)
+			}
+		}
+	}
+
+	// Replace rule groups, their filters were deleted above
+	_, err = tx.RuleGroup.Delete().
+		Where(rulegroup.BenefitID(benefit.ID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete old rule groups", slog.String("error", err.Error()))
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return nil, dberr.Tag(err)
+	}
+
+	if req.Rules != nil {
+		if err := s.createRuleGroup(ctx, tx, benefit.ID, nil, req.Rules); err != nil {
+			s.logger(ctx).Error("failed to save rule group", slog.String("error", err.Error()))
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 			}
+			return nil, dberr.Tag(err)
 		}
 	}
 
@@ -300,11 +446,11 @@ func (s *storage) UpdateBenefit(ctx context.Context, req *entity.UpdateRequest)
 			Where(benefitcategory.BenefitID(benefit.ID)).
 			Exec(ctx)
 		if err != nil {
-			s.log.Error("failed to delete old benefit categories", slog.String("error", err.Error()))
+			s.logger(ctx).Error("failed to delete old benefit categories", slog.String("error", err.Error()))
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+				s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 			}
-			return nil, err
+			return nil, dberr.Tag(err)
 		}
 	}
 
@@ -316,30 +462,84 @@ func (s *storage) UpdateBenefit(ctx context.Context, req *entity.UpdateRequest)
 				SetCategoryID(categoryID).
 				Save(ctx)
 			if err != nil {
-				s.log.Error("failed to save benefit category", slog.String("error", err.Error()))
+				s.logger(ctx).Error("failed to save benefit category", slog.String("error", err.Error()))
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					s.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+					s.logger(ctx).Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
 				}
-				return nil, err
+				return nil, dberr.Tag(err)
 			}
 		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		s.log.Error("failed to commit transaction", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	// Fetch the updated benefit with filters
 	return s.GetBenefit(ctx, benefit.ID)
 }
 
+// createRuleGroup saves a rule group with its filter conditions and nested
+// groups under the given parent.
+func (s *storage) createRuleGroup(ctx context.Context, tx *ent.Tx, benefitID int, parentID *int, req *entity.RuleGroupRequest) error {
+	if req.Operator == benefitConsts.Not && len(req.Filters)+len(req.Groups) != 1 {
+		return fmt.Errorf("%w: NOT rule group must have exactly one member", eligibility.ErrInvalidValue)
+	}
+
+	group, err := tx.RuleGroup.Create().
+		SetBenefitID(benefitID).
+		SetNillableParentID(parentID).
+		SetOperator(rulegroup.Operator(req.Operator.String())).
+		Save(ctx)
+	if err != nil {
+		return dberr.Tag(err)
+	}
+
+	for _, filter := range req.Filters {
+		if err := s.validateBenefitFilter(ctx, tx, filter); err != nil {
+			return dberr.Tag(err)
+		}
+
+		_, err := tx.BenefitFilter.Create().
+			SetBenefitID(benefitID).
+			SetFilterID(filter.FilterID).
+			SetNillableValue(filter.Value).
+			SetNillableFrom(filter.From).
+			SetNillableTo(filter.To).
+			SetRuleGroupID(group.ID).
+			Save(ctx)
+		if err != nil {
+			return dberr.Tag(err)
+		}
+	}
+
+	for i := range req.Groups {
+		if err := s.createRuleGroup(ctx, tx, benefitID, &group.ID, &req.Groups[i]); err != nil {
+			return dberr.Tag(err)
+		}
+	}
+
+	return nil
+}
+
+// validateBenefitFilter rejects values and bounds that can't be compared
+// under the filter's type.
+func (s *storage) validateBenefitFilter(ctx context.Context, tx *ent.Tx, req entity.BenefitFilterRequest) error {
+	f, err := tx.Filter.Get(ctx, req.FilterID)
+	if err != nil {
+		return dberr.Tag(err)
+	}
+
+	return eligibility.ValidateBounds(consts.FilterType(f.Type), f.Values, req.Value, req.From, req.To)
+}
+
 func (s *storage) DeleteBenefit(ctx context.Context, id int) error {
 	// Start a transaction to delete benefit and its relations
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
-		s.log.Error("failed to start transaction", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to start transaction", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	// Delete benefit filters
@@ -347,9 +547,19 @@ func (s *storage) DeleteBenefit(ctx context.Context, id int) error {
 		Where(benefitfilter.BenefitID(id)).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete benefit filters", slog.String("error", err.Error()))
+		s.logger(ctx).Error("failed to delete benefit filters", slog.String("error", err.Error()))
 		tx.Rollback()
-		return err
+		return dberr.Tag(err)
+	}
+
+	// Delete rule groups
+	_, err = tx.RuleGroup.Delete().
+		Where(rulegroup.BenefitID(id)).
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete rule groups", slog.String("error", err.Error()))
+		tx.Rollback()
+		return dberr.Tag(err)
 	}
 
 	// Delete benefit categories (junction table records)
@@ -357,24 +567,168 @@ func (s *storage) DeleteBenefit(ctx context.Context, id int) error {
 		Where(benefitcategory.BenefitID(id)).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete benefit categories", slog.String("error", err.Error()))
+		s.logger(ctx).Error("failed to delete benefit categories", slog.String("error", err.Error()))
 		tx.Rollback()
-		return err
+		return dberr.Tag(err)
 	}
 
 	// Delete the benefit itself
 	err = tx.Benefit.DeleteOneID(id).Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete benefit", slog.String("error", err.Error()))
+		s.logger(ctx).Error("failed to delete benefit", slog.String("error", err.Error()))
 		tx.Rollback()
-		return err
+		return dberr.Tag(err)
 	}
 
 	// Commit the transaction
 	if err := tx.Commit(); err != nil {
-		s.log.Error("failed to commit transaction", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to commit transaction", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	return nil
 }
+
+func (s *storage) ListTranslations(ctx context.Context, id int) ([]*entity.Translation, error) {
+	benefit, err := s.client.Benefit.Get(ctx, id)
+	if err != nil {
+		s.logger(ctx).Error("failed to get benefit", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	translations, err := benefit.QueryTranslations().
+		Order(ent.Asc(benefittranslation.FieldLocale)).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to list benefit translations", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	result := make([]*entity.Translation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, entity.MakeStorageTranslationToEntity(t))
+	}
+
+	return result, nil
+}
+
+func (s *storage) SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.Translation, error) {
+	// Not found rather than a foreign key conflict for a missing benefit
+	if _, err := s.client.Benefit.Get(ctx, req.ID); err != nil {
+		s.logger(ctx).Error("failed to get benefit", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	existing, err := s.client.BenefitTranslation.Query().
+		Where(
+			benefittranslation.BenefitID(req.ID),
+			benefittranslation.LocaleEQ(benefittranslation.Locale(req.Locale.String())),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger(ctx).Error("failed to get benefit translation", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	var translation *ent.BenefitTranslation
+	if existing == nil {
+		translation, err = s.client.BenefitTranslation.Create().
+			SetBenefitID(req.ID).
+			SetLocale(benefittranslation.Locale(req.Locale.String())).
+			SetTitle(req.Title).
+			SetContent(req.Content).
+			SetBonus(req.Bonus).
+			Save(ctx)
+	} else {
+		translation, err = existing.Update().
+			SetTitle(req.Title).
+			SetContent(req.Content).
+			SetBonus(req.Bonus).
+			Save(ctx)
+	}
+	if err != nil {
+		s.logger(ctx).Error("failed to save benefit translation", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageTranslationToEntity(translation), nil
+}
+
+func (s *storage) DeleteTranslation(ctx context.Context, id int, loc locale.Locale) error {
+	translation, err := s.client.BenefitTranslation.Query().
+		Where(
+			benefittranslation.BenefitID(id),
+			benefittranslation.LocaleEQ(benefittranslation.Locale(loc.String())),
+		).
+		Only(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get benefit translation", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	err = s.client.BenefitTranslation.DeleteOne(translation).Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete benefit translation", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+// ListMissingTranslations pages through the benefits with untranslated
+// fields one locale after another, so a benefit missing both locales is
+// listed under each.
+func (s *storage) ListMissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) ([]*entity.MissingTranslation, int, error) {
+	locales := locale.Translated
+	if req.Locale != "" {
+		locales = []locale.Locale{req.Locale}
+	}
+
+	missing := make([]*entity.MissingTranslation, 0)
+	total, offset := 0, req.Offset
+	for _, loc := range locales {
+		query := s.client.Benefit.Query().
+			Where(missingTranslation(loc))
+
+		count, err := query.Clone().Count(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to count benefits missing translations", slog.String("error", err.Error()))
+			return nil, 0, dberr.Tag(err)
+		}
+		total += count
+
+		// Skip locales the page starts after or that it has no room left for
+		if offset >= count {
+			offset -= count
+			continue
+		}
+		if req.Limit > 0 && len(missing) == req.Limit {
+			continue
+		}
+
+		query = query.
+			WithTranslations(func(q *ent.BenefitTranslationQuery) {
+				q.Where(benefittranslation.LocaleEQ(benefittranslation.Locale(loc.String())))
+			}).
+			Order(ent.Asc(benefit.FieldID)).
+			Offset(offset)
+		if req.Limit > 0 {
+			query = query.Limit(req.Limit - len(missing))
+		}
+		offset = 0
+
+		benefits, err := query.All(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to list benefits missing translations", slog.String("error", err.Error()))
+			return nil, 0, dberr.Tag(err)
+		}
+
+		for _, b := range benefits {
+			if m := entity.MakeStorageMissingTranslationToEntity(b, loc); m != nil {
+				missing = append(missing, m)
+			}
+		}
+	}
+
+	return missing, total, nil
+}
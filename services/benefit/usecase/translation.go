@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/citizenkz/core/services/benefit/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+func (u *usecase) ListTranslations(ctx context.Context, req *entity.ListTranslationsRequest) (*entity.ListTranslationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.ListTranslations")
+	defer span.End()
+
+	translations, err := u.storage.ListTranslations(ctx, req.ID)
+	if err != nil {
+		u.logger(ctx).Error("failed to list benefit translations", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+
+	return &entity.ListTranslationsResponse{
+		Translations: translations,
+	}, nil
+}
+
+func (u *usecase) SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.SaveTranslationResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.SaveTranslation")
+	defer span.End()
+
+	if !req.Locale.IsTranslated() {
+		return nil, apperr.Validation("benefits are not translated into %q", req.Locale)
+	}
+
+	translation, err := u.storage.SaveTranslation(ctx, req)
+	if err != nil {
+		u.logger(ctx).Error("failed to save benefit translation", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+
+	return &entity.SaveTranslationResponse{
+		Translation: translation,
+	}, nil
+}
+
+func (u *usecase) DeleteTranslation(ctx context.Context, req *entity.DeleteTranslationRequest) (*entity.DeleteTranslationResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.DeleteTranslation")
+	defer span.End()
+
+	err := u.storage.DeleteTranslation(ctx, req.ID, req.Locale)
+	if err != nil {
+		u.logger(ctx).Error("failed to delete benefit translation", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "translation not found")
+	}
+
+	return &entity.DeleteTranslationResponse{
+		Success: true,
+	}, nil
+}
+
+func (u *usecase) MissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) (*entity.MissingTranslationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.MissingTranslations")
+	defer span.End()
+
+	missing, total, err := u.storage.ListMissingTranslations(ctx, req)
+	if err != nil {
+		u.logger(ctx).Error("failed to list missing benefit translations", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+
+	return &entity.MissingTranslationsResponse{
+		Missing: missing,
+		Total:   total,
+	}, nil
+}
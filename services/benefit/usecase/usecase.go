@@ -7,6 +7,12 @@ import (
 	"github.com/citizenkz/core/config"
 	"github.com/citizenkz/core/services/benefit/entity"
 	"github.com/citizenkz/core/services/benefit/storage"
+	"github.com/citizenkz/core/services/eligibility"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 type usecase struct {
@@ -21,6 +27,13 @@ type UseCase interface {
 	List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error)
 	Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error)
 	Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error)
+	Eligible(ctx context.Context, req *entity.EligibleRequest) (*entity.EligibleResponse, error)
+	ListTranslations(ctx context.Context, req *entity.ListTranslationsRequest) (*entity.ListTranslationsResponse, error)
+	SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.SaveTranslationResponse, error)
+	DeleteTranslation(ctx context.Context, req *entity.DeleteTranslationRequest) (*entity.DeleteTranslationResponse, error)
+	// MissingTranslations reports the benefits with fields not yet
+	// translated, for editors to work through.
+	MissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) (*entity.MissingTranslationsResponse, error)
 }
 
 func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase {
@@ -31,11 +44,19 @@ func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (u *usecase) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, u.log)
+}
+
 func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entity.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.Create")
+	defer span.End()
+
 	benefit, err := u.storage.CreateBenefit(ctx, req)
 	if err != nil {
-		u.log.Error("failed to create benefit", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to create benefit", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter or category not found")
 	}
 
 	return &entity.CreateResponse{
@@ -44,10 +65,46 @@ func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entit
 }
 
 func (u *usecase) Get(ctx context.Context, req *entity.GetRequest) (*entity.GetResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.Get")
+	defer span.End()
+
+	if req.Explain {
+		return u.explain(ctx, req)
+	}
+
 	benefit, err := u.storage.GetBenefit(ctx, req.ID)
 	if err != nil {
-		u.log.Error("failed to get benefit", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to get benefit", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+
+	return &entity.GetResponse{
+		Benefit: benefit,
+	}, nil
+}
+
+// explain reports the benefit's conditions against the caller's saved
+// filters. Without a token every condition is reported as missing.
+func (u *usecase) explain(ctx context.Context, req *entity.GetRequest) (*entity.GetResponse, error) {
+	var criteria []eligibility.Criterion
+	if req.Token != "" {
+		userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+		if err != nil {
+			u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+			return nil, apperr.Unauthorized("invalid token")
+		}
+
+		criteria, err = u.storage.GetUserCriteria(ctx, userID)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.GetUserCriteria", slog.String("error", err.Error()))
+			return nil, apperr.Public(err, "user not found")
+		}
+	}
+
+	benefit, err := u.storage.ExplainBenefit(ctx, req.ID, criteria)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.ExplainBenefit", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
 	}
 
 	return &entity.GetResponse{
@@ -56,10 +113,13 @@ func (u *usecase) Get(ctx context.Context, req *entity.GetRequest) (*entity.GetR
 }
 
 func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.List")
+	defer span.End()
+
 	benefits, total, err := u.storage.ListBenefits(ctx, req)
 	if err != nil {
-		u.log.Error("failed to list benefits", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to list benefits", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
 	}
 
 	return &entity.ListResponse{
@@ -69,10 +129,13 @@ func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.Li
 }
 
 func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.Update")
+	defer span.End()
+
 	benefit, err := u.storage.UpdateBenefit(ctx, req)
 	if err != nil {
-		u.log.Error("failed to update benefit", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to update benefit", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit, filter or category not found")
 	}
 
 	return &entity.UpdateResponse{
@@ -81,13 +144,45 @@ func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entit
 }
 
 func (u *usecase) Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.Delete")
+	defer span.End()
+
 	err := u.storage.DeleteBenefit(ctx, req.ID)
 	if err != nil {
-		u.log.Error("failed to delete benefit", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to delete benefit", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
 	}
 
 	return &entity.DeleteResponse{
 		Success: true,
 	}, nil
 }
+
+func (u *usecase) Eligible(ctx context.Context, req *entity.EligibleRequest) (*entity.EligibleResponse, error) {
+	ctx, span := tracing.Start(ctx, "benefit.Eligible")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	criteria, err := u.storage.GetUserCriteria(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetUserCriteria", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "user not found")
+	}
+
+	benefits, err := u.storage.ListEligibleBenefits(ctx, criteria)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.ListEligibleBenefits", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+	metrics.EligibilityChecks.WithLabelValues(metrics.SubjectUser).Inc()
+
+	return &entity.EligibleResponse{
+		Benefits: benefits,
+		Total:    len(benefits),
+	}, nil
+}
@@ -2,7 +2,7 @@ package entity
 
 type (
 	CreateRequest struct {
-		Name        string  `json:"name"`
+		Name        string  `json:"name" validate:"required,max=255"`
 		Description *string `json:"description,omitempty"`
 	}
 
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/locale"
 )
 
 type (
@@ -12,10 +13,27 @@ type (
 	}
 )
 
-func MakeStorageCategoryToEntity(category *ent.Category) *Category {
-	return &Category{
+// MakeStorageCategoryToEntity returns category in loc, as far as its loaded
+// translations go.
+func MakeStorageCategoryToEntity(category *ent.Category, loc locale.Locale) *Category {
+	result := &Category{
 		ID:          category.ID,
 		Name:        category.Name,
 		Description: category.Description,
 	}
+
+	overlays := locale.Overlays(loc, category.Edges.Translations, func(t *ent.CategoryTranslation) locale.Locale {
+		return locale.Locale(t.Locale)
+	})
+	for _, t := range overlays {
+		if t.Name != "" {
+			result.Name = t.Name
+		}
+		if t.Description != "" {
+			description := t.Description
+			result.Description = &description
+		}
+	}
+
+	return result
 }
@@ -2,8 +2,8 @@ package entity
 
 type (
 	ListRequest struct {
-		Limit  int    `json:"limit"`
-		Offset int    `json:"offset"`
+		Limit  int    `json:"limit" validate:"min=0,max=100"`
+		Offset int    `json:"offset" validate:"min=0"`
 		Search string `json:"search,omitempty"`
 	}
 
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+type (
+	Translation struct {
+		Locale      locale.Locale `json:"locale"`
+		Name        string        `json:"name,omitempty"`
+		Description string        `json:"description,omitempty"`
+	}
+
+	ListTranslationsRequest struct {
+		ID int `json:"id"`
+	}
+
+	ListTranslationsResponse struct {
+		Translations []*Translation `json:"translations"`
+	}
+
+	// SaveTranslationRequest replaces the translation of a category. Fields
+	// left empty fall back to another locale.
+	SaveTranslationRequest struct {
+		ID          int           `json:"-"`
+		Locale      locale.Locale `json:"-"`
+		Name        string        `json:"name" validate:"max=255"`
+		Description string        `json:"description"`
+	}
+
+	SaveTranslationResponse struct {
+		Translation *Translation `json:"translation"`
+	}
+
+	DeleteTranslationRequest struct {
+		ID     int           `json:"id"`
+		Locale locale.Locale `json:"locale"`
+	}
+
+	DeleteTranslationResponse struct {
+		Success bool `json:"success"`
+	}
+
+	// MissingTranslationsRequest asks for the categories with untranslated
+	// fields, in Locale or in every translated locale when it's empty.
+	MissingTranslationsRequest struct {
+		Locale locale.Locale `json:"locale,omitempty" validate:"oneof=kk ru"`
+		Limit  int           `json:"limit" validate:"min=0,max=100"`
+		Offset int           `json:"offset" validate:"min=0"`
+	}
+
+	MissingTranslation struct {
+		ID     int           `json:"id"`
+		Name   string        `json:"name"`
+		Locale locale.Locale `json:"locale"`
+		Fields []string      `json:"fields"`
+	}
+
+	MissingTranslationsResponse struct {
+		Missing []*MissingTranslation `json:"missing"`
+		Total   int                   `json:"total"`
+	}
+)
+
+func MakeStorageTranslationToEntity(translation *ent.CategoryTranslation) *Translation {
+	return &Translation{
+		Locale:      locale.Locale(translation.Locale),
+		Name:        translation.Name,
+		Description: translation.Description,
+	}
+}
+
+// MakeStorageMissingTranslationToEntity reports the fields of category that
+// have text but no translation into loc. It is nil when there are none.
+func MakeStorageMissingTranslationToEntity(category *ent.Category, loc locale.Locale) *MissingTranslation {
+	var translation *ent.CategoryTranslation
+	for _, t := range category.Edges.Translations {
+		if locale.Locale(t.Locale) == loc {
+			translation = t
+		}
+	}
+	if translation == nil {
+		translation = &ent.CategoryTranslation{}
+	}
+
+	fields := make([]string, 0)
+	if category.Name != "" && translation.Name == "" {
+		fields = append(fields, "name")
+	}
+	if category.Description != nil && *category.Description != "" && translation.Description == "" {
+		fields = append(fields, "description")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &MissingTranslation{
+		ID:     category.ID,
+		Name:   category.Name,
+		Locale: loc,
+		Fields: fields,
+	}
+}
@@ -3,7 +3,7 @@ package entity
 type (
 	UpdateRequest struct {
 		ID          int     `json:"id"`
-		Name        string  `json:"name"`
+		Name        string  `json:"name" validate:"required,max=255"`
 		Description *string `json:"description,omitempty"`
 	}
 
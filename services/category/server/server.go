@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"github.com/citizenkz/core/services/category/entity"
 	"github.com/citizenkz/core/services/category/usecase"
 	"github.com/citizenkz/core/utils/json"
+	"github.com/citizenkz/core/utils/logger"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -22,6 +24,10 @@ type Server interface {
 	HandleList(w http.ResponseWriter, r *http.Request)
 	HandleUpdate(w http.ResponseWriter, r *http.Request)
 	HandleDelete(w http.ResponseWriter, r *http.Request)
+	HandleListTranslations(w http.ResponseWriter, r *http.Request)
+	HandleSaveTranslation(w http.ResponseWriter, r *http.Request)
+	HandleDeleteTranslation(w http.ResponseWriter, r *http.Request)
+	HandleMissingTranslations(w http.ResponseWriter, r *http.Request)
 }
 
 func New(log *slog.Logger, usecase usecase.UseCase) Server {
@@ -31,24 +37,29 @@ func New(log *slog.Logger, usecase usecase.UseCase) Server {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *server) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *server) HandleCreate(w http.ResponseWriter, r *http.Request) {
 	req := &entity.CreateRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	resp, err := s.usecase.Create(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Create", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Create", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -58,7 +69,7 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to parse id", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -69,14 +80,14 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Get(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Get", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Get", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -85,8 +96,8 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleList(w http.ResponseWriter, r *http.Request) {
 	req := &entity.ListRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -97,14 +108,14 @@ func (s *server) HandleList(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.List(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.List", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.List", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -114,15 +125,15 @@ func (s *server) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to parse id", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	req := &entity.UpdateRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -130,14 +141,14 @@ func (s *server) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Update(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Update", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Update", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -147,7 +158,7 @@ func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to parse id", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -158,14 +169,14 @@ func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Delete(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Delete", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Delete", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
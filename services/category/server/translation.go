@@ -0,0 +1,131 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/citizenkz/core/services/category/entity"
+	"github.com/citizenkz/core/utils/json"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *server) HandleListTranslations(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req := &entity.ListTranslationsRequest{
+		ID: id,
+	}
+
+	resp, err := s.usecase.ListTranslations(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.ListTranslations", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleSaveTranslation(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req := &entity.SaveTranslationRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	req.ID = id
+	req.Locale = locale.Locale(chi.URLParam(r, "locale"))
+
+	resp, err := s.usecase.SaveTranslation(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.SaveTranslation", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleDeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req := &entity.DeleteTranslationRequest{
+		ID:     id,
+		Locale: locale.Locale(chi.URLParam(r, "locale")),
+	}
+
+	resp, err := s.usecase.DeleteTranslation(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.DeleteTranslation", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleMissingTranslations(w http.ResponseWriter, r *http.Request) {
+	req := &entity.MissingTranslationsRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	// Set default limit if not provided
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.usecase.MissingTranslations(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.MissingTranslations", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
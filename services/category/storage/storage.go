@@ -6,7 +6,12 @@ import (
 
 	"github.com/citizenkz/core/ent"
 	"github.com/citizenkz/core/ent/category"
+	"github.com/citizenkz/core/ent/categorytranslation"
+	"github.com/citizenkz/core/ent/predicate"
 	"github.com/citizenkz/core/services/category/entity"
+	"github.com/citizenkz/core/utils/dberr"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 type storage struct {
@@ -20,6 +25,12 @@ type Storage interface {
 	ListCategories(ctx context.Context, req *entity.ListRequest) ([]*entity.Category, int, error)
 	UpdateCategory(ctx context.Context, req *entity.UpdateRequest) (*entity.Category, error)
 	DeleteCategory(ctx context.Context, id int) error
+	ListTranslations(ctx context.Context, id int) ([]*entity.Translation, error)
+	SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.Translation, error)
+	DeleteTranslation(ctx context.Context, id int, loc locale.Locale) error
+	// ListMissingTranslations lists the categories with fields not yet
+	// translated into the requested locales.
+	ListMissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) ([]*entity.MissingTranslation, int, error)
 }
 
 func New(client *ent.Client, log *slog.Logger) Storage {
@@ -29,27 +40,35 @@ func New(client *ent.Client, log *slog.Logger) Storage {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *storage) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *storage) CreateCategory(ctx context.Context, req *entity.CreateRequest) (*entity.Category, error) {
 	category, err := s.client.Category.Create().
 		SetName(req.Name).
 		SetNillableDescription(req.Description).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to save category", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to save category", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageCategoryToEntity(category), nil
+	return entity.MakeStorageCategoryToEntity(category, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) GetCategory(ctx context.Context, id int) (*entity.Category, error) {
-	category, err := s.client.Category.Get(ctx, id)
+	category, err := s.client.Category.Query().
+		Where(category.ID(id)).
+		WithTranslations().
+		Only(ctx)
 	if err != nil {
-		s.log.Error("failed to get category", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get category", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageCategoryToEntity(category), nil
+	return entity.MakeStorageCategoryToEntity(category, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) ListCategories(ctx context.Context, req *entity.ListRequest) ([]*entity.Category, int, error) {
@@ -61,6 +80,12 @@ func (s *storage) ListCategories(ctx context.Context, req *entity.ListRequest) (
 			category.Or(
 				category.NameContains(req.Search),
 				category.DescriptionContains(req.Search),
+				category.HasTranslationsWith(
+					categorytranslation.Or(
+						categorytranslation.NameContains(req.Search),
+						categorytranslation.DescriptionContains(req.Search),
+					),
+				),
 			),
 		)
 	}
@@ -68,8 +93,8 @@ func (s *storage) ListCategories(ctx context.Context, req *entity.ListRequest) (
 	// Get total count
 	total, err := query.Count(ctx)
 	if err != nil {
-		s.log.Error("failed to count categories", slog.String("error", err.Error()))
-		return nil, 0, err
+		s.logger(ctx).Error("failed to count categories", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
 
 	// Apply pagination
@@ -80,15 +105,15 @@ func (s *storage) ListCategories(ctx context.Context, req *entity.ListRequest) (
 		query = query.Offset(req.Offset)
 	}
 
-	categories, err := query.All(ctx)
+	categories, err := query.WithTranslations().All(ctx)
 	if err != nil {
-		s.log.Error("failed to list categories", slog.String("error", err.Error()))
-		return nil, 0, err
+		s.logger(ctx).Error("failed to list categories", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
 
 	result := make([]*entity.Category, 0, len(categories))
 	for _, c := range categories {
-		result = append(result, entity.MakeStorageCategoryToEntity(c))
+		result = append(result, entity.MakeStorageCategoryToEntity(c, locale.FromContext(ctx)))
 	}
 
 	return result, total, nil
@@ -100,19 +125,177 @@ func (s *storage) UpdateCategory(ctx context.Context, req *entity.UpdateRequest)
 		SetNillableDescription(req.Description).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update category", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update category", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageCategoryToEntity(category), nil
+	return entity.MakeStorageCategoryToEntity(category, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) DeleteCategory(ctx context.Context, id int) error {
 	err := s.client.Category.DeleteOneID(id).Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete category", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete category", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	return nil
 }
+
+func (s *storage) ListTranslations(ctx context.Context, id int) ([]*entity.Translation, error) {
+	category, err := s.client.Category.Get(ctx, id)
+	if err != nil {
+		s.logger(ctx).Error("failed to get category", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	translations, err := category.QueryTranslations().
+		Order(ent.Asc(categorytranslation.FieldLocale)).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to list category translations", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	result := make([]*entity.Translation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, entity.MakeStorageTranslationToEntity(t))
+	}
+
+	return result, nil
+}
+
+func (s *storage) SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.Translation, error) {
+	// Not found rather than a foreign key conflict for a missing category
+	if _, err := s.client.Category.Get(ctx, req.ID); err != nil {
+		s.logger(ctx).Error("failed to get category", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	existing, err := s.client.CategoryTranslation.Query().
+		Where(
+			categorytranslation.CategoryID(req.ID),
+			categorytranslation.LocaleEQ(categorytranslation.Locale(req.Locale.String())),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger(ctx).Error("failed to get category translation", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	var translation *ent.CategoryTranslation
+	if existing == nil {
+		translation, err = s.client.CategoryTranslation.Create().
+			SetCategoryID(req.ID).
+			SetLocale(categorytranslation.Locale(req.Locale.String())).
+			SetName(req.Name).
+			SetDescription(req.Description).
+			Save(ctx)
+	} else {
+		translation, err = existing.Update().
+			SetName(req.Name).
+			SetDescription(req.Description).
+			Save(ctx)
+	}
+	if err != nil {
+		s.logger(ctx).Error("failed to save category translation", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageTranslationToEntity(translation), nil
+}
+
+func (s *storage) DeleteTranslation(ctx context.Context, id int, loc locale.Locale) error {
+	translation, err := s.client.CategoryTranslation.Query().
+		Where(
+			categorytranslation.CategoryID(id),
+			categorytranslation.LocaleEQ(categorytranslation.Locale(loc.String())),
+		).
+		Only(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get category translation", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	err = s.client.CategoryTranslation.DeleteOne(translation).Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete category translation", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+// ListMissingTranslations pages through the categories with untranslated
+// fields one locale after another, so a category missing both locales is
+// listed under each.
+func (s *storage) ListMissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) ([]*entity.MissingTranslation, int, error) {
+	locales := locale.Translated
+	if req.Locale != "" {
+		locales = []locale.Locale{req.Locale}
+	}
+
+	missing := make([]*entity.MissingTranslation, 0)
+	total, offset := 0, req.Offset
+	for _, loc := range locales {
+		query := s.client.Category.Query().
+			Where(missingTranslation(loc))
+
+		count, err := query.Clone().Count(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to count categories missing translations", slog.String("error", err.Error()))
+			return nil, 0, dberr.Tag(err)
+		}
+		total += count
+
+		// Skip locales the page starts after or that it has no room left for
+		if offset >= count {
+			offset -= count
+			continue
+		}
+		if req.Limit > 0 && len(missing) == req.Limit {
+			continue
+		}
+
+		query = query.
+			WithTranslations(func(q *ent.CategoryTranslationQuery) {
+				q.Where(categorytranslation.LocaleEQ(categorytranslation.Locale(loc.String())))
+			}).
+			Order(ent.Asc(category.FieldID)).
+			Offset(offset)
+		if req.Limit > 0 {
+			query = query.Limit(req.Limit - len(missing))
+		}
+		offset = 0
+
+		categories, err := query.All(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to list categories missing translations", slog.String("error", err.Error()))
+			return nil, 0, dberr.Tag(err)
+		}
+
+		for _, c := range categories {
+			if m := entity.MakeStorageMissingTranslationToEntity(c, loc); m != nil {
+				missing = append(missing, m)
+			}
+		}
+	}
+
+	return missing, total, nil
+}
+
+// missingTranslation passes categories with a field that has text but none in
+// the loc translation. A NULL field never equals, so it counts as empty.
+func missingTranslation(loc locale.Locale) predicate.Category {
+	translated := func(field predicate.CategoryTranslation) predicate.Category {
+		return category.HasTranslationsWith(
+			categorytranslation.LocaleEQ(categorytranslation.Locale(loc.String())),
+			field,
+		)
+	}
+
+	return category.Or(
+		category.And(category.NameNEQ(""), category.Not(translated(categorytranslation.NameNEQ("")))),
+		category.And(category.DescriptionNEQ(""), category.Not(translated(categorytranslation.DescriptionNEQ("")))),
+	)
+}
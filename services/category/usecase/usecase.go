@@ -7,6 +7,9 @@ import (
 	"github.com/citizenkz/core/config"
 	"github.com/citizenkz/core/services/category/entity"
 	"github.com/citizenkz/core/services/category/storage"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 type usecase struct {
@@ -21,6 +24,12 @@ type UseCase interface {
 	List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error)
 	Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error)
 	Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error)
+	ListTranslations(ctx context.Context, req *entity.ListTranslationsRequest) (*entity.ListTranslationsResponse, error)
+	SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.SaveTranslationResponse, error)
+	DeleteTranslation(ctx context.Context, req *entity.DeleteTranslationRequest) (*entity.DeleteTranslationResponse, error)
+	// MissingTranslations reports the categories with fields not yet
+	// translated, for editors to work through.
+	MissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) (*entity.MissingTranslationsResponse, error)
 }
 
 func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase {
@@ -31,11 +40,19 @@ func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (u *usecase) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, u.log)
+}
+
 func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entity.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "category.Create")
+	defer span.End()
+
 	category, err := u.storage.CreateCategory(ctx, req)
 	if err != nil {
-		u.log.Error("failed to create category", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to create category", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "category not found")
 	}
 
 	return &entity.CreateResponse{
@@ -44,10 +61,13 @@ func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entit
 }
 
 func (u *usecase) Get(ctx context.Context, req *entity.GetRequest) (*entity.GetResponse, error) {
+	ctx, span := tracing.Start(ctx, "category.Get")
+	defer span.End()
+
 	category, err := u.storage.GetCategory(ctx, req.ID)
 	if err != nil {
-		u.log.Error("failed to get category", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to get category", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "category not found")
 	}
 
 	return &entity.GetResponse{
@@ -56,10 +76,13 @@ func (u *usecase) Get(ctx context.Context, req *entity.GetRequest) (*entity.GetR
 }
 
 func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error) {
+	ctx, span := tracing.Start(ctx, "category.List")
+	defer span.End()
+
 	categories, total, err := u.storage.ListCategories(ctx, req)
 	if err != nil {
-		u.log.Error("failed to list categories", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to list categories", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "category not found")
 	}
 
 	return &entity.ListResponse{
@@ -69,10 +92,13 @@ func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.Li
 }
 
 func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error) {
+	ctx, span := tracing.Start(ctx, "category.Update")
+	defer span.End()
+
 	category, err := u.storage.UpdateCategory(ctx, req)
 	if err != nil {
-		u.log.Error("failed to update category", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to update category", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "category not found")
 	}
 
 	return &entity.UpdateResponse{
@@ -81,10 +107,13 @@ func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entit
 }
 
 func (u *usecase) Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "category.Delete")
+	defer span.End()
+
 	err := u.storage.DeleteCategory(ctx, req.ID)
 	if err != nil {
-		u.log.Error("failed to delete category", slog.String("error", err.Error()))
-		return nil, err
+		u.logger(ctx).Error("failed to delete category", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "category not found")
 	}
 
 	return &entity.DeleteResponse{
@@ -0,0 +1,13 @@
+package entity
+
+import benefitEntity "github.com/citizenkz/core/services/benefit/entity"
+
+type BenefitsRequest struct {
+	ID    int
+	Token string
+}
+
+type BenefitsResponse struct {
+	Benefits []*benefitEntity.BenefitWithFilters `json:"benefits"`
+	Total    int                                 `json:"total"`
+}
@@ -7,7 +7,7 @@ type SaveFiltersRequest struct {
 }
 
 type FilterValueRequest struct {
-	FilterID int    `json:"filter_id"`
+	FilterID int    `json:"filter_id" validate:"required"`
 	Value    string `json:"value"`
 }
 
@@ -4,9 +4,9 @@ import "time"
 
 type UpdateRequest struct {
 	ID        int
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	BirthDate time.Time `json:"birth_date"`
+	FirstName string    `json:"first_name" validate:"required,max=100"`
+	LastName  string    `json:"last_name" validate:"required,max=100"`
+	BirthDate time.Time `json:"birth_date" validate:"required,past"`
 	Token     string
 }
 
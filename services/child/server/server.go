@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"github.com/citizenkz/core/services/child/usecase"
 	"github.com/citizenkz/core/utils/json"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/logger"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -24,6 +26,7 @@ type Server interface {
 	HandleUpdate(w http.ResponseWriter, r *http.Request)
 	HandleDelete(w http.ResponseWriter, r *http.Request)
 	HandleSaveFilters(w http.ResponseWriter, r *http.Request)
+	HandleBenefits(w http.ResponseWriter, r *http.Request)
 }
 
 func New(log *slog.Logger, usecase usecase.UseCase) Server {
@@ -33,18 +36,23 @@ func New(log *slog.Logger, usecase usecase.UseCase) Server {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *server) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *server) HandleCreate(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 
 	req := &entity.CreateRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -52,13 +60,13 @@ func (s *server) HandleCreate(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Create(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Create", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Create", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -67,7 +75,7 @@ func (s *server) HandleCreate(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
@@ -75,7 +83,7 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -87,13 +95,13 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Get(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Get", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Get", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -102,15 +110,15 @@ func (s *server) HandleGet(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleList(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 
 	req := &entity.ListRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -118,13 +126,13 @@ func (s *server) HandleList(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.List(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.List", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.List", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -133,7 +141,7 @@ func (s *server) HandleList(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
@@ -141,15 +149,15 @@ func (s *server) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
 
 	req := &entity.UpdateRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -158,13 +166,13 @@ func (s *server) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Update(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Update", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Update", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -173,7 +181,7 @@ func (s *server) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
@@ -181,7 +189,7 @@ func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -193,13 +201,13 @@ func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Delete(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Delete", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Delete", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -208,15 +216,15 @@ func (s *server) HandleDelete(w http.ResponseWriter, r *http.Request) {
 func (s *server) HandleSaveFilters(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 
 	req := &entity.SaveFiltersRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -224,13 +232,48 @@ func (s *server) HandleSaveFilters(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.SaveFilters(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.SaveFilters", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.SaveFilters", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleBenefits(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req := &entity.BenefitsRequest{
+		ID:    id,
+		Token: token,
+	}
+
+	resp, err := s.usecase.Benefits(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.Benefits", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	if err := json.WriteJSON(w, http.StatusOK, resp); err != nil {
-		s.log.Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
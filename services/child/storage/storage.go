@@ -7,7 +7,12 @@ import (
 	"github.com/citizenkz/core/ent"
 	"github.com/citizenkz/core/ent/child"
 	"github.com/citizenkz/core/ent/childfilter"
+	"github.com/citizenkz/core/ent/filter"
 	"github.com/citizenkz/core/services/child/entity"
+	"github.com/citizenkz/core/services/eligibility"
+	"github.com/citizenkz/core/services/filter/consts"
+	"github.com/citizenkz/core/utils/dberr"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 type storage struct {
@@ -23,6 +28,7 @@ type Storage interface {
 	DeleteChild(ctx context.Context, userID, childID int) error
 	SaveChildFilters(ctx context.Context, childID int, filters []entity.FilterValueRequest) error
 	GetChildFilters(ctx context.Context, childID int) ([]*entity.ChildFilter, error)
+	GetChildCriteria(ctx context.Context, userID, childID int) ([]eligibility.Criterion, error)
 }
 
 func New(client *ent.Client, log *slog.Logger) Storage {
@@ -32,6 +38,11 @@ func New(client *ent.Client, log *slog.Logger) Storage {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *storage) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *storage) CreateChild(ctx context.Context, userID int, req *entity.CreateRequest) (*entity.Child, error) {
 	c, err := s.client.Child.
 		Create().
@@ -41,8 +52,8 @@ func (s *storage) CreateChild(ctx context.Context, userID int, req *entity.Creat
 		SetUserID(userID).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to create child", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to create child", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return &entity.Child{
@@ -61,8 +72,8 @@ func (s *storage) GetChild(ctx context.Context, userID, childID int) (*entity.Ch
 		Where(child.ID(childID), child.UserID(userID)).
 		Only(ctx)
 	if err != nil {
-		s.log.Error("failed to get child", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get child", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return &entity.Child{
@@ -83,8 +94,8 @@ func (s *storage) ListChildren(ctx context.Context, userID int, req *entity.List
 	// Get total count
 	total, err := query.Count(ctx)
 	if err != nil {
-		s.log.Error("failed to count children", slog.String("error", err.Error()))
-		return nil, 0, err
+		s.logger(ctx).Error("failed to count children", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
 
 	// Apply pagination
@@ -93,8 +104,8 @@ func (s *storage) ListChildren(ctx context.Context, userID int, req *entity.List
 		Offset(req.Offset).
 		All(ctx)
 	if err != nil {
-		s.log.Error("failed to list children", slog.String("error", err.Error()))
-		return nil, 0, err
+		s.logger(ctx).Error("failed to list children", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
 	}
 
 	result := make([]*entity.Child, len(children))
@@ -121,8 +132,8 @@ func (s *storage) UpdateChild(ctx context.Context, userID int, req *entity.Updat
 		SetBirthDate(req.BirthDate).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update child", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update child", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	return &entity.Child{
@@ -142,8 +153,8 @@ func (s *storage) DeleteChild(ctx context.Context, userID, childID int) error {
 		Where(childfilter.ChildID(childID)).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete child filters", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete child filters", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	// Then delete the child
@@ -151,22 +162,35 @@ func (s *storage) DeleteChild(ctx context.Context, userID, childID int) error {
 		DeleteOneID(childID).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete child", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete child", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	return nil
 }
 
 func (s *storage) SaveChildFilters(ctx context.Context, childID int, filters []entity.FilterValueRequest) error {
+	// Reject values that can't be compared before touching existing filters
+	for _, f := range filters {
+		definition, err := s.client.Filter.Get(ctx, f.FilterID)
+		if err != nil {
+			s.logger(ctx).Error("failed to get filter", slog.String("error", err.Error()))
+			return dberr.Tag(err)
+		}
+		if err := eligibility.ValidateValue(consts.FilterType(definition.Type), definition.Values, f.Value); err != nil {
+			s.logger(ctx).Error("failed to validate child filter", slog.String("error", err.Error()))
+			return dberr.Tag(err)
+		}
+	}
+
 	// Delete existing filters for this child
 	_, err := s.client.ChildFilter.
 		Delete().
 		Where(childfilter.ChildID(childID)).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete existing child filters", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete existing child filters", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	// Create new filters
@@ -178,8 +202,8 @@ func (s *storage) SaveChildFilters(ctx context.Context, childID int, filters []e
 			SetValue(f.Value).
 			Save(ctx)
 		if err != nil {
-			s.log.Error("failed to create child filter", slog.String("error", err.Error()))
-			return err
+			s.logger(ctx).Error("failed to create child filter", slog.String("error", err.Error()))
+			return dberr.Tag(err)
 		}
 	}
 
@@ -192,8 +216,8 @@ func (s *storage) GetChildFilters(ctx context.Context, childID int) ([]*entity.C
 		Where(childfilter.ChildID(childID)).
 		All(ctx)
 	if err != nil {
-		s.log.Error("failed to get child filters", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get child filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	result := make([]*entity.ChildFilter, len(filters))
@@ -208,3 +232,47 @@ func (s *storage) GetChildFilters(ctx context.Context, childID int) ([]*entity.C
 
 	return result, nil
 }
+
+// GetChildCriteria builds eligibility criteria from the child's saved filter
+// values. Age filters are always answered from the child's birth date.
+func (s *storage) GetChildCriteria(ctx context.Context, userID, childID int) ([]eligibility.Criterion, error) {
+	c, err := s.client.Child.
+		Query().
+		Where(child.ID(childID), child.UserID(userID)).
+		WithChildFilters().
+		Only(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get child", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	ids, err := s.client.Filter.
+		Query().
+		Where(filter.IsAge(true)).
+		IDs(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get age filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	ageFilterIDs := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		ageFilterIDs[id] = true
+	}
+
+	criteria := make([]eligibility.Criterion, 0, len(c.Edges.ChildFilters)+len(ageFilterIDs))
+	for _, cf := range c.Edges.ChildFilters {
+		if ageFilterIDs[cf.FilterID] {
+			continue
+		}
+		value := cf.Value
+		criteria = append(criteria, eligibility.Criterion{
+			FilterID: cf.FilterID,
+			Value:    &value,
+		})
+	}
+
+	criteria = append(criteria, eligibility.AgeCriteria(c.BirthDate, ageFilterIDs)...)
+
+	return criteria, nil
+}
@@ -2,19 +2,24 @@ package usecase
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 
 	"github.com/citizenkz/core/config"
+	benefitStorage "github.com/citizenkz/core/services/benefit/storage"
 	"github.com/citizenkz/core/services/child/entity"
 	"github.com/citizenkz/core/services/child/storage"
+	"github.com/citizenkz/core/utils/apperr"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 type usecase struct {
-	log     *slog.Logger
-	storage storage.Storage
-	cfg     *config.Config
+	log            *slog.Logger
+	storage        storage.Storage
+	benefitStorage benefitStorage.Storage
+	cfg            *config.Config
 }
 
 type UseCase interface {
@@ -24,27 +29,37 @@ type UseCase interface {
 	Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error)
 	Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error)
 	SaveFilters(ctx context.Context, req *entity.SaveFiltersRequest) (*entity.SaveFiltersResponse, error)
+	Benefits(ctx context.Context, req *entity.BenefitsRequest) (*entity.BenefitsResponse, error)
 }
 
-func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase {
+func New(log *slog.Logger, storage storage.Storage, benefitStorage benefitStorage.Storage, cfg *config.Config) UseCase {
 	return &usecase{
-		log:     log,
-		storage: storage,
-		cfg:     cfg,
+		log:            log,
+		storage:        storage,
+		benefitStorage: benefitStorage,
+		cfg:            cfg,
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (u *usecase) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, u.log)
+}
+
 func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entity.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.Create")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	child, err := u.storage.CreateChild(ctx, userID, req)
 	if err != nil {
-		u.log.Error("failed to storage.CreateChild", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.CreateChild: %w", err)
+		u.logger(ctx).Error("failed to storage.CreateChild", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "user not found")
 	}
 
 	return &entity.CreateResponse{
@@ -53,16 +68,19 @@ func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entit
 }
 
 func (u *usecase) Get(ctx context.Context, req *entity.GetRequest) (*entity.GetResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.Get")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	child, err := u.storage.GetChild(ctx, userID, req.ID)
 	if err != nil {
-		u.log.Error("failed to storage.GetChild", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.GetChild: %w", err)
+		u.logger(ctx).Error("failed to storage.GetChild", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
 	}
 
 	return &entity.GetResponse{
@@ -71,16 +89,19 @@ func (u *usecase) Get(ctx context.Context, req *entity.GetRequest) (*entity.GetR
 }
 
 func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.List")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	children, total, err := u.storage.ListChildren(ctx, userID, req)
 	if err != nil {
-		u.log.Error("failed to storage.ListChildren", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.ListChildren: %w", err)
+		u.logger(ctx).Error("failed to storage.ListChildren", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
 	}
 
 	return &entity.ListResponse{
@@ -90,16 +111,19 @@ func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.Li
 }
 
 func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entity.UpdateResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.Update")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	child, err := u.storage.UpdateChild(ctx, userID, req)
 	if err != nil {
-		u.log.Error("failed to storage.UpdateChild", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.UpdateChild: %w", err)
+		u.logger(ctx).Error("failed to storage.UpdateChild", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
 	}
 
 	return &entity.UpdateResponse{
@@ -108,16 +132,19 @@ func (u *usecase) Update(ctx context.Context, req *entity.UpdateRequest) (*entit
 }
 
 func (u *usecase) Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.Delete")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	err = u.storage.DeleteChild(ctx, userID, req.ID)
 	if err != nil {
-		u.log.Error("failed to storage.DeleteChild", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.DeleteChild: %w", err)
+		u.logger(ctx).Error("failed to storage.DeleteChild", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
 	}
 
 	return &entity.DeleteResponse{
@@ -126,26 +153,58 @@ func (u *usecase) Delete(ctx context.Context, req *entity.DeleteRequest) (*entit
 }
 
 func (u *usecase) SaveFilters(ctx context.Context, req *entity.SaveFiltersRequest) (*entity.SaveFiltersResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.SaveFilters")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to jwt.ParseUserID: %w", err)
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 
 	// Verify the child belongs to the user
 	_, err = u.storage.GetChild(ctx, userID, req.ChildID)
 	if err != nil {
-		u.log.Error("failed to storage.GetChild", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.GetChild: %w", err)
+		u.logger(ctx).Error("failed to storage.GetChild", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
 	}
 
 	err = u.storage.SaveChildFilters(ctx, req.ChildID, req.Filters)
 	if err != nil {
-		u.log.Error("failed to storage.SaveChildFilters", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.SaveChildFilters: %w", err)
+		u.logger(ctx).Error("failed to storage.SaveChildFilters", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
 	}
 
 	return &entity.SaveFiltersResponse{
 		Success: true,
 	}, nil
 }
+
+func (u *usecase) Benefits(ctx context.Context, req *entity.BenefitsRequest) (*entity.BenefitsResponse, error) {
+	ctx, span := tracing.Start(ctx, "child.Benefits")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	criteria, err := u.storage.GetChildCriteria(ctx, userID, req.ID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.GetChildCriteria", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "child not found")
+	}
+
+	benefits, err := u.benefitStorage.ListEligibleBenefits(ctx, criteria)
+	if err != nil {
+		u.logger(ctx).Error("failed to benefitStorage.ListEligibleBenefits", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+	metrics.EligibilityChecks.WithLabelValues(metrics.SubjectChild).Inc()
+
+	return &entity.BenefitsResponse{
+		Benefits: benefits,
+		Total:    len(benefits),
+	}, nil
+}
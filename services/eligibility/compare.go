@@ -0,0 +1,134 @@
+package eligibility
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/citizenkz/core/services/filter/consts"
+	"github.com/citizenkz/core/utils/apperr"
+)
+
+// ErrInvalidValue is a validation error, so a bad filter value is answered
+// with 422.
+var ErrInvalidValue = apperr.Validation("invalid filter value")
+
+var dateLayouts = []string{time.RFC3339, time.DateOnly}
+
+// numberSyntax is the decimal notation PostgreSQL casts to NUMERIC, so the
+// benefit query compares the same numbers. strconv alone would also take hex
+// and underscores.
+var numberSyntax = regexp.MustCompile(`^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?\s*$`)
+
+// Compare compares a and b according to the filter type and returns -1, 0
+// or +1. NUMBER_RANGE values are compared as numbers, DATE_RANGE values as
+// calendar dates in UTC and STRING_RANGE values lexically.
+func Compare(filterType consts.FilterType, a, b string) (int, error) {
+	switch filterType {
+	case consts.NumberRange:
+		x, err := parseNumber(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := parseNumber(b)
+		if err != nil {
+			return 0, err
+		}
+		return cmp.Compare(x, y), nil
+	case consts.DateRange:
+		x, err := ParseDate(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := ParseDate(b)
+		if err != nil {
+			return 0, err
+		}
+		return x.Compare(y), nil
+	default:
+		return strings.Compare(a, b), nil
+	}
+}
+
+// ValidateValue checks that a single value can be compared under the filter
+// type. STRING_RANGE values must be one of the filter's values when it has any.
+func ValidateValue(filterType consts.FilterType, allowed []string, value string) error {
+	switch filterType {
+	case consts.NumberRange:
+		_, err := parseNumber(value)
+		return err
+	case consts.DateRange:
+		_, err := ParseDate(value)
+		return err
+	default:
+		if len(allowed) > 0 && !slices.Contains(allowed, value) {
+			return fmt.Errorf("%w: %q is not one of %v", ErrInvalidValue, value, allowed)
+		}
+		return nil
+	}
+}
+
+// ValidateBounds checks a benefit filter definition, which is either a single
+// value or a from/to range with at least one bound and from not after to.
+func ValidateBounds(filterType consts.FilterType, allowed []string, value, from, to *string) error {
+	if value != nil {
+		return ValidateValue(filterType, allowed, *value)
+	}
+
+	if from == nil && to == nil {
+		return fmt.Errorf("%w: value or from/to is required", ErrInvalidValue)
+	}
+
+	for _, bound := range []*string{from, to} {
+		if bound == nil {
+			continue
+		}
+		if _, err := Compare(filterType, *bound, *bound); err != nil {
+			return err
+		}
+	}
+
+	if from != nil && to != nil {
+		c, err := Compare(filterType, *from, *to)
+		if err != nil {
+			return err
+		}
+		if c > 0 {
+			return fmt.Errorf("%w: from %q is greater than to %q", ErrInvalidValue, *from, *to)
+		}
+	}
+
+	return nil
+}
+
+func parseNumber(value string) (float64, error) {
+	if !numberSyntax.MatchString(value) {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrInvalidValue, value)
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrInvalidValue, value)
+	}
+
+	return n, nil
+}
+
+// ParseDate parses a DATE_RANGE value and truncates it to its UTC date. Year
+// 0, which PostgreSQL has no date for, is rejected.
+func ParseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil && t.UTC().Year() > 0 {
+			t = t.UTC()
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q is not a date", ErrInvalidValue, value)
+}
@@ -0,0 +1,125 @@
+package eligibility
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/filter/consts"
+)
+
+// Criterion is a value or a range supplied for a single filter, either by
+// the client directly or derived from a saved user or child profile.
+type Criterion struct {
+	FilterID int
+	Value    *string
+	From     *string
+	To       *string
+}
+
+// Matches checks if a benefit's filters match the criteria.
+// A benefit matches if, for every criterion:
+// - It doesn't have a filter record for the criterion's filter ID (passes through), OR
+// - It has a filter record and the values match
+func Matches(benefitFilters []*ent.BenefitFilter, criteria []Criterion) bool {
+	for i := range criteria {
+		hasFilter := false
+		matchesValue := false
+
+		for _, bf := range benefitFilters {
+			if bf.FilterID != criteria[i].FilterID {
+				continue
+			}
+			hasFilter = true
+			if valuesMatch(bf, &criteria[i]) {
+				matchesValue = true
+				break
+			}
+		}
+
+		if hasFilter && !matchesValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valuesMatch compares benefit filter values with a criterion using the
+// comparison semantics of the filter's type.
+func valuesMatch(bf *ent.BenefitFilter, criterion *Criterion) bool {
+	filterType := consts.StringRange
+	if bf.Edges.Filter != nil {
+		filterType = consts.FilterType(bf.Edges.Filter.Type)
+	}
+
+	lessOrEqual := func(a, b string) bool {
+		c, err := Compare(filterType, a, b)
+		return err == nil && c <= 0
+	}
+
+	// For single value criteria
+	if criterion.Value != nil {
+		if bf.Value != nil {
+			c, err := Compare(filterType, *bf.Value, *criterion.Value)
+			return err == nil && c == 0
+		}
+		// A single value falls inside the benefit's range bounds
+		if bf.From == nil && bf.To == nil {
+			return false
+		}
+		if bf.From != nil && !lessOrEqual(*bf.From, *criterion.Value) {
+			return false
+		}
+		if bf.To != nil && !lessOrEqual(*criterion.Value, *bf.To) {
+			return false
+		}
+		return true
+	}
+
+	// For range criteria (from/to), an open bound on either side overlaps
+	if criterion.From != nil || criterion.To != nil {
+		if bf.From == nil && bf.To == nil {
+			return false
+		}
+		if criterion.From != nil && bf.To != nil && !lessOrEqual(*criterion.From, *bf.To) {
+			return false
+		}
+		if criterion.To != nil && bf.From != nil && !lessOrEqual(*bf.From, *criterion.To) {
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+// Age returns the number of full years between birthDate and now.
+func Age(birthDate, now time.Time) int {
+	age := now.Year() - birthDate.Year()
+	if now.Month() < birthDate.Month() ||
+		(now.Month() == birthDate.Month() && now.Day() < birthDate.Day()) {
+		age--
+	}
+	if age < 0 {
+		return 0
+	}
+
+	return age
+}
+
+// AgeCriteria answers every age filter with the age derived from birthDate.
+func AgeCriteria(birthDate time.Time, ageFilterIDs map[int]bool) []Criterion {
+	age := strconv.Itoa(Age(birthDate, time.Now()))
+
+	criteria := make([]Criterion, 0, len(ageFilterIDs))
+	for filterID := range ageFilterIDs {
+		value := age
+		criteria = append(criteria, Criterion{
+			FilterID: filterID,
+			Value:    &value,
+		})
+	}
+
+	return criteria
+}
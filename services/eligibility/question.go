@@ -0,0 +1,137 @@
+package eligibility
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/filter/consts"
+)
+
+// Question is the unanswered filter that narrows the candidates the most.
+type Question struct {
+	FilterID          int
+	Candidates        int
+	ExpectedRemaining float64
+}
+
+// NextQuestion picks the unanswered filter whose answer is expected to leave
+// the fewest candidate benefits. Each filter is scored by trying every
+// plausible answer, weighted equally, against the candidates. It returns nil
+// when no unanswered filter can rule out a candidate.
+func NextQuestion(candidates []*ent.Benefit, criteria []Criterion) *Question {
+	answered := make(map[int]bool, len(criteria))
+	for _, c := range criteria {
+		answered[c.FilterID] = true
+	}
+
+	filters := make(map[int]*ent.Filter)
+	order := make([]int, 0)
+	for _, b := range candidates {
+		for _, bf := range b.Edges.BenefitFilters {
+			if answered[bf.FilterID] || bf.Edges.Filter == nil {
+				continue
+			}
+			if _, ok := filters[bf.FilterID]; !ok {
+				filters[bf.FilterID] = bf.Edges.Filter
+				order = append(order, bf.FilterID)
+			}
+		}
+	}
+
+	var best *Question
+	for _, filterID := range order {
+		answers := plausibleAnswers(filters[filterID], candidates)
+		if len(answers) == 0 {
+			continue
+		}
+
+		remaining := 0
+		for _, answer := range answers {
+			value := answer
+			trial := append(slices.Clone(criteria), Criterion{FilterID: filterID, Value: &value})
+			for _, b := range candidates {
+				if Evaluate(b, trial) {
+					remaining++
+				}
+			}
+		}
+
+		expected := float64(remaining) / float64(len(answers))
+		if expected >= float64(len(candidates)) {
+			continue
+		}
+		if best == nil || expected < best.ExpectedRemaining {
+			best = &Question{
+				FilterID:          filterID,
+				Candidates:        len(candidates),
+				ExpectedRemaining: expected,
+			}
+		}
+	}
+
+	return best
+}
+
+// plausibleAnswers lists the answers worth trying for a filter: its values
+// for STRING_RANGE, and every bound the candidates use plus the points just
+// outside them for NUMBER_RANGE and DATE_RANGE.
+func plausibleAnswers(filter *ent.Filter, candidates []*ent.Benefit) []string {
+	filterType := consts.FilterType(filter.Type)
+	if filterType == consts.StringRange && len(filter.Values) > 0 {
+		return filter.Values
+	}
+
+	answers := make([]string, 0)
+	add := func(value string) {
+		if !slices.Contains(answers, value) {
+			answers = append(answers, value)
+		}
+	}
+
+	for _, b := range candidates {
+		for _, bf := range b.Edges.BenefitFilters {
+			if bf.FilterID != filter.ID {
+				continue
+			}
+			if bf.Value != nil {
+				add(*bf.Value)
+			}
+			if bf.From != nil {
+				add(*bf.From)
+				if below, ok := step(filterType, *bf.From, -1); ok {
+					add(below)
+				}
+			}
+			if bf.To != nil {
+				add(*bf.To)
+				if above, ok := step(filterType, *bf.To, 1); ok {
+					add(above)
+				}
+			}
+		}
+	}
+
+	return answers
+}
+
+// step moves a range bound one unit (a number or a day) in the direction.
+func step(filterType consts.FilterType, value string, direction int) (string, bool) {
+	switch filterType {
+	case consts.NumberRange:
+		n, err := parseNumber(value)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatFloat(n+float64(direction), 'f', -1, 64), true
+	case consts.DateRange:
+		t, err := ParseDate(value)
+		if err != nil {
+			return "", false
+		}
+		return t.AddDate(0, 0, direction).Format(time.DateOnly), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,102 @@
+package eligibility
+
+import (
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+type Status string
+
+const (
+	// StatusMatched means the supplied value satisfies the condition.
+	StatusMatched Status = "MATCHED"
+	// StatusMissing means no value was supplied for the condition's filter.
+	StatusMissing Status = "MISSING"
+	// StatusFailed means the supplied value doesn't satisfy the condition.
+	StatusFailed Status = "FAILED"
+)
+
+type (
+	// Condition explains a single benefit filter against the supplied criteria.
+	Condition struct {
+		FilterID      int     `json:"filter_id"`
+		RuleGroupID   *int    `json:"rule_group_id,omitempty"`
+		FilterName    string  `json:"filter_name,omitempty"`
+		FilterType    string  `json:"filter_type,omitempty"`
+		Status        Status  `json:"status"`
+		ExpectedValue *string `json:"expected_value,omitempty"`
+		ExpectedFrom  *string `json:"expected_from,omitempty"`
+		ExpectedTo    *string `json:"expected_to,omitempty"`
+		ProvidedValue *string `json:"provided_value,omitempty"`
+		ProvidedFrom  *string `json:"provided_from,omitempty"`
+		ProvidedTo    *string `json:"provided_to,omitempty"`
+	}
+
+	// EligibilityReport lists every condition of a benefit and whether the
+	// criteria met it. Eligible agrees with Evaluate for the same input.
+	EligibilityReport struct {
+		Eligible   bool        `json:"eligible"`
+		Conditions []Condition `json:"conditions"`
+	}
+)
+
+// Explain builds an EligibilityReport for a benefit with filter names in loc.
+// Its filters with their filter edges and its rule groups must be loaded, and
+// the filters' translations to name them in anything but the default locale.
+func Explain(benefit *ent.Benefit, criteria []Criterion, loc locale.Locale) *EligibilityReport {
+	benefitFilters := benefit.Edges.BenefitFilters
+
+	byFilter := make(map[int]*Criterion, len(criteria))
+	for i := range criteria {
+		byFilter[criteria[i].FilterID] = &criteria[i]
+	}
+
+	report := &EligibilityReport{
+		Eligible:   Evaluate(benefit, criteria),
+		Conditions: make([]Condition, 0, len(benefitFilters)),
+	}
+
+	for _, bf := range benefitFilters {
+		condition := Condition{
+			FilterID:      bf.FilterID,
+			RuleGroupID:   bf.RuleGroupID,
+			Status:        StatusMissing,
+			ExpectedValue: bf.Value,
+			ExpectedFrom:  bf.From,
+			ExpectedTo:    bf.To,
+		}
+		if bf.Edges.Filter != nil {
+			condition.FilterName = filterName(bf.Edges.Filter, loc)
+			condition.FilterType = bf.Edges.Filter.Type.String()
+		}
+
+		if criterion, ok := byFilter[bf.FilterID]; ok {
+			condition.ProvidedValue = criterion.Value
+			condition.ProvidedFrom = criterion.From
+			condition.ProvidedTo = criterion.To
+			condition.Status = StatusFailed
+			if valuesMatch(bf, criterion) {
+				condition.Status = StatusMatched
+			}
+		}
+
+		report.Conditions = append(report.Conditions, condition)
+	}
+
+	return report
+}
+
+// filterName returns the name of filter in loc.
+func filterName(filter *ent.Filter, loc locale.Locale) string {
+	name := filter.Name
+	overlays := locale.Overlays(loc, filter.Edges.Translations, func(t *ent.FilterTranslation) locale.Locale {
+		return locale.Locale(t.Locale)
+	})
+	for _, t := range overlays {
+		if t.Name != "" {
+			name = t.Name
+		}
+	}
+
+	return name
+}
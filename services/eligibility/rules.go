@@ -0,0 +1,124 @@
+package eligibility
+
+import (
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/benefit/consts"
+)
+
+// outcome is the three-valued result of a condition. A condition without a
+// supplied value is missing rather than failed, so it never excludes a
+// benefit on its own.
+type outcome int
+
+const (
+	failed outcome = iota
+	missing
+	matched
+)
+
+// Evaluate checks if a benefit is eligible for the criteria. Filters outside
+// rule groups are matched as by Matches and ANDed with every root rule group.
+// The benefit's filters and rule groups must be loaded.
+func Evaluate(benefit *ent.Benefit, criteria []Criterion) bool {
+	byFilter := make(map[int]*Criterion, len(criteria))
+	for i := range criteria {
+		byFilter[criteria[i].FilterID] = &criteria[i]
+	}
+
+	ungrouped := make([]*ent.BenefitFilter, 0, len(benefit.Edges.BenefitFilters))
+	conditions := make(map[int][]*ent.BenefitFilter)
+	for _, bf := range benefit.Edges.BenefitFilters {
+		if bf.RuleGroupID == nil {
+			ungrouped = append(ungrouped, bf)
+			continue
+		}
+		conditions[*bf.RuleGroupID] = append(conditions[*bf.RuleGroupID], bf)
+	}
+
+	if !Matches(ungrouped, criteria) {
+		return false
+	}
+
+	children := make(map[int][]*ent.RuleGroup)
+	roots := make([]*ent.RuleGroup, 0)
+	for _, group := range benefit.Edges.RuleGroups {
+		if group.ParentID == nil {
+			roots = append(roots, group)
+			continue
+		}
+		children[*group.ParentID] = append(children[*group.ParentID], group)
+	}
+
+	for _, root := range roots {
+		if evaluateGroup(root, children, conditions, byFilter) == failed {
+			return false
+		}
+	}
+
+	return true
+}
+
+func evaluateGroup(group *ent.RuleGroup, children map[int][]*ent.RuleGroup, conditions map[int][]*ent.BenefitFilter, byFilter map[int]*Criterion) outcome {
+	outcomes := make([]outcome, 0, len(children[group.ID])+len(conditions[group.ID]))
+	for _, bf := range conditions[group.ID] {
+		outcomes = append(outcomes, evaluateCondition(bf, byFilter))
+	}
+	for _, child := range children[group.ID] {
+		outcomes = append(outcomes, evaluateGroup(child, children, conditions, byFilter))
+	}
+
+	switch consts.Operator(group.Operator) {
+	case consts.Or:
+		return anyOf(outcomes)
+	case consts.Not:
+		// NOT negates the conjunction of its members
+		switch allOf(outcomes) {
+		case matched:
+			return failed
+		case failed:
+			return matched
+		default:
+			return missing
+		}
+	default:
+		return allOf(outcomes)
+	}
+}
+
+func evaluateCondition(bf *ent.BenefitFilter, byFilter map[int]*Criterion) outcome {
+	criterion, ok := byFilter[bf.FilterID]
+	if !ok {
+		return missing
+	}
+	if valuesMatch(bf, criterion) {
+		return matched
+	}
+
+	return failed
+}
+
+// allOf is AND over outcomes: any failure fails, otherwise anything missing is
+// missing. An empty group has no conditions to fail.
+func allOf(outcomes []outcome) outcome {
+	result := matched
+	for _, o := range outcomes {
+		result = min(result, o)
+	}
+
+	return result
+}
+
+// anyOf is OR over outcomes: any match matches, otherwise anything missing is
+// missing. An empty group has no conditions to fail.
+func anyOf(outcomes []outcome) outcome {
+	if len(outcomes) == 0 {
+		return matched
+	}
+
+	result := failed
+	for _, o := range outcomes {
+		result = max(result, o)
+	}
+
+	return result
+}
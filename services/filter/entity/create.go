@@ -4,10 +4,11 @@ import "github.com/citizenkz/core/services/filter/consts"
 
 type (
 	CreateRequest struct {
-		Name   string            `json:"name"`
-		Type   consts.FilterType `json:"type"`
+		Name   string            `json:"name" validate:"required,max=255"`
+		Type   consts.FilterType `json:"type" validate:"required,oneof=STRING_RANGE NUMBER_RANGE DATE_RANGE"`
 		Hint   *string           `json:"hint"`
-		Values []string          `json:"values"`
+		Values []string          `json:"values" validate:"required_if=Type STRING_RANGE"`
+		IsAge  bool              `json:"is_age"`
 	}
 
 	CreateResponse struct {
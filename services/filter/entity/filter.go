@@ -3,6 +3,7 @@ package entity
 import (
 	"github.com/citizenkz/core/ent"
 	"github.com/citizenkz/core/services/filter/consts"
+	"github.com/citizenkz/core/utils/locale"
 )
 
 type (
@@ -12,6 +13,8 @@ type (
 		Hint          *string           `json:"hint"`
 		Type          consts.FilterType `json:"type"`
 		Values        []string          `json:"values"`
+		Labels        []string          `json:"labels,omitempty"`
+		IsAge         bool              `json:"is_age"`
 		SelectedValue *string           `json:"selected_value,omitempty"`
 	}
 
@@ -27,26 +30,50 @@ type (
 	}
 
 	FilterValues struct {
-		FilterID int    `json:"filter_id"`
+		FilterID int    `json:"filter_id" validate:"required"`
 		Value    string `json:"value"`
 	}
 )
 
-func MakeStorageFilterToEntity(filter *ent.Filter) *Filter {
-	return &Filter{
+// MakeStorageFilterToEntity returns filter in loc, as far as its loaded
+// translations go. Labels show Values in loc, in the same order; answers are
+// still given with Values.
+func MakeStorageFilterToEntity(filter *ent.Filter, loc locale.Locale) *Filter {
+	result := &Filter{
 		ID:     filter.ID,
 		Name:   filter.Name,
 		Hint:   filter.Hint,
 		Type:   consts.FilterType(filter.Type),
 		Values: filter.Values,
+		Labels: filter.Values,
+		IsAge:  filter.IsAge,
+	}
+
+	overlays := locale.Overlays(loc, filter.Edges.Translations, func(t *ent.FilterTranslation) locale.Locale {
+		return locale.Locale(t.Locale)
+	})
+	for _, t := range overlays {
+		if t.Name != "" {
+			result.Name = t.Name
+		}
+		if t.Hint != "" {
+			hint := t.Hint
+			result.Hint = &hint
+		}
+		// Labels that don't line up with the values are stale
+		if len(t.Values) > 0 && len(t.Values) == len(filter.Values) {
+			result.Labels = t.Values
+		}
 	}
+
+	return result
 }
 
-func MakeStorageFilterSliceToEntity(filters []*ent.Filter) []*Filter {
-	result := make([]*Filter, len(filters))
+func MakeStorageFilterSliceToEntity(filters []*ent.Filter, loc locale.Locale) []*Filter {
+	result := make([]*Filter, 0, len(filters))
 
 	for _, filter := range filters {
-		filterEntity := MakeStorageFilterToEntity(filter)
+		filterEntity := MakeStorageFilterToEntity(filter, loc)
 		result = append(result, filterEntity)
 	}
 
@@ -0,0 +1,13 @@
+package entity
+
+type (
+	NextRequest struct {
+		Token string `json:"-"`
+	}
+
+	NextResponse struct {
+		Filter            *Filter `json:"filter"`
+		Candidates        int     `json:"candidates"`
+		ExpectedRemaining float64 `json:"expected_remaining"`
+	}
+)
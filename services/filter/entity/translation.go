@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/locale"
+)
+
+type (
+	Translation struct {
+		Locale locale.Locale `json:"locale"`
+		Name   string        `json:"name,omitempty"`
+		Hint   string        `json:"hint,omitempty"`
+		Values []string      `json:"values,omitempty"`
+	}
+
+	ListTranslationsRequest struct {
+		ID int `json:"id"`
+	}
+
+	ListTranslationsResponse struct {
+		Translations []*Translation `json:"translations"`
+	}
+
+	// SaveTranslationRequest replaces the translation of a filter. Fields
+	// left empty fall back to another locale. Values label the filter's
+	// values in the same order.
+	SaveTranslationRequest struct {
+		ID     int           `json:"-"`
+		Locale locale.Locale `json:"-"`
+		Name   string        `json:"name" validate:"max=255"`
+		Hint   string        `json:"hint"`
+		Values []string      `json:"values"`
+	}
+
+	SaveTranslationResponse struct {
+		Translation *Translation `json:"translation"`
+	}
+
+	DeleteTranslationRequest struct {
+		ID     int           `json:"id"`
+		Locale locale.Locale `json:"locale"`
+	}
+
+	DeleteTranslationResponse struct {
+		IsDeleted bool `json:"is_deleted"`
+	}
+
+	// MissingTranslationsRequest asks for the filters with untranslated
+	// fields, in Locale or in every translated locale when it's empty.
+	MissingTranslationsRequest struct {
+		Locale locale.Locale `json:"locale,omitempty" validate:"oneof=kk ru"`
+		Limit  int           `json:"limit" validate:"min=0,max=100"`
+		Offset int           `json:"offset" validate:"min=0"`
+	}
+
+	MissingTranslation struct {
+		ID     int           `json:"id"`
+		Name   string        `json:"name"`
+		Locale locale.Locale `json:"locale"`
+		Fields []string      `json:"fields"`
+	}
+
+	MissingTranslationsResponse struct {
+		Missing []*MissingTranslation `json:"missing"`
+		Total   int                   `json:"total"`
+	}
+)
+
+func MakeStorageTranslationToEntity(translation *ent.FilterTranslation) *Translation {
+	return &Translation{
+		Locale: locale.Locale(translation.Locale),
+		Name:   translation.Name,
+		Hint:   translation.Hint,
+		Values: translation.Values,
+	}
+}
+
+// MakeStorageMissingTranslationToEntity reports the fields of filter that
+// have text but no translation into loc. It is nil when there are none.
+func MakeStorageMissingTranslationToEntity(filter *ent.Filter, loc locale.Locale) *MissingTranslation {
+	var translation *ent.FilterTranslation
+	for _, t := range filter.Edges.Translations {
+		if locale.Locale(t.Locale) == loc {
+			translation = t
+		}
+	}
+	if translation == nil {
+		translation = &ent.FilterTranslation{}
+	}
+
+	fields := make([]string, 0)
+	if filter.Name != "" && translation.Name == "" {
+		fields = append(fields, "name")
+	}
+	if filter.Hint != nil && *filter.Hint != "" && translation.Hint == "" {
+		fields = append(fields, "hint")
+	}
+	if len(filter.Values) > 0 && len(translation.Values) != len(filter.Values) {
+		fields = append(fields, "values")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &MissingTranslation{
+		ID:     filter.ID,
+		Name:   filter.Name,
+		Locale: loc,
+		Fields: fields,
+	}
+}
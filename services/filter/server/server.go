@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"github.com/citizenkz/core/services/filter/usecase"
 	"github.com/citizenkz/core/utils/json"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/logger"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -22,6 +24,11 @@ type Server interface {
 	SaveUserFitlers(w http.ResponseWriter, r *http.Request)
 	Create(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
+	Next(w http.ResponseWriter, r *http.Request)
+	ListTranslations(w http.ResponseWriter, r *http.Request)
+	SaveTranslation(w http.ResponseWriter, r *http.Request)
+	DeleteTranslation(w http.ResponseWriter, r *http.Request)
+	MissingTranslations(w http.ResponseWriter, r *http.Request)
 }
 
 func New(log *slog.Logger, usecase usecase.UseCase) Server {
@@ -31,6 +38,11 @@ func New(log *slog.Logger, usecase usecase.UseCase) Server {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *server) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *server) List(w http.ResponseWriter, r *http.Request) {
 	queryParam := r.URL.Query()
 
@@ -45,7 +57,7 @@ func (s *server) List(w http.ResponseWriter, r *http.Request) {
 		var err error
 		limit, err = strconv.Atoi(rawLimit)
 		if err != nil {
-			s.log.Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+			s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusBadRequest, err)
 			return
 		}
@@ -55,7 +67,7 @@ func (s *server) List(w http.ResponseWriter, r *http.Request) {
 		var err error
 		offset, err = strconv.Atoi(rawOffset)
 		if err != nil {
-			s.log.Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+			s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusBadRequest, err)
 			return
 		}
@@ -73,14 +85,14 @@ func (s *server) List(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.List(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.List", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.List", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -89,14 +101,14 @@ func (s *server) List(w http.ResponseWriter, r *http.Request) {
 func (s *server) SaveUserFitlers(w http.ResponseWriter, r *http.Request) {
 	token, err := jwt.ParseTokenFromHeader(r)
 	if err != nil {
-		s.log.Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusUnauthorized, err)
 		return
 	}
 	req := &entity.SaveFilersRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
@@ -104,14 +116,14 @@ func (s *server) SaveUserFitlers(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.SaveUserFilters(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.SaveUseFilters", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.SaveUseFilters", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -120,21 +132,21 @@ func (s *server) SaveUserFitlers(w http.ResponseWriter, r *http.Request) {
 func (s *server) Create(w http.ResponseWriter, r *http.Request) {
 	req := &entity.CreateRequest{}
 	if err := json.ParseJSON(r, req); err != nil {
-		s.log.Error("failed to json.ParseJSON", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusBadRequest, err)
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	resp, err := s.usecase.Create(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Create", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Create", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -144,7 +156,7 @@ func (s *server) Delete(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		s.log.Error("failed to strconv.Atoi", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to strconv.Atoi", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -155,14 +167,41 @@ func (s *server) Delete(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := s.usecase.Delete(r.Context(), req)
 	if err != nil {
-		s.log.Error("failed to usecase.Delete", slog.String("error", err.Error()))
-		json.WriteError(w, http.StatusInternalServerError, err)
+		s.logger(r.Context()).Error("failed to usecase.Delete", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) Next(w http.ResponseWriter, r *http.Request) {
+	token, err := jwt.ParseTokenFromHeader(r)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to jwt.ParseTokenFromHeader", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req := &entity.NextRequest{
+		Token: token,
+	}
+
+	resp, err := s.usecase.Next(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.Next", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
 		return
 	}
 
 	err = json.WriteJSON(w, http.StatusOK, resp)
 	if err != nil {
-		s.log.Error("failed to json.WriteJson", slog.String("error", err.Error()))
+		s.logger(r.Context()).Error("failed to json.WriteJson", slog.String("error", err.Error()))
 		json.WriteError(w, http.StatusBadRequest, err)
 		return
 	}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"log/slog"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/citizenkz/core/ent"
 	"github.com/citizenkz/core/ent/filter"
+	"github.com/citizenkz/core/ent/filtertranslation"
+	"github.com/citizenkz/core/ent/predicate"
 	"github.com/citizenkz/core/ent/userfilter"
 	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/dberr"
+	"github.com/citizenkz/core/utils/locale"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 type storage struct {
@@ -24,6 +30,12 @@ type Storage interface {
 	UpdateUserFilters(ctx context.Context, userID int, filterID int, value string) (*entity.UserFilter, error)
 	CreateUserFilters(ctx context.Context, userID int, filterID int, value string) (*entity.UserFilter, error)
 	DeleteFilter(ctx context.Context, filterID int) error
+	ListTranslations(ctx context.Context, filterID int) ([]*entity.Translation, error)
+	SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.Translation, error)
+	DeleteTranslation(ctx context.Context, filterID int, loc locale.Locale) error
+	// ListMissingTranslations lists the filters with fields not yet
+	// translated into the requested locales.
+	ListMissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) ([]*entity.MissingTranslation, int, error)
 }
 
 func New(log *slog.Logger, client *ent.Client) Storage {
@@ -33,19 +45,25 @@ func New(log *slog.Logger, client *ent.Client) Storage {
 	}
 }
 
+// logger returns the logger of the request ctx belongs to.
+func (s *storage) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
 func (s *storage) Create(ctx context.Context, req *entity.CreateRequest) (*entity.Filter, error) {
 	createdFilter, err := s.client.Filter.Create().
 		SetName(req.Name).
 		SetNillableHint(req.Hint).
 		SetValues(req.Values).
 		SetType(filter.Type(req.Type.String())).
+		SetIsAge(req.IsAge).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to create filter", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to create filter", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageFilterToEntity(createdFilter), nil
+	return entity.MakeStorageFilterToEntity(createdFilter, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) Update(ctx context.Context, req *entity.UpdateRequest) (*entity.Filter, error) {
@@ -54,40 +72,49 @@ func (s *storage) Update(ctx context.Context, req *entity.UpdateRequest) (*entit
 		SetNillableHint(req.Hint).
 		SetValues(req.Values).
 		SetType(filter.Type(req.Type.String())).
+		SetIsAge(req.IsAge).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update filter", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update filter", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageFilterToEntity(updatedFilter), nil
+	return entity.MakeStorageFilterToEntity(updatedFilter, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) Get(ctx context.Context, id int) (*entity.Filter, error) {
-	filter, err := s.client.Filter.Get(ctx, id)
+	filter, err := s.client.Filter.Query().
+		Where(filter.ID(id)).
+		WithTranslations().
+		Only(ctx)
 	if err != nil {
-		s.log.Error("failed to get filter", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get filter", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
-	return entity.MakeStorageFilterToEntity(filter), nil
+	return entity.MakeStorageFilterToEntity(filter, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) List(ctx context.Context, req *entity.ListRequest) ([]*entity.Filter, error) {
 	filterQuery := s.client.Filter.Query()
 	if req.SearchQuery != "" {
 		filterQuery = filterQuery.Where(
-			filter.NameContains(req.SearchQuery),
+			filter.Or(
+				filter.NameContains(req.SearchQuery),
+				filter.HasTranslationsWith(
+					filtertranslation.NameContains(req.SearchQuery),
+				),
+			),
 		)
 	}
-	
-	filters, err := filterQuery.All(ctx)
+
+	filters, err := filterQuery.WithTranslations().All(ctx)
 	if err != nil {
-		s.log.Error("failed to list filters", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to list filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
-	
-	return entity.MakeStorageFilterSliceToEntity(filters), nil
+
+	return entity.MakeStorageFilterSliceToEntity(filters, locale.FromContext(ctx)), nil
 }
 
 func (s *storage) GetUserFilter(ctx context.Context, userID, filterID int) (*entity.UserFilter, error) {
@@ -97,8 +124,8 @@ func (s *storage) GetUserFilter(ctx context.Context, userID, filterID int) (*ent
 			userfilter.FilterIDEQ(filterID),
 		).First(ctx)
 	if err != nil {
-		s.log.Error("failed to get user filters", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get user filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 	return entity.MakeStorageUserFilterToEntity(userFilters), nil
 }
@@ -110,10 +137,10 @@ func (s *storage) CreateUserFilters(ctx context.Context, userID int, filterID in
 		SetValue(value).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to get user filters", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to get user filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
-	
+
 	return entity.MakeStorageUserFilterToEntity(userFilter), nil
 }
 
@@ -128,16 +155,16 @@ func (s *storage) UpdateUserFilters(ctx context.Context, userID int, filterID in
 		SetValue(value).
 		Save(ctx)
 	if err != nil {
-		s.log.Error("failed to update user filters", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update user filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
 
 	userFilter, err := s.GetUserFilter(ctx, userID, filterID)
 	if err != nil {
-		s.log.Error("failed to update user filters", slog.String("error", err.Error()))
-		return nil, err
+		s.logger(ctx).Error("failed to update user filters", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
 	}
-	
+
 	return userFilter, nil
 }
 
@@ -145,9 +172,192 @@ func (s *storage) DeleteFilter(ctx context.Context, filterID int) error {
 	err := s.client.Filter.DeleteOneID(filterID).
 		Exec(ctx)
 	if err != nil {
-		s.log.Error("failed to delete filter", slog.String("error", err.Error()))
-		return err
+		s.logger(ctx).Error("failed to delete filter", slog.String("error", err.Error()))
+		return dberr.Tag(err)
 	}
 
 	return nil
 }
+
+func (s *storage) ListTranslations(ctx context.Context, filterID int) ([]*entity.Translation, error) {
+	filter, err := s.client.Filter.Get(ctx, filterID)
+	if err != nil {
+		s.logger(ctx).Error("failed to get filter", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	translations, err := filter.QueryTranslations().
+		Order(ent.Asc(filtertranslation.FieldLocale)).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to list filter translations", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	result := make([]*entity.Translation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, entity.MakeStorageTranslationToEntity(t))
+	}
+
+	return result, nil
+}
+
+func (s *storage) SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.Translation, error) {
+	existing, err := s.client.FilterTranslation.Query().
+		Where(
+			filtertranslation.FilterID(req.ID),
+			filtertranslation.LocaleEQ(filtertranslation.Locale(req.Locale.String())),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger(ctx).Error("failed to get filter translation", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	var translation *ent.FilterTranslation
+	if existing == nil {
+		translation, err = s.client.FilterTranslation.Create().
+			SetFilterID(req.ID).
+			SetLocale(filtertranslation.Locale(req.Locale.String())).
+			SetName(req.Name).
+			SetHint(req.Hint).
+			SetValues(req.Values).
+			Save(ctx)
+	} else {
+		translation, err = existing.Update().
+			SetName(req.Name).
+			SetHint(req.Hint).
+			SetValues(req.Values).
+			Save(ctx)
+	}
+	if err != nil {
+		s.logger(ctx).Error("failed to save filter translation", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageTranslationToEntity(translation), nil
+}
+
+func (s *storage) DeleteTranslation(ctx context.Context, filterID int, loc locale.Locale) error {
+	translation, err := s.client.FilterTranslation.Query().
+		Where(
+			filtertranslation.FilterID(filterID),
+			filtertranslation.LocaleEQ(filtertranslation.Locale(loc.String())),
+		).
+		Only(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to get filter translation", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	err = s.client.FilterTranslation.DeleteOne(translation).Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to delete filter translation", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+// ListMissingTranslations pages through the filters with untranslated
+// fields one locale after another, so a filter missing both locales is
+// listed under each.
+func (s *storage) ListMissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) ([]*entity.MissingTranslation, int, error) {
+	locales := locale.Translated
+	if req.Locale != "" {
+		locales = []locale.Locale{req.Locale}
+	}
+
+	missing := make([]*entity.MissingTranslation, 0)
+	total, offset := 0, req.Offset
+	for _, loc := range locales {
+		query := s.client.Filter.Query().
+			Where(missingTranslation(loc))
+
+		count, err := query.Clone().Count(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to count filters missing translations", slog.String("error", err.Error()))
+			return nil, 0, dberr.Tag(err)
+		}
+		total += count
+
+		// Skip locales the page starts after or that it has no room left for
+		if offset >= count {
+			offset -= count
+			continue
+		}
+		if req.Limit > 0 && len(missing) == req.Limit {
+			continue
+		}
+
+		query = query.
+			WithTranslations(func(q *ent.FilterTranslationQuery) {
+				q.Where(filtertranslation.LocaleEQ(filtertranslation.Locale(loc.String())))
+			}).
+			Order(ent.Asc(filter.FieldID)).
+			Offset(offset)
+		if req.Limit > 0 {
+			query = query.Limit(req.Limit - len(missing))
+		}
+		offset = 0
+
+		filters, err := query.All(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to list filters missing translations", slog.String("error", err.Error()))
+			return nil, 0, dberr.Tag(err)
+		}
+
+		for _, f := range filters {
+			if m := entity.MakeStorageMissingTranslationToEntity(f, loc); m != nil {
+				missing = append(missing, m)
+			}
+		}
+	}
+
+	return missing, total, nil
+}
+
+// missingTranslation passes filters with a field that has text but none in
+// the loc translation, or with values the translation doesn't label one by
+// one. A NULL field never equals, so it counts as empty.
+func missingTranslation(loc locale.Locale) predicate.Filter {
+	translated := func(field predicate.FilterTranslation) predicate.Filter {
+		return filter.HasTranslationsWith(
+			filtertranslation.LocaleEQ(filtertranslation.Locale(loc.String())),
+			field,
+		)
+	}
+
+	return filter.Or(
+		filter.And(filter.NameNEQ(""), filter.Not(translated(filtertranslation.NameNEQ("")))),
+		filter.And(filter.HintNEQ(""), filter.Not(translated(filtertranslation.HintNEQ("")))),
+		func(s *sql.Selector) {
+			d := sql.Dialect(s.Dialect())
+			t := d.Table(filtertranslation.Table)
+			values := arrayLength(s.C(filter.FieldValues))
+			s.Where(sql.And(
+				sql.P(func(b *sql.Builder) {
+					b.WriteString(values + " > 0")
+				}),
+				sql.Not(sql.Exists(
+					d.Select(t.C(filtertranslation.FieldID)).
+						From(t).
+						Where(sql.And(
+							sql.ColumnsEQ(t.C(filtertranslation.FieldFilterID), s.C(filter.FieldID)),
+							sql.EQ(t.C(filtertranslation.FieldLocale), loc.String()),
+							sql.P(func(b *sql.Builder) {
+								b.WriteString(arrayLength(t.C(filtertranslation.FieldValues)) + " = " + values)
+							}),
+						)),
+				)),
+			))
+		},
+	)
+}
+
+// arrayLength is the length of a JSON column, 0 unless it holds an array.
+// Values saved from a nil slice hold JSON null, which jsonb_array_length
+// rejects.
+func arrayLength(column string) string {
+	return "CASE jsonb_typeof(" + column + ") WHEN 'array' THEN jsonb_array_length(" + column + ") ELSE 0 END"
+}
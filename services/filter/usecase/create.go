@@ -2,17 +2,21 @@ package usecase
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 
 	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 func (u *usecase) Create(ctx context.Context, req *entity.CreateRequest) (*entity.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.Create")
+	defer span.End()
+
 	filter, err := u.storage.Create(ctx, req)
 	if err != nil {
-		u.log.Error("failed to storage.Create", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.Create: %w", err)
+		u.logger(ctx).Error("failed to storage.Create", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
 	}
 	
 	return &entity.CreateResponse{
@@ -2,17 +2,21 @@ package usecase
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 
 	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 func (u *usecase) Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.Delete")
+	defer span.End()
+
 	err := u.storage.DeleteFilter(ctx, req.ID)
 	if err != nil {
-		u.log.Error("failed to storage.DeleteFilter", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.DeleteFilter: %w", err)
+		u.logger(ctx).Error("failed to storage.DeleteFilter", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
 	}
 
 	return &entity.DeleteResponse{
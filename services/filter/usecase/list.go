@@ -6,25 +6,35 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/apperr"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.List")
+	defer span.End()
+
 	filters, err := u.storage.List(ctx, req)
 	if err != nil {
-		u.log.Error("failed to storage.ListFilters", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to storage.List: %w", err)
+		u.logger(ctx).Error("failed to storage.ListFilters", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
 	}
 
 	if req.Token != "" {
 		userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 		if err != nil {
-			u.log.Error("failed to jwtp.ParseUserID", slog.String("error", err.Error()))
+			u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+			return nil, apperr.Unauthorized("invalid token")
 		}
 		for i, filter := range filters {
 			userfilter, err := u.storage.GetUserFilter(ctx, userID, filter.ID)
+			// Filters the user hasn't answered have no selected value
+			if apperr.CodeOf(err) == apperr.CodeNotFound {
+				continue
+			}
 			if err != nil {
-				u.log.Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
+				u.logger(ctx).Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
 				return nil, fmt.Errorf("failed to storage.GetUserFilter: %w", err)
 			}
 
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/citizenkz/core/services/eligibility"
+	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+// Next returns the unanswered filter that narrows the user's candidate
+// benefits the most. Filter is nil when no question would rule anything out.
+func (u *usecase) Next(ctx context.Context, req *entity.NextRequest) (*entity.NextResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.Next")
+	defer span.End()
+
+	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
+	if err != nil {
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
+	}
+
+	criteria, err := u.benefitStorage.GetUserCriteria(ctx, userID)
+	if err != nil {
+		u.logger(ctx).Error("failed to benefitStorage.GetUserCriteria", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "user not found")
+	}
+
+	candidates, err := u.benefitStorage.ListCandidateBenefits(ctx, criteria)
+	if err != nil {
+		u.logger(ctx).Error("failed to benefitStorage.ListCandidateBenefits", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "benefit not found")
+	}
+
+	question := eligibility.NextQuestion(candidates, criteria)
+	if question == nil {
+		return &entity.NextResponse{
+			Candidates:        len(candidates),
+			ExpectedRemaining: float64(len(candidates)),
+		}, nil
+	}
+
+	filter, err := u.storage.Get(ctx, question.FilterID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.Get", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
+	}
+
+	return &entity.NextResponse{
+		Filter:            filter,
+		Candidates:        question.Candidates,
+		ExpectedRemaining: question.ExpectedRemaining,
+	}, nil
+}
@@ -5,32 +5,48 @@ import (
 	"fmt"
 	"log/slog"
 
-	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/eligibility"
 	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/apperr"
 	"github.com/citizenkz/core/utils/jwt"
+	"github.com/citizenkz/core/utils/tracing"
 )
 
 func (u *usecase) SaveUserFilters(ctx context.Context, req *entity.SaveFilersRequest) (*entity.SaveFilterResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.SaveUserFilters")
+	defer span.End()
+
 	userID, err := jwt.ParseUserID(ctx, req.Token, u.cfg.JwtSecret)
 	if err != nil {
-		u.log.Error("failed to jwtp.ParseUserID", slog.String("error", err.Error()))
+		u.logger(ctx).Error("failed to jwt.ParseUserID", slog.String("error", err.Error()))
+		return nil, apperr.Unauthorized("invalid token")
 	}
 	userFilters := &entity.UserFilters{
 		UserID: userID,
 	}
 	newFilterValues := make([]*entity.FilterValues, 0)
 	for _, filterValue := range req.FilterValues {
+		definition, err := u.storage.Get(ctx, filterValue.FilterID)
+		if err != nil {
+			u.logger(ctx).Error("failed to storage.Get", slog.String("error", err.Error()))
+			return nil, apperr.Public(err, "filter not found")
+		}
+		if err := eligibility.ValidateValue(definition.Type, definition.Values, filterValue.Value); err != nil {
+			u.logger(ctx).Error("failed to eligibility.ValidateValue", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("filter %d: %w", filterValue.FilterID, err)
+		}
+
 		filter, err := u.storage.GetUserFilter(ctx, userID, filterValue.FilterID)
-		if err != nil && ent.IsNotFound(err) {
-			u.log.Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
-			return nil, fmt.Errorf("failed to storage.GetUserFilter: %w", err)
+		if err != nil && apperr.CodeOf(err) != apperr.CodeNotFound {
+			u.logger(ctx).Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
+			return nil, apperr.Public(err, "filter answer not found")
 		}
 		switch {
-		case ent.IsNotFound(err):
+		case apperr.CodeOf(err) == apperr.CodeNotFound:
 			userFilter, err := u.storage.CreateUserFilters(ctx, userID, filterValue.FilterID, filterValue.Value)
 			if err != nil {
-				u.log.Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
-				return nil, fmt.Errorf("failed to storage.GetUserFilter: %w", err)
+				u.logger(ctx).Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
+				return nil, apperr.Public(err, "filter answer not found")
 			}
 			newFilterValues = append(newFilterValues, &entity.FilterValues{
 				FilterID: userFilter.FilterID,
@@ -39,8 +55,8 @@ func (u *usecase) SaveUserFilters(ctx context.Context, req *entity.SaveFilersReq
 		case err == nil:
 			userFilter, err := u.storage.UpdateUserFilters(ctx, filter.UserID, filter.FilterID, filterValue.Value)
 			if err != nil {
-				u.log.Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
-				return nil, fmt.Errorf("failed to storage.GetUserFilter: %w", err)
+				u.logger(ctx).Error("failed to storage.GetUserFilter", slog.String("error", err.Error()))
+				return nil, apperr.Public(err, "filter answer not found")
 			}
 			newFilterValues = append(newFilterValues, &entity.FilterValues{
 				FilterID: userFilter.FilterID,
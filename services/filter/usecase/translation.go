@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/citizenkz/core/services/filter/entity"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+func (u *usecase) ListTranslations(ctx context.Context, req *entity.ListTranslationsRequest) (*entity.ListTranslationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.ListTranslations")
+	defer span.End()
+
+	translations, err := u.storage.ListTranslations(ctx, req.ID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.ListTranslations", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
+	}
+
+	return &entity.ListTranslationsResponse{
+		Translations: translations,
+	}, nil
+}
+
+func (u *usecase) SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.SaveTranslationResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.SaveTranslation")
+	defer span.End()
+
+	if !req.Locale.IsTranslated() {
+		return nil, apperr.Validation("filters are not translated into %q", req.Locale)
+	}
+
+	filter, err := u.storage.Get(ctx, req.ID)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.Get", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
+	}
+
+	// Values are labels of the filter's values, one each
+	if len(req.Values) > 0 && len(req.Values) != len(filter.Values) {
+		return nil, apperr.InvalidFields(map[string]string{
+			"values": fmt.Sprintf("must have %d labels, one per filter value", len(filter.Values)),
+		})
+	}
+
+	translation, err := u.storage.SaveTranslation(ctx, req)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.SaveTranslation", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
+	}
+
+	return &entity.SaveTranslationResponse{
+		Translation: translation,
+	}, nil
+}
+
+func (u *usecase) DeleteTranslation(ctx context.Context, req *entity.DeleteTranslationRequest) (*entity.DeleteTranslationResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.DeleteTranslation")
+	defer span.End()
+
+	err := u.storage.DeleteTranslation(ctx, req.ID, req.Locale)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.DeleteTranslation", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "translation not found")
+	}
+
+	return &entity.DeleteTranslationResponse{
+		IsDeleted: true,
+	}, nil
+}
+
+func (u *usecase) MissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) (*entity.MissingTranslationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "filter.MissingTranslations")
+	defer span.End()
+
+	missing, total, err := u.storage.ListMissingTranslations(ctx, req)
+	if err != nil {
+		u.logger(ctx).Error("failed to storage.ListMissingTranslations", slog.String("error", err.Error()))
+		return nil, apperr.Public(err, "filter not found")
+	}
+
+	return &entity.MissingTranslationsResponse{
+		Missing: missing,
+		Total:   total,
+	}, nil
+}
@@ -5,14 +5,17 @@ import (
 	"log/slog"
 
 	"github.com/citizenkz/core/config"
+	benefitStorage "github.com/citizenkz/core/services/benefit/storage"
 	"github.com/citizenkz/core/services/filter/entity"
 	"github.com/citizenkz/core/services/filter/storage"
+	"github.com/citizenkz/core/utils/logger"
 )
 
 type usecase struct {
-	log     *slog.Logger
-	storage storage.Storage
-	cfg     *config.Config
+	log            *slog.Logger
+	storage        storage.Storage
+	benefitStorage benefitStorage.Storage
+	cfg            *config.Config
 }
 
 type UseCase interface {
@@ -20,12 +23,25 @@ type UseCase interface {
 	SaveUserFilters(ctx context.Context, req *entity.SaveFilersRequest) (*entity.SaveFilterResponse, error)
 	Create(ctx context.Context, req *entity.CreateRequest) (*entity.CreateResponse, error)
 	Delete(ctx context.Context, req *entity.DeleteRequest) (*entity.DeleteResponse, error)
+	Next(ctx context.Context, req *entity.NextRequest) (*entity.NextResponse, error)
+	ListTranslations(ctx context.Context, req *entity.ListTranslationsRequest) (*entity.ListTranslationsResponse, error)
+	SaveTranslation(ctx context.Context, req *entity.SaveTranslationRequest) (*entity.SaveTranslationResponse, error)
+	DeleteTranslation(ctx context.Context, req *entity.DeleteTranslationRequest) (*entity.DeleteTranslationResponse, error)
+	// MissingTranslations reports the filters with fields not yet
+	// translated, for editors to work through.
+	MissingTranslations(ctx context.Context, req *entity.MissingTranslationsRequest) (*entity.MissingTranslationsResponse, error)
 }
 
-func New(log *slog.Logger, storage storage.Storage, cfg *config.Config) UseCase {
+func New(log *slog.Logger, storage storage.Storage, benefitStorage benefitStorage.Storage, cfg *config.Config) UseCase {
 	return &usecase{
-		log:     log,
-		storage: storage,
-		cfg:     cfg,
+		log:            log,
+		storage:        storage,
+		benefitStorage: benefitStorage,
+		cfg:            cfg,
 	}
 }
+
+// logger returns the logger of the request ctx belongs to.
+func (u *usecase) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, u.log)
+}
@@ -0,0 +1,17 @@
+package consts
+
+// Status is where an outbox email is in its delivery.
+type Status string
+
+const (
+	// Pending emails are waiting for their next delivery attempt
+	Pending Status = "pending"
+	// Sent emails were accepted by the mail server
+	Sent Status = "sent"
+	// Dead emails ran out of attempts and wait for an admin to retry them
+	Dead Status = "dead"
+)
+
+func (status Status) String() string {
+	return string(status)
+}
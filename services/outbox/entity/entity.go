@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/services/outbox/consts"
+)
+
+type (
+	// Email is an outbox email as admins see it. The body is left out since
+	// it may hold one-time codes.
+	Email struct {
+		ID            int           `json:"id"`
+		Kind          string        `json:"kind"`
+		Recipient     string        `json:"recipient"`
+		Subject       string        `json:"subject"`
+		Status        consts.Status `json:"status"`
+		Attempts      int           `json:"attempts"`
+		NextAttemptAt time.Time     `json:"next_attempt_at"`
+		LastError     *string       `json:"last_error,omitempty"`
+		SentAt        *time.Time    `json:"sent_at,omitempty"`
+		CreatedAt     time.Time     `json:"created_at"`
+	}
+)
+
+func MakeStorageEmailToEntity(email *ent.OutboxEmail) *Email {
+	return &Email{
+		ID:            email.ID,
+		Kind:          email.Kind,
+		Recipient:     email.Recipient,
+		Subject:       email.Subject,
+		Status:        consts.Status(email.Status),
+		Attempts:      email.Attempts,
+		NextAttemptAt: email.NextAttemptAt,
+		LastError:     email.LastError,
+		SentAt:        email.SentAt,
+		CreatedAt:     email.CreatedAt,
+	}
+}
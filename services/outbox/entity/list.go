@@ -0,0 +1,16 @@
+package entity
+
+import "github.com/citizenkz/core/services/outbox/consts"
+
+type (
+	ListRequest struct {
+		Status consts.Status `json:"status,omitempty" validate:"oneof=pending sent dead"`
+		Limit  int           `json:"limit" validate:"min=0,max=100"`
+		Offset int           `json:"offset" validate:"min=0"`
+	}
+
+	ListResponse struct {
+		Emails []*Email `json:"emails"`
+		Total  int      `json:"total"`
+	}
+)
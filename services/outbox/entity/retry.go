@@ -0,0 +1,15 @@
+package entity
+
+type (
+	RetryRequest struct {
+		ID int `json:"id"`
+	}
+
+	RetryResponse struct {
+		Email *Email `json:"email"`
+	}
+
+	RetryDeadResponse struct {
+		Retried int `json:"retried"`
+	}
+)
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/citizenkz/core/services/outbox/entity"
+	"github.com/citizenkz/core/services/outbox/usecase"
+	"github.com/citizenkz/core/utils/json"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+type server struct {
+	log     *slog.Logger
+	usecase usecase.UseCase
+}
+
+type Server interface {
+	HandleList(w http.ResponseWriter, r *http.Request)
+	HandleRetry(w http.ResponseWriter, r *http.Request)
+	HandleRetryDead(w http.ResponseWriter, r *http.Request)
+}
+
+func New(log *slog.Logger, usecase usecase.UseCase) Server {
+	return &server{
+		log:     log,
+		usecase: usecase,
+	}
+}
+
+// logger returns the logger of the request ctx belongs to.
+func (s *server) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
+func (s *server) HandleList(w http.ResponseWriter, r *http.Request) {
+	req := &entity.ListRequest{}
+	if err := json.ParseJSON(r, req); err != nil {
+		s.logger(r.Context()).Error("failed to json.ParseJSON", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	// Set default limit if not provided
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.usecase.List(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.List", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleRetry(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to parse id", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req := &entity.RetryRequest{
+		ID: id,
+	}
+
+	resp, err := s.usecase.Retry(r.Context(), req)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.Retry", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
+
+func (s *server) HandleRetryDead(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.usecase.RetryDead(r.Context())
+	if err != nil {
+		s.logger(r.Context()).Error("failed to usecase.RetryDead", slog.String("error", err.Error()))
+		json.WriteAppError(w, err)
+		return
+	}
+
+	err = json.WriteJSON(w, http.StatusOK, resp)
+	if err != nil {
+		s.logger(r.Context()).Error("failed to json.WriteJSON", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+}
@@ -0,0 +1,239 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/ent/outboxemail"
+	"github.com/citizenkz/core/services/outbox/entity"
+	"github.com/citizenkz/core/utils/dberr"
+	"github.com/citizenkz/core/utils/dbtx"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/logger"
+)
+
+type storage struct {
+	client *ent.Client
+	log    *slog.Logger
+}
+
+type Storage interface {
+	// Enqueue stores msgs for delivery. Inside a transaction the emails are
+	// only sent if it commits.
+	Enqueue(ctx context.Context, msgs ...email.Message) error
+	// ClaimDue leases up to limit pending emails whose next attempt is due,
+	// so other workers skip them until lease runs out.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.OutboxEmail, error)
+	// MarkSent records a delivery and clears the body, which is no longer
+	// needed.
+	MarkSent(ctx context.Context, id int, sentAt time.Time) error
+	// MarkFailed records a failed attempt. The email is tried again at
+	// nextAttemptAt, or dead-lettered when dead is set.
+	MarkFailed(ctx context.Context, id int, attempts int, lastError string, nextAttemptAt time.Time, dead bool) error
+	ListEmails(ctx context.Context, req *entity.ListRequest) ([]*entity.Email, int, error)
+	GetEmail(ctx context.Context, id int) (*entity.Email, error)
+	// RetryEmail puts an email back in the queue with a fresh set of
+	// attempts.
+	RetryEmail(ctx context.Context, id int) (*entity.Email, error)
+	// RetryDeadEmails requeues every dead email and returns how many.
+	RetryDeadEmails(ctx context.Context) (int, error)
+}
+
+func New(client *ent.Client, log *slog.Logger) Storage {
+	return &storage{
+		client: client,
+		log:    log,
+	}
+}
+
+// logger returns the logger of the request ctx belongs to.
+func (s *storage) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.log)
+}
+
+// db returns the client to query with, joining the transaction ctx carries.
+func (s *storage) db(ctx context.Context) *ent.Client {
+	return dbtx.Client(ctx, s.client)
+}
+
+func (s *storage) Enqueue(ctx context.Context, msgs ...email.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.OutboxEmailCreate, 0, len(msgs))
+	for _, msg := range msgs {
+		builders = append(builders, s.db(ctx).OutboxEmail.Create().
+			SetKind(msg.Kind).
+			SetRecipient(msg.To).
+			SetSubject(msg.Subject).
+			SetBody(msg.Body).
+			SetTextBody(msg.Text))
+	}
+
+	err := s.db(ctx).OutboxEmail.CreateBulk(builders...).Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to enqueue emails", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.OutboxEmail, error) {
+	// Pending, due and not leased by another worker
+	available := outboxemail.And(
+		outboxemail.StatusEQ(outboxemail.StatusPending),
+		outboxemail.NextAttemptAtLTE(now),
+		outboxemail.Or(
+			outboxemail.LockedUntilIsNil(),
+			outboxemail.LockedUntilLT(now),
+		),
+	)
+
+	candidates, err := s.db(ctx).OutboxEmail.Query().
+		Where(available).
+		Order(ent.Asc(outboxemail.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to query due emails", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	// The lease is taken with a conditional update, so of two workers that
+	// saw the same email only one gets it.
+	claimed := make([]*ent.OutboxEmail, 0, len(candidates))
+	for _, candidate := range candidates {
+		n, err := s.db(ctx).OutboxEmail.Update().
+			Where(outboxemail.ID(candidate.ID), available).
+			SetLockedUntil(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			s.logger(ctx).Error("failed to lease email", slog.String("error", err.Error()))
+			return nil, dberr.Tag(err)
+		}
+		if n == 1 {
+			claimed = append(claimed, candidate)
+		}
+	}
+
+	return claimed, nil
+}
+
+func (s *storage) MarkSent(ctx context.Context, id int, sentAt time.Time) error {
+	// The body may hold a one-time code, and a sent email is never retried
+	err := s.db(ctx).OutboxEmail.UpdateOneID(id).
+		SetStatus(outboxemail.StatusSent).
+		AddAttempts(1).
+		SetSentAt(sentAt).
+		SetBody("").
+		SetTextBody("").
+		ClearLockedUntil().
+		ClearLastError().
+		Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to mark email sent", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) MarkFailed(ctx context.Context, id int, attempts int, lastError string, nextAttemptAt time.Time, dead bool) error {
+	update := s.db(ctx).OutboxEmail.UpdateOneID(id).
+		SetAttempts(attempts).
+		SetLastError(lastError).
+		SetNextAttemptAt(nextAttemptAt).
+		ClearLockedUntil()
+	if dead {
+		update = update.SetStatus(outboxemail.StatusDead)
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to mark email failed", slog.String("error", err.Error()))
+		return dberr.Tag(err)
+	}
+
+	return nil
+}
+
+func (s *storage) ListEmails(ctx context.Context, req *entity.ListRequest) ([]*entity.Email, int, error) {
+	query := s.db(ctx).OutboxEmail.Query()
+
+	if req.Status != "" {
+		query = query.Where(outboxemail.StatusEQ(outboxemail.Status(req.Status.String())))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to count emails", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
+	}
+
+	if req.Limit > 0 {
+		query = query.Limit(req.Limit)
+	}
+	if req.Offset > 0 {
+		query = query.Offset(req.Offset)
+	}
+
+	emails, err := query.
+		Order(ent.Desc(outboxemail.FieldCreatedAt), ent.Desc(outboxemail.FieldID)).
+		All(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to list emails", slog.String("error", err.Error()))
+		return nil, 0, dberr.Tag(err)
+	}
+
+	result := make([]*entity.Email, 0, len(emails))
+	for _, e := range emails {
+		result = append(result, entity.MakeStorageEmailToEntity(e))
+	}
+
+	return result, total, nil
+}
+
+func (s *storage) GetEmail(ctx context.Context, id int) (*entity.Email, error) {
+	email, err := s.db(ctx).OutboxEmail.Get(ctx, id)
+	if err != nil {
+		s.logger(ctx).Error("failed to get email", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageEmailToEntity(email), nil
+}
+
+func (s *storage) RetryEmail(ctx context.Context, id int) (*entity.Email, error) {
+	email, err := s.db(ctx).OutboxEmail.UpdateOneID(id).
+		SetStatus(outboxemail.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to retry email", slog.String("error", err.Error()))
+		return nil, dberr.Tag(err)
+	}
+
+	return entity.MakeStorageEmailToEntity(email), nil
+}
+
+func (s *storage) RetryDeadEmails(ctx context.Context) (int, error) {
+	n, err := s.db(ctx).OutboxEmail.Update().
+		Where(outboxemail.StatusEQ(outboxemail.StatusDead)).
+		SetStatus(outboxemail.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		s.logger(ctx).Error("failed to retry dead emails", slog.String("error", err.Error()))
+		return 0, dberr.Tag(err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (u *usecase) Work(ctx context.Context) {
+	u.log.Info("outbox worker started", slog.Duration("poll_interval", u.cfg.Outbox.PollInterval))
+
+	ticker := time.NewTicker(u.cfg.Outbox.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// A full batch means more may be waiting, so keep going without
+		// waiting for the next tick.
+		for {
+			n, err := u.Deliver(ctx)
+			if err != nil || n < u.cfg.Outbox.BatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			u.log.Info("outbox worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (u *usecase) Deliver(ctx context.Context) (int, error) {
+	// A batch that started is finished even if ctx is cancelled, otherwise
+	// emails already handed to the transport would be sent again once their
+	// lease runs out.
+	ctx = context.WithoutCancel(ctx)
+
+	ctx, span := tracing.Start(ctx, "outbox.Deliver")
+	defer span.End()
+
+	now := time.Now()
+	emails, err := u.storage.ClaimDue(ctx, now, u.cfg.Outbox.Lease, u.cfg.Outbox.BatchSize)
+	if err != nil {
+		u.logger(ctx).Error("failed to claim due emails", slog.String("error", err.Error()))
+		tracing.Fail(span, err)
+		return 0, fmt.Errorf("failed to storage.ClaimDue: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("outbox.batch", len(emails)))
+
+	for _, e := range emails {
+		u.deliver(ctx, e)
+	}
+
+	return len(emails), nil
+}
+
+// deliver sends one claimed email and records the outcome. Errors are
+// logged rather than returned so one bad email doesn't hold up the batch.
+func (u *usecase) deliver(ctx context.Context, e *ent.OutboxEmail) {
+	log := u.logger(ctx).With(
+		slog.Int("outbox_id", e.ID),
+		slog.String("kind", e.Kind),
+	)
+
+	sendErr := u.sender.Send(ctx, email.Message{
+		Kind:    e.Kind,
+		To:      e.Recipient,
+		Subject: e.Subject,
+		Body:    e.Body,
+		Text:    e.TextBody,
+	})
+	if sendErr == nil {
+		if err := u.storage.MarkSent(ctx, e.ID, time.Now()); err != nil {
+			log.Error("failed to mark email sent", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	attempts := e.Attempts + 1
+	dead := attempts >= u.cfg.Outbox.MaxAttempts
+	next := time.Now().Add(u.backoff(attempts))
+
+	if err := u.storage.MarkFailed(ctx, e.ID, attempts, sendErr.Error(), next, dead); err != nil {
+		log.Error("failed to mark email failed", slog.String("error", err.Error()))
+		return
+	}
+
+	if dead {
+		metrics.EmailsDeadLettered.WithLabelValues(e.Kind).Inc()
+		log.Error("email dead-lettered",
+			slog.Int("attempts", attempts),
+			slog.String("error", sendErr.Error()),
+		)
+		return
+	}
+
+	log.Warn("email delivery failed, will retry",
+		slog.Int("attempts", attempts),
+		slog.Time("next_attempt_at", next),
+		slog.String("error", sendErr.Error()),
+	)
+}
+
+// backoff is how long to wait after the given number of failed attempts:
+// BaseDelay doubled for each attempt after the first, capped at MaxDelay.
+func (u *usecase) backoff(attempts int) time.Duration {
+	delay := u.cfg.Outbox.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= u.cfg.Outbox.MaxDelay {
+			return u.cfg.Outbox.MaxDelay
+		}
+	}
+
+	return min(delay, u.cfg.Outbox.MaxDelay)
+}
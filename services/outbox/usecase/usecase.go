@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/services/outbox/consts"
+	"github.com/citizenkz/core/services/outbox/entity"
+	"github.com/citizenkz/core/services/outbox/storage"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/email"
+	"github.com/citizenkz/core/utils/logger"
+	"github.com/citizenkz/core/utils/tracing"
+)
+
+type usecase struct {
+	log     *slog.Logger
+	storage storage.Storage
+	sender  email.EmailSender
+	cfg     *config.Config
+}
+
+type UseCase interface {
+	// Deliver sends one batch of due emails and returns how many it
+	// attempted.
+	Deliver(ctx context.Context) (int, error)
+	// Work delivers due emails every poll interval until ctx is done.
+	Work(ctx context.Context)
+	List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error)
+	Retry(ctx context.Context, req *entity.RetryRequest) (*entity.RetryResponse, error)
+	RetryDead(ctx context.Context) (*entity.RetryDeadResponse, error)
+}
+
+func New(log *slog.Logger, storage storage.Storage, sender email.EmailSender, cfg *config.Config) UseCase {
+	return &usecase{
+		log:     log,
+		storage: storage,
+		sender:  sender,
+		cfg:     cfg,
+	}
+}
+
+// logger returns the logger of the request ctx belongs to.
+func (u *usecase) logger(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, u.log)
+}
+
+func (u *usecase) List(ctx context.Context, req *entity.ListRequest) (*entity.ListResponse, error) {
+	ctx, span := tracing.Start(ctx, "outbox.List")
+	defer span.End()
+
+	emails, total, err := u.storage.ListEmails(ctx, req)
+	if err != nil {
+		u.logger(ctx).Error("failed to list emails", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to storage.ListEmails: %w", err)
+	}
+
+	return &entity.ListResponse{
+		Emails: emails,
+		Total:  total,
+	}, nil
+}
+
+func (u *usecase) Retry(ctx context.Context, req *entity.RetryRequest) (*entity.RetryResponse, error) {
+	ctx, span := tracing.Start(ctx, "outbox.Retry")
+	defer span.End()
+
+	current, err := u.storage.GetEmail(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to storage.GetEmail: %w", err)
+	}
+
+	if current.Status == consts.Sent {
+		return nil, apperr.Conflict("email was already sent")
+	}
+
+	email, err := u.storage.RetryEmail(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to storage.RetryEmail: %w", err)
+	}
+
+	u.logger(ctx).Info("email requeued", slog.Int("outbox_id", email.ID), slog.String("kind", email.Kind))
+
+	return &entity.RetryResponse{
+		Email: email,
+	}, nil
+}
+
+func (u *usecase) RetryDead(ctx context.Context) (*entity.RetryDeadResponse, error) {
+	ctx, span := tracing.Start(ctx, "outbox.RetryDead")
+	defer span.End()
+
+	n, err := u.storage.RetryDeadEmails(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to storage.RetryDeadEmails: %w", err)
+	}
+
+	u.logger(ctx).Info("dead emails requeued", slog.Int("count", n))
+
+	return &entity.RetryDeadResponse{
+		Retried: n,
+	}, nil
+}
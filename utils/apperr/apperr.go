@@ -0,0 +1,173 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Code is the machine-readable kind of an error, stable across releases so
+// clients can branch on it instead of on the message.
+type Code string
+
+const (
+	CodeBadRequest      Code = "bad_request"
+	CodeUnauthorized    Code = "unauthorized"
+	CodeForbidden       Code = "forbidden"
+	CodeUnverified      Code = "email_not_verified"
+	CodeNotFound        Code = "not_found"
+	CodeConflict        Code = "conflict"
+	CodeValidation      Code = "validation_failed"
+	CodeTooManyRequests Code = "too_many_requests"
+	CodeInternal        Code = "internal"
+)
+
+// Status returns the HTTP status the code is answered with.
+func (c Code) Status() int {
+	switch c {
+	case CodeBadRequest:
+		return http.StatusBadRequest
+	case CodeUnauthorized:
+		return http.StatusUnauthorized
+	case CodeForbidden, CodeUnverified:
+		return http.StatusForbidden
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeConflict:
+		return http.StatusConflict
+	case CodeValidation:
+		return http.StatusUnprocessableEntity
+	case CodeTooManyRequests:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// CodeForStatus is the inverse of Code.Status, for errors whose status is
+// decided by the caller.
+func CodeForStatus(status int) Code {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	default:
+		return CodeInternal
+	}
+}
+
+// Error tags an error with a Code. Its message is shown to the client, and
+// Fields, when set, maps request fields to what is wrong with them.
+type Error struct {
+	Code   Code
+	Err    error
+	Fields map[string]string
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func newError(code Code, format string, args ...any) error {
+	return &Error{
+		Code: code,
+		Err:  fmt.Errorf(format, args...),
+	}
+}
+
+// BadRequest reports a request that can't be read at all.
+func BadRequest(format string, args ...any) error {
+	return newError(CodeBadRequest, format, args...)
+}
+
+// NotFound reports a missing resource.
+func NotFound(format string, args ...any) error {
+	return newError(CodeNotFound, format, args...)
+}
+
+// Conflict reports a request that clashes with the current state, such as a
+// duplicate email.
+func Conflict(format string, args ...any) error {
+	return newError(CodeConflict, format, args...)
+}
+
+// Validation reports well-formed input that breaks a rule.
+func Validation(format string, args ...any) error {
+	return newError(CodeValidation, format, args...)
+}
+
+// InvalidFields reports validation failures keyed by field.
+func InvalidFields(fields map[string]string) error {
+	return &Error{
+		Code:   CodeValidation,
+		Err:    errors.New("validation failed"),
+		Fields: fields,
+	}
+}
+
+// Unauthorized reports missing or wrong credentials.
+func Unauthorized(format string, args ...any) error {
+	return newError(CodeUnauthorized, format, args...)
+}
+
+// Forbidden reports a caller that is known but not allowed to do this.
+func Forbidden(format string, args ...any) error {
+	return newError(CodeForbidden, format, args...)
+}
+
+// Unverified reports a caller who must confirm their email address first.
+func Unverified(format string, args ...any) error {
+	return newError(CodeUnverified, format, args...)
+}
+
+// Throttled is an error that tells when to try again, such as a lockout. It
+// is answered as too many requests, with a Retry-After header.
+type Throttled interface {
+	error
+	RetryAfter() time.Duration
+}
+
+// CodeOf classifies err. A tagged Error anywhere in the chain wins, then a
+// Throttled error is too many requests. Anything else is internal; storages
+// tag the errors of their database.
+func CodeOf(err error) Code {
+	var appErr *Error
+	var throttled Throttled
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &appErr):
+		return appErr.Code
+	case errors.As(err, &throttled):
+		return CodeTooManyRequests
+	default:
+		return CodeInternal
+	}
+}
+
+// Public returns what a usecase answers for an error from a storage. A
+// not-found error gets the notFound message instead of the storage's generic
+// one, anything else is returned as it is.
+func Public(err error, notFound string) error {
+	if CodeOf(err) == CodeNotFound {
+		return NotFound("%s", notFound)
+	}
+
+	return err
+}
@@ -0,0 +1,65 @@
+// Package dberr tags the errors of ent and PostgreSQL with apperr codes, so
+// storages answer in the codes usecases and handlers understand.
+package dberr
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/citizenkz/core/ent"
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/lib/pq"
+)
+
+// PostgreSQL error codes, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	notNullViolation    = "23502"
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+	checkViolation      = "23514"
+)
+
+// Tag returns err with the apperr code it stands for. A missing row is not
+// found, a duplicate or a row still referenced is a conflict, and a reference
+// to a missing row or a value the schema rejects is a validation error. The
+// messages are generic, ent's aren't meant for clients. Tagged and other
+// errors are returned as they are.
+func Tag(err error) error {
+	var appErr *apperr.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &appErr):
+		return err
+	case ent.IsNotFound(err):
+		return apperr.NotFound("not found")
+	case ent.IsValidationError(err):
+		return apperr.Validation("invalid value")
+	case ent.IsConstraintError(err):
+		return constraint(err)
+	default:
+		return err
+	}
+}
+
+func constraint(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return apperr.Conflict("conflicts with existing data")
+	}
+
+	switch pqErr.Code {
+	case foreignKeyViolation:
+		// Deleting a row something still points at is a conflict, pointing
+		// at a row that doesn't exist is bad input
+		if strings.HasPrefix(pqErr.Message, "update or delete on table") {
+			return apperr.Conflict("still in use")
+		}
+		return apperr.Validation("refers to a record that doesn't exist")
+	case notNullViolation, checkViolation:
+		return apperr.Validation("invalid value")
+	default:
+		return apperr.Conflict("conflicts with existing data")
+	}
+}
@@ -0,0 +1,83 @@
+package dbtx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/citizenkz/core/ent"
+)
+
+// Client returns the client of the transaction ctx carries, or client outside
+// of one. Storages query through it so their writes join a transaction
+// started by Run.
+func Client(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return client
+}
+
+// Tx is a transaction that may belong to an enclosing Run. Commit and
+// Rollback only act on transactions Begin started itself; a joined one is
+// finished by its owner.
+type Tx struct {
+	*ent.Tx
+	owned bool
+}
+
+// Begin starts a transaction, or joins the one ctx carries.
+func Begin(ctx context.Context, client *ent.Client) (*Tx, error) {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return &Tx{Tx: tx}, nil
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx, owned: true}, nil
+}
+
+func (t *Tx) Commit() error {
+	if !t.owned {
+		return nil
+	}
+
+	return t.Tx.Commit()
+}
+
+func (t *Tx) Rollback() error {
+	if !t.owned {
+		return nil
+	}
+
+	return t.Tx.Rollback()
+}
+
+// Run calls fn with a context carrying a transaction, committed if fn
+// succeeds and rolled back otherwise. Inside another Run it joins that
+// transaction.
+func Run(ctx context.Context, client *ent.Client, log *slog.Logger, fn func(ctx context.Context) error) error {
+	tx, err := Begin(ctx, client)
+	if err != nil {
+		log.Error("failed to start transaction", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := fn(ent.NewTxContext(ctx, tx.Tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
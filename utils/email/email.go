@@ -1,143 +1,108 @@
 package email
 
 import (
+	"bytes"
 	"fmt"
-	"net/smtp"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"time"
+
+	"github.com/citizenkz/core/utils/locale"
+)
 
-	"github.com/citizenkz/core/config"
+const (
+	KindPasswordResetOTP     = "password_reset_otp"
+	KindEmailVerification    = "email_verification"
+	KindEmailChangeRequested = "email_change_requested"
+	KindPasswordChanged      = "password_changed"
+	KindEmailChanged         = "email_changed"
+	KindAccountDeleted       = "account_deleted"
 )
 
-type EmailService struct {
-	cfg *config.Config
+// Message is a rendered email. Kind names what it is about, for metrics and
+// the outbox. Body is HTML and Text its plain-text alternative.
+type Message struct {
+	Kind    string
+	To      string
+	Subject string
+	Body    string
+	Text    string
 }
 
-func New(cfg *config.Config) *EmailService {
-	return &EmailService{
-		cfg: cfg,
+// raw renders msg as an RFC 5322 message sent by from, multipart/alternative
+// when it has a text part.
+func (msg Message) raw(from string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+
+	// Emails queued before text parts existed only have HTML
+	if msg.Text == "" {
+		b.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+		b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+		b.WriteString("\r\n")
+		writeQuotedPrintable(&b, msg.Body)
+		return b.Bytes()
 	}
-}
 
-func (e *EmailService) SendOTP(to, otpCode string) error {
-	subject := "Password Reset OTP Code"
-	body := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <style>
-        body { font-family: Arial, sans-serif; }
-        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
-        .otp-code { font-size: 24px; font-weight: bold; color: #4CAF50; padding: 10px; background: #f5f5f5; border-radius: 5px; text-align: center; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <h2>Password Reset Request</h2>
-        <p>You have requested to reset your password. Use the OTP code below:</p>
-        <div class="otp-code">%s</div>
-        <p>This code will expire in 10 minutes.</p>
-        <p>If you didn't request this, please ignore this email.</p>
-    </div>
-</body>
-</html>
-`, otpCode)
-
-	return e.send(to, subject, body)
-}
+	parts := multipart.NewWriter(&b)
+	fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%q\r\n", parts.Boundary())
+	b.WriteString("\r\n")
+
+	// Clients show the last part they understand, so HTML goes last
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", msg.Text},
+		{"text/html; charset=UTF-8", msg.Body},
+	} {
+		w, _ := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		writeQuotedPrintable(w, part.content)
+	}
+	parts.Close()
 
-func (e *EmailService) SendPasswordChanged(to string) error {
-	subject := "Password Successfully Changed"
-	body := `
-<!DOCTYPE html>
-<html>
-<head>
-    <style>
-        body { font-family: Arial, sans-serif; }
-        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <h2>Password Changed</h2>
-        <p>Your password has been successfully changed.</p>
-        <p>If you didn't make this change, please contact support immediately.</p>
-    </div>
-</body>
-</html>
-`
-
-	return e.send(to, subject, body)
+	return b.Bytes()
 }
 
-func (e *EmailService) SendEmailChanged(to, newEmail string) error {
-	subject := "Email Address Changed"
-	body := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <style>
-        body { font-family: Arial, sans-serif; }
-        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <h2>Email Address Changed</h2>
-        <p>Your email address has been successfully changed to: <strong>%s</strong></p>
-        <p>If you didn't make this change, please contact support immediately.</p>
-    </div>
-</body>
-</html>
-`, newEmail)
-
-	return e.send(to, subject, body)
+func writeQuotedPrintable(w io.Writer, content string) {
+	qp := quotedprintable.NewWriter(w)
+	qp.Write([]byte(content))
+	qp.Close()
 }
 
-func (e *EmailService) SendAccountDeleted(to string) error {
-	subject := "Account Deleted"
-	body := `
-<!DOCTYPE html>
-<html>
-<head>
-    <style>
-        body { font-family: Arial, sans-serif; }
-        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <h2>Account Deleted</h2>
-        <p>Your account has been successfully deleted.</p>
-        <p>We're sorry to see you go. If you change your mind, you can create a new account anytime.</p>
-    </div>
-</body>
-</html>
-`
-
-	return e.send(to, subject, body)
+// PasswordResetOTP carries the code that confirms a password reset.
+func PasswordResetOTP(loc locale.Locale, to, otpCode string) (Message, error) {
+	return render(loc, KindPasswordResetOTP, to, data{Code: otpCode})
 }
 
-func (e *EmailService) send(to, subject, body string) error {
-	from := e.cfg.SMTP.From
-	if from == "" {
-		from = e.cfg.SMTP.Username
-	}
-
-	msg := fmt.Sprintf("From: %s\r\n"+
-		"To: %s\r\n"+
-		"Subject: %s\r\n"+
-		"MIME-Version: 1.0\r\n"+
-		"Content-Type: text/html; charset=UTF-8\r\n"+
-		"\r\n"+
-		"%s\r\n", from, to, subject, body)
+// EmailVerification carries the code that confirms an email address.
+func EmailVerification(loc locale.Locale, to, otpCode string) (Message, error) {
+	return render(loc, KindEmailVerification, to, data{Code: otpCode})
+}
 
-	auth := smtp.PlainAuth("", e.cfg.SMTP.Username, e.cfg.SMTP.Password, e.cfg.SMTP.Host)
+// EmailChangeRequested warns the old address that a change was requested.
+func EmailChangeRequested(loc locale.Locale, to, newEmail string) (Message, error) {
+	return render(loc, KindEmailChangeRequested, to, data{NewEmail: newEmail})
+}
 
-	addr := fmt.Sprintf("%s:%d", e.cfg.SMTP.Host, e.cfg.SMTP.Port)
+// PasswordChanged confirms a password change.
+func PasswordChanged(loc locale.Locale, to string) (Message, error) {
+	return render(loc, KindPasswordChanged, to, data{})
+}
 
-	err := smtp.SendMail(addr, auth, from, []string{to}, []byte(msg))
-	if err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
-	}
+// EmailChanged confirms an email change, to both addresses.
+func EmailChanged(loc locale.Locale, to, newEmail string) (Message, error) {
+	return render(loc, KindEmailChanged, to, data{NewEmail: newEmail})
+}
 
-	return nil
+// AccountDeleted confirms an account deletion.
+func AccountDeleted(loc locale.Locale, to string) (Message, error) {
+	return render(loc, KindAccountDeleted, to, data{})
 }
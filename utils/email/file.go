@@ -0,0 +1,59 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// fileSender delivers emails into a maildir, one file per email, so they can
+// be read with any mail client or just cat.
+type fileSender struct {
+	dir      string
+	from     string
+	hostname string
+	seq      atomic.Uint64
+}
+
+func newFileSender(dir, from string) (*fileSender, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create maildir %s: %w", dir, err)
+		}
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+
+	return &fileSender{
+		dir:  dir,
+		from: from,
+		// Maildir names can't hold '/' or ':'
+		hostname: strings.NewReplacer("/", `\057`, ":", `\072`).Replace(hostname),
+	}, nil
+}
+
+// Send writes the email to tmp and then moves it to new, so readers never
+// see half a file.
+func (f *fileSender) Send(ctx context.Context, msg Message) error {
+	now := time.Now()
+	name := fmt.Sprintf("%d.M%dP%dQ%d.%s", now.Unix(), now.Nanosecond()/1000, os.Getpid(), f.seq.Add(1), f.hostname)
+
+	tmp := filepath.Join(f.dir, "tmp", name)
+	if err := os.WriteFile(tmp, msg.raw(f.from), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, filepath.Join(f.dir, "new", name)); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to deliver %s: %w", name, err)
+	}
+
+	return nil
+}
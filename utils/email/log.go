@@ -0,0 +1,33 @@
+package email
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/citizenkz/core/utils/logger"
+)
+
+// logSender only logs emails. The text body, which holds one-time codes, is
+// logged at debug level.
+type logSender struct {
+	log *slog.Logger
+}
+
+func newLogSender(log *slog.Logger) *logSender {
+	return &logSender{
+		log: log,
+	}
+}
+
+func (l *logSender) Send(ctx context.Context, msg Message) error {
+	log := logger.FromContext(ctx, l.log).With(
+		slog.String("kind", msg.Kind),
+		slog.String("to", msg.To),
+		slog.String("subject", msg.Subject),
+	)
+
+	log.Info("email logged instead of sent")
+	log.Debug("email body", slog.String("body", msg.Text))
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package email
+
+import (
+	"context"
+	"sync"
+)
+
+// Recorder keeps the emails it is given in memory instead of sending them,
+// so tests can assert on what would have gone out.
+type Recorder struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Send(ctx context.Context, msg Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = append(r.messages, msg)
+
+	return nil
+}
+
+// Messages returns the recorded emails, oldest first.
+func (r *Recorder) Messages() []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Message(nil), r.messages...)
+}
+
+// Last returns the most recent email, if any.
+func (r *Recorder) Last() (Message, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.messages) == 0 {
+		return Message{}, false
+	}
+
+	return r.messages[len(r.messages)-1], true
+}
+
+// To returns the emails recorded for recipient, oldest first.
+func (r *Recorder) To(recipient string) []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var messages []Message
+	for _, msg := range r.messages {
+		if msg.To == recipient {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages
+}
+
+// Reset forgets every recorded email.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = nil
+}
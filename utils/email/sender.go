@@ -0,0 +1,87 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/citizenkz/core/config"
+	"github.com/citizenkz/core/utils/metrics"
+	"github.com/citizenkz/core/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	TransportSMTP = "smtp"
+	TransportFile = "file"
+	TransportLog  = "log"
+)
+
+// defaultFrom is the sender of emails when SMTP sets neither a from address
+// nor a username.
+const defaultFrom = "noreply@localhost"
+
+// EmailSender delivers a rendered email.
+type EmailSender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New returns the sender cfg.Email.Transport selects. Every send gets an
+// "email.send" span and is counted in metrics under its kind.
+func New(cfg *config.Config, log *slog.Logger) (EmailSender, error) {
+	from := cfg.SMTP.From
+	if from == "" {
+		from = cfg.SMTP.Username
+	}
+	if from == "" {
+		from = defaultFrom
+	}
+
+	var sender EmailSender
+	switch cfg.Email.Transport {
+	case TransportSMTP, "":
+		smtpSender, err := newSMTPSender(cfg.SMTP, from)
+		if err != nil {
+			return nil, err
+		}
+		sender = smtpSender
+	case TransportFile:
+		fileSender, err := newFileSender(cfg.Email.Dir, from)
+		if err != nil {
+			return nil, err
+		}
+		sender = fileSender
+	case TransportLog:
+		sender = newLogSender(log)
+	default:
+		return nil, fmt.Errorf("unknown email transport %q", cfg.Email.Transport)
+	}
+
+	return &instrumented{
+		next:      sender,
+		transport: cfg.Email.Transport,
+	}, nil
+}
+
+// instrumented traces and counts the sends of next.
+type instrumented struct {
+	next      EmailSender
+	transport string
+}
+
+func (i *instrumented) Send(ctx context.Context, msg Message) error {
+	ctx, span := tracing.Start(ctx, "email.send",
+		attribute.String("email.kind", msg.Kind),
+		attribute.String("email.transport", i.transport),
+	)
+	defer span.End()
+
+	err := i.next.Send(ctx, msg)
+	metrics.EmailsSent.WithLabelValues(msg.Kind, metrics.Result(err)).Inc()
+	tracing.Fail(span, err)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/citizenkz/core/utils/locale"
+)
+
+// Every email has, per locale, templates/<locale>/<kind>.html defining
+// "content", which is wrapped in templates/layout.html, and
+// templates/<locale>/<kind>.txt defining "subject" and "text".
+//
+//go:embed templates
+var templateFS embed.FS
+
+var kinds = []string{
+	KindPasswordResetOTP,
+	KindEmailVerification,
+	KindEmailChangeRequested,
+	KindPasswordChanged,
+	KindEmailChanged,
+	KindAccountDeleted,
+}
+
+// data is what templates are executed with.
+type data struct {
+	Locale   string
+	Code     string
+	NewEmail string
+}
+
+type templateKey struct {
+	locale locale.Locale
+	kind   string
+}
+
+type template struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// templates are parsed once at startup; a missing or broken template panics
+// there rather than when the email is due.
+var templates = mustParseTemplates()
+
+func mustParseTemplates() map[templateKey]template {
+	parsed := make(map[templateKey]template, len(locale.Supported)*len(kinds))
+	for _, loc := range locale.Supported {
+		for _, kind := range kinds {
+			dir := "templates/" + loc.String() + "/"
+			parsed[templateKey{loc, kind}] = template{
+				html: htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/layout.html", dir+kind+".html")),
+				text: texttemplate.Must(texttemplate.ParseFS(templateFS, dir+kind+".txt")),
+			}
+		}
+	}
+
+	return parsed
+}
+
+// render builds the kind email to to in loc, or in the default locale when
+// loc isn't supported. HTML is escaped by html/template.
+func render(loc locale.Locale, kind, to string, d data) (Message, error) {
+	if !loc.IsValid() {
+		loc = locale.Default
+	}
+	d.Locale = loc.String()
+
+	t, ok := templates[templateKey{loc, kind}]
+	if !ok {
+		return Message{}, fmt.Errorf("no %s template for %s", kind, loc)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", d); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s subject: %w", kind, err)
+	}
+	if err := t.text.ExecuteTemplate(&text, "text", d); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s text: %w", kind, err)
+	}
+	if err := t.html.ExecuteTemplate(&html, "layout.html", d); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", kind, err)
+	}
+
+	return Message{
+		Kind:    kind,
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
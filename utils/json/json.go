@@ -2,16 +2,27 @@ package json
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/validate"
 )
 
+// ParseJSON decodes the body into model and checks it against the model's
+// `validate` tags.
 func ParseJSON(r *http.Request, model any) error {
 	if r.Body == nil {
-		return fmt.Errorf("Missing request body")
+		return apperr.BadRequest("Missing request body")
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(model); err != nil {
+		return apperr.BadRequest("%w", err)
 	}
 
-	return json.NewDecoder(r.Body).Decode(model)
+	return validate.Struct(model)
 }
 
 func WriteJSON(w http.ResponseWriter, status int, v any) error {
@@ -21,6 +32,45 @@ func WriteJSON(w http.ResponseWriter, status int, v any) error {
 	return json.NewEncoder(w).Encode(v)
 }
 
+// WriteError writes err with a status the caller has already decided on,
+// e.g. 400 for a body that doesn't parse.
 func WriteError(w http.ResponseWriter, status int, err error) {
-	WriteJSON(w, status, map[string]string{"error": err.Error()})
+	writeError(w, status, apperr.CodeForStatus(status), err.Error(), nil)
+}
+
+// WriteAppError writes an error returned by ParseJSON or a usecase with the
+// status of its apperr code. Internal errors are answered without their
+// message, which is only worth logging.
+func WriteAppError(w http.ResponseWriter, err error) {
+	code := apperr.CodeOf(err)
+
+	var fields map[string]string
+	var appErr *apperr.Error
+	if errors.As(err, &appErr) {
+		fields = appErr.Fields
+	}
+
+	message := err.Error()
+	if code == apperr.CodeInternal {
+		message = "internal server error"
+	}
+
+	var throttled apperr.Throttled
+	if errors.As(err, &throttled) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter().Seconds()))))
+	}
+
+	writeError(w, code.Status(), code, message, fields)
+}
+
+func writeError(w http.ResponseWriter, status int, code apperr.Code, message string, fields map[string]string) {
+	body := map[string]any{
+		"error": message,
+		"code":  code,
+	}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+
+	WriteJSON(w, status, body)
 }
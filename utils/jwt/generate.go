@@ -7,12 +7,33 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func Generate(ctx context.Context, user_id int, secret string) (string, error) {
+// ChallengePurpose marks tokens that only allow finishing a two-factor login.
+const ChallengePurpose = "2fa"
+
+func Generate(ctx context.Context, user_id int, role string, emailVerified bool, secret string, ttl time.Duration) (string, error) {
+	token := jwt.New(jwt.SigningMethodHS256)
+
+	claims := token.Claims.(jwt.MapClaims)
+	claims["user_id"] = user_id
+	claims["role"] = role
+	claims["email_verified"] = emailVerified
+	claims["exp"] = time.Now().Add(ttl).Unix()
+
+	tokenString, err := token.SignedString([]byte(secret))
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+func GenerateChallenge(ctx context.Context, user_id int, secret string, ttl time.Duration) (string, error) {
 	token := jwt.New(jwt.SigningMethodHS256)
 
 	claims := token.Claims.(jwt.MapClaims)
 	claims["user_id"] = user_id
-	claims["exp"] = time.Now().Add(time.Hour * 24 * 365).Unix()
+	claims["purpose"] = ChallengePurpose
+	claims["exp"] = time.Now().Add(ttl).Unix()
 
 	tokenString, err := token.SignedString([]byte(secret))
 	if err != nil {
@@ -0,0 +1,81 @@
+package jwt
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/citizenkz/core/utils/apperr"
+	"github.com/citizenkz/core/utils/json"
+)
+
+// RequireRole rejects requests whose bearer token doesn't carry one of roles.
+func RequireRole(secret string, roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := ParseTokenFromHeader(r)
+			if err != nil {
+				json.WriteError(w, http.StatusUnauthorized, err)
+				return
+			}
+
+			role, err := ParseRole(r.Context(), token, secret)
+			if err != nil {
+				json.WriteError(w, http.StatusUnauthorized, err)
+				return
+			}
+
+			if !slices.Contains(roles, role) {
+				json.WriteError(w, http.StatusForbidden, errors.New("insufficient role"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireVerified rejects requests whose bearer token was issued before the
+// user confirmed their email. Refreshing after verification gets a new one.
+func RequireVerified(secret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := ParseTokenFromHeader(r)
+			if err != nil {
+				json.WriteError(w, http.StatusUnauthorized, err)
+				return
+			}
+
+			verified, err := ParseEmailVerified(r.Context(), token, secret)
+			if err != nil {
+				json.WriteError(w, http.StatusUnauthorized, err)
+				return
+			}
+
+			if !verified {
+				json.WriteAppError(w, apperr.Unverified("email not verified, confirm it with /auth/verify-email or request a new code with /auth/verify-email/resend"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Identify returns a function that reports the user of a request's access
+// token, for labelling logs. Requests without a valid one are anonymous.
+func Identify(secret string) func(r *http.Request) (int, bool) {
+	return func(r *http.Request) (int, bool) {
+		token, err := ParseTokenFromHeader(r)
+		if err != nil {
+			return 0, false
+		}
+
+		userID, err := ParseUserID(r.Context(), token, secret)
+		if err != nil {
+			return 0, false
+		}
+
+		return userID, true
+	}
+}
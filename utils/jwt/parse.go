@@ -6,10 +6,66 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/citizenkz/core/utils/apperr"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 func ParseUserID(ctx context.Context, tokenString string, secret string) (int, error) {
+	claims, err := parseClaims(tokenString, secret, "")
+	if err != nil {
+		return 0, err
+	}
+
+	return userIDClaim(claims)
+}
+
+// ParseChallenge returns the user of a two-factor challenge token.
+func ParseChallenge(ctx context.Context, tokenString string, secret string) (int, error) {
+	claims, err := parseClaims(tokenString, secret, ChallengePurpose)
+	if err != nil {
+		return 0, err
+	}
+
+	return userIDClaim(claims)
+}
+
+func userIDClaim(claims jwt.MapClaims) (int, error) {
+	if uid, ok := claims["user_id"].(float64); ok {
+		return int(uid), nil
+	}
+
+	return 0, apperr.Unauthorized("user_id not found in token claims")
+}
+
+// ParseRole returns the role claim of the token. Tokens issued before roles
+// existed carry no claim and get an empty role.
+func ParseRole(ctx context.Context, tokenString string, secret string) (string, error) {
+	claims, err := parseClaims(tokenString, secret, "")
+	if err != nil {
+		return "", err
+	}
+
+	role, _ := claims["role"].(string)
+
+	return role, nil
+}
+
+// ParseEmailVerified reports whether the token was issued to a user with a
+// confirmed email. Tokens issued before the claim existed count as unverified.
+func ParseEmailVerified(ctx context.Context, tokenString string, secret string) (bool, error) {
+	claims, err := parseClaims(tokenString, secret, "")
+	if err != nil {
+		return false, err
+	}
+
+	verified, _ := claims["email_verified"].(bool)
+
+	return verified, nil
+}
+
+// parseClaims verifies the token and its purpose claim. Access tokens have
+// none, so a challenge token can never be used as one.
+func parseClaims(tokenString string, secret string, purpose string) (jwt.MapClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("unexpected signing method")
@@ -17,17 +73,17 @@ func ParseUserID(ctx context.Context, tokenString string, secret string) (int, e
 		return []byte(secret), nil
 	})
 	if err != nil {
-		return 0, err
+		return nil, apperr.Unauthorized("%w", err)
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		if uid, ok := claims["user_id"].(float64); ok {
-			return int(uid), nil
+		if p, _ := claims["purpose"].(string); p != purpose {
+			return nil, apperr.Unauthorized("invalid token purpose")
 		}
-		return 0, errors.New("user_id not found in token claims")
+		return claims, nil
 	}
 
-	return 0, errors.New("invalid token")
+	return nil, apperr.Unauthorized("invalid token")
 }
 
 func ParseTokenFromHeader(r *http.Request) (string, error) {
@@ -0,0 +1,46 @@
+package locale
+
+import (
+	"context"
+	"net/http"
+)
+
+type ctxKey struct{}
+
+// WithContext returns a copy of ctx that carries l.
+func WithContext(ctx context.Context, l Locale) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the locale ctx carries, or Default.
+func FromContext(ctx context.Context) Locale {
+	l, ok := ctx.Value(ctxKey{}).(Locale)
+	if !ok {
+		return Default
+	}
+
+	return l
+}
+
+// FromRequest picks the locale to answer r in: the lang query parameter when
+// it names a supported locale, else the Accept-Language header.
+func FromRequest(r *http.Request) Locale {
+	if l := Locale(r.URL.Query().Get("lang")); l.IsValid() {
+		return l
+	}
+
+	return FromAcceptLanguage(r.Header.Get("Accept-Language"))
+}
+
+// Middleware puts the locale of each request in its context and reports it
+// in the Content-Language header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := FromRequest(r)
+		w.Header().Set("Content-Language", l.String())
+		// Responses differ by language
+		w.Header().Add("Vary", "Accept-Language")
+
+		next.ServeHTTP(w, r.WithContext(WithContext(r.Context(), l)))
+	})
+}
@@ -0,0 +1,106 @@
+package locale
+
+import (
+	"slices"
+
+	"golang.org/x/text/language"
+)
+
+// Locale is a language the service speaks, as a BCP 47 base tag.
+type Locale string
+
+const (
+	Kazakh  Locale = "kk"
+	Russian Locale = "ru"
+	English Locale = "en"
+)
+
+// Default is the locale of users who never chose one.
+const Default = English
+
+// Supported lists every locale, the default first.
+var Supported = []Locale{English, Kazakh, Russian}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+func (l Locale) IsValid() bool {
+	switch l {
+	case Kazakh, Russian, English:
+		return true
+	default:
+		return false
+	}
+}
+
+// matcher picks among Supported; its first tag is the answer when nothing
+// matches.
+var matcher = language.NewMatcher(func() []language.Tag {
+	tags := make([]language.Tag, 0, len(Supported))
+	for _, l := range Supported {
+		tags = append(tags, language.Make(l.String()))
+	}
+	return tags
+}())
+
+// FromAcceptLanguage picks the supported locale that best fits an
+// Accept-Language header, e.g. "kk-KZ,ru;q=0.8". It is Default for an empty
+// or unreadable header.
+func FromAcceptLanguage(header string) Locale {
+	if header == "" {
+		return Default
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+
+	return Supported[index]
+}
+
+// Translated lists the locales content is translated into. Content itself
+// is written in Default.
+var Translated = []Locale{Kazakh, Russian}
+
+// IsTranslated reports whether content is translated into l.
+func (l Locale) IsTranslated() bool {
+	return slices.Contains(Translated, l)
+}
+
+// Fallbacks lists the translations to serve l from, best first. Content
+// missing from all of them is served in Default. Kazakh readers get Russian
+// rather than English when there's no Kazakh text.
+func (l Locale) Fallbacks() []Locale {
+	switch l {
+	case Kazakh:
+		return []Locale{Kazakh, Russian}
+	case Russian:
+		return []Locale{Russian}
+	default:
+		return nil
+	}
+}
+
+// Overlays picks the translations to serve l from, in the order to apply
+// them: the best one last, so each field ends up with the best translation
+// that has it.
+func Overlays[T any](l Locale, translations []T, localeOf func(T) Locale) []T {
+	fallbacks := l.Fallbacks()
+	result := make([]T, 0, len(fallbacks))
+	for i := len(fallbacks) - 1; i >= 0; i-- {
+		for _, t := range translations {
+			if localeOf(t) == fallbacks[i] {
+				result = append(result, t)
+			}
+		}
+	}
+
+	return result
+}
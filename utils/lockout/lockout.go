@@ -0,0 +1,126 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrLocked = errors.New("too many failed attempts")
+
+// LockedError tells how long a throttled key has to wait.
+type LockedError struct {
+	Wait time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s, retry in %s", ErrLocked, e.Wait.Round(time.Second))
+}
+
+// RetryAfter makes a LockedError an apperr.Throttled.
+func (e *LockedError) RetryAfter() time.Duration {
+	return e.Wait
+}
+
+func (e *LockedError) Is(target error) bool {
+	return target == ErrLocked
+}
+
+// State is what a Store keeps about the failures of one key.
+type State struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// Store persists lockout state. Entries may be dropped once ttl has passed.
+type Store interface {
+	// Update replaces the state of key with what fn returns, atomically with
+	// respect to other updates of key. When fn fails nothing is stored.
+	Update(ctx context.Context, key string, ttl time.Duration, fn func(state State) (State, error)) error
+	Delete(ctx context.Context, key string) error
+}
+
+// Policy doubles the wait after each failure from BaseDelay up to MaxDelay
+// and locks the key for LockDuration after MaxFailures failures.
+type Policy struct {
+	MaxFailures  int
+	LockDuration time.Duration
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+}
+
+type Limiter struct {
+	store  Store
+	policy Policy
+}
+
+func New(store Store, policy Policy) *Limiter {
+	return &Limiter{
+		store:  store,
+		policy: policy,
+	}
+}
+
+// Reserve counts an attempt of the key as a failure before it is made, so
+// concurrent attempts can't all pass before any of them is counted. It
+// returns a *LockedError, counting nothing, while the key is locked or still
+// inside the delay that follows its last failure. A key locks once its
+// failures reach MaxFailures and keeps the count through the lock, so every
+// failure after it locks the key again.
+func (l *Limiter) Reserve(ctx context.Context, key string) error {
+	return l.store.Update(ctx, key, l.ttl(), func(state State) (State, error) {
+		now := time.Now()
+		if now.Before(state.LockedUntil) {
+			return state, &LockedError{Wait: state.LockedUntil.Sub(now)}
+		}
+
+		if state.Failures > 0 {
+			next := state.LastFailure.Add(l.delay(state.Failures))
+			if now.Before(next) {
+				return state, &LockedError{Wait: next.Sub(now)}
+			}
+		}
+
+		state.Failures++
+		state.LastFailure = now
+		if state.Failures >= l.policy.MaxFailures {
+			state.LockedUntil = now.Add(l.policy.LockDuration)
+		}
+
+		return state, nil
+	})
+}
+
+// Release takes back an attempt Reserve counted, for one that turned out not
+// to be a failure. A lock the attempt set is lifted with it.
+func (l *Limiter) Release(ctx context.Context, key string) error {
+	return l.store.Update(ctx, key, l.ttl(), func(state State) (State, error) {
+		if state.LockedUntil.Equal(state.LastFailure.Add(l.policy.LockDuration)) {
+			state.LockedUntil = time.Time{}
+		}
+		state.Failures = max(state.Failures-1, 0)
+
+		return state, nil
+	})
+}
+
+// Reset forgets the failures of the key after a success.
+func (l *Limiter) Reset(ctx context.Context, key string) error {
+	return l.store.Delete(ctx, key)
+}
+
+// ttl keeps the failures for a lock duration after a lock ends.
+func (l *Limiter) ttl() time.Duration {
+	return 2 * l.policy.LockDuration
+}
+
+func (l *Limiter) delay(failures int) time.Duration {
+	delay := l.policy.BaseDelay
+	for i := 1; i < failures && delay < l.policy.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, l.policy.MaxDelay)
+}
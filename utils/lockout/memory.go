@@ -0,0 +1,70 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepEvery is how many writes pass between removals of expired entries.
+const sweepEvery = 1024
+
+type memoryEntry struct {
+	state   State
+	expires time.Time
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	writes  int
+}
+
+// NewMemoryStore keeps lockout state in process memory. It suits a single
+// instance and development; state is lost on restart.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		entries: make(map[string]memoryEntry),
+	}
+}
+
+func (s *memoryStore) Update(ctx context.Context, key string, ttl time.Duration, fn func(state State) (State, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var state State
+	if entry, ok := s.entries[key]; ok && !now.After(entry.expires) {
+		state = entry.state
+	}
+
+	state, err := fn(state)
+	if err != nil {
+		return err
+	}
+
+	s.entries[key] = memoryEntry{
+		state:   state,
+		expires: now.Add(ttl),
+	}
+
+	s.writes++
+	if s.writes%sweepEvery == 0 {
+		for k, entry := range s.entries {
+			if now.After(entry.expires) {
+				delete(s.entries, k)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+
+	return nil
+}
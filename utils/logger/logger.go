@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// EnvLocal is the config.Env of a developer machine, where logs are read by
+// people rather than shipped to a collector.
+const EnvLocal = "local"
+
+type ctxKey struct{}
+
+// New builds the root logger for env: text at debug level locally, JSON at
+// info level everywhere else.
+func New(env string, w io.Writer) *slog.Logger {
+	if env == EnvLocal {
+		return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+			Level: slog.LevelDebug,
+		}))
+	}
+
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+}
+
+// WithContext returns a copy of ctx carrying log.
+func WithContext(ctx context.Context, log *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, log)
+}
+
+// FromContext returns the logger of the request ctx belongs to, or fallback
+// outside of a request. The chi route pattern is added here rather than in
+// Middleware, since it is only known once routing has reached the handler.
+func FromContext(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	log, ok := ctx.Value(ctxKey{}).(*slog.Logger)
+	if !ok {
+		return fallback
+	}
+
+	if rctx := chi.RouteContext(ctx); rctx != nil && rctx.RoutePattern() != "" {
+		return log.With(slog.String("route", rctx.RoutePattern()))
+	}
+
+	return log
+}
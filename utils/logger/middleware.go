@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader carries the request ID in both directions, so a proxy or
+// client can pass its own and find it in our logs.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds an incoming ID, since it is copied into every
+// log line of the request.
+const maxRequestIDLength = 128
+
+// Middleware gives every request an ID and a logger carrying it, the trace ID
+// when the request is traced and the user ID that identify returns, if any.
+// The logger is put in the request context for FromContext, and logs the
+// request once it is done.
+func Middleware(log *slog.Logger, identify func(r *http.Request) (int, bool)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			requestID := r.Header.Get(RequestIDHeader)
+			if requestID == "" || len(requestID) > maxRequestIDLength {
+				requestID = uuid.NewString()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			reqLog := log.With(
+				slog.String("request_id", requestID),
+				slog.String("method", r.Method),
+			)
+			if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+				reqLog = reqLog.With(slog.String("trace_id", sc.TraceID().String()))
+			}
+			if userID, ok := identify(r); ok {
+				reqLog = reqLog.With(slog.Int("user_id", userID))
+			}
+
+			ctx := WithContext(r.Context(), reqLog)
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			FromContext(ctx, reqLog).Log(ctx, level, "request completed",
+				slog.String("path", r.URL.Path),
+				slog.Int("status", status),
+				slog.Int("bytes", ww.BytesWritten()),
+				slog.Duration("duration", time.Since(start)),
+			)
+		})
+	}
+}
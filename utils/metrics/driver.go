@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver wraps an ent driver to time every query and count how transactions
+// end.
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver wraps drv.
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return observe("exec", "false", func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return observe("query", "false", func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx}, nil
+}
+
+// BeginTx is Tx with options, for ent's Client.BeginTx.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, errors.New("metrics: driver does not support BeginTx")
+	}
+
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx}, nil
+}
+
+// Tx is a transaction of Driver.
+type Tx struct {
+	dialect.Tx
+}
+
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	return observe("exec", "true", func() error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	return observe("query", "true", func() error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Commit() error {
+	if err := t.Tx.Commit(); err != nil {
+		return err
+	}
+	DBTransactions.WithLabelValues("commit").Inc()
+
+	return nil
+}
+
+func (t *Tx) Rollback() error {
+	DBTransactions.WithLabelValues("rollback").Inc()
+
+	return t.Tx.Rollback()
+}
+
+func observe(kind, inTx string, query func() error) error {
+	start := time.Now()
+	err := query()
+	DBQueryDuration.WithLabelValues(kind, inTx).Observe(time.Since(start).Seconds())
+	if err != nil {
+		DBQueryErrors.WithLabelValues(kind).Inc()
+	}
+
+	return err
+}
@@ -0,0 +1,316 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	atlas "ariga.io/atlas/sql/migrate"
+)
+
+// lockID keys the advisory lock that keeps two instances from migrating at
+// once.
+const lockID = 7310255
+
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version character varying PRIMARY KEY,
+	name character varying NOT NULL,
+	applied_at timestamptz NOT NULL DEFAULT now()
+)`
+
+var ErrUnknownVersion = errors.New("database has migrations this build doesn't know")
+
+// Migration is a pair of {version}_{name}.up.sql and .down.sql files.
+type Migration struct {
+	Version string
+	Name    string
+	up      string
+	down    string
+}
+
+// Status is a migration and when it was applied, nil if it is pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	log        *slog.Logger
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New reads the migrations in fsys and checks them against its atlas.sum, so
+// a migration edited after it was generated is refused.
+func New(log *slog.Logger, db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	dir := atlas.OpenMemDir("migrations")
+	defer dir.Close()
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[string]*Migration)
+	for _, entry := range entries {
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile(entry.Name(), data); err != nil {
+			return nil, err
+		}
+
+		base, direction, ok := strings.Cut(strings.TrimSuffix(entry.Name(), ".sql"), ".")
+		if !ok || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		version, name, _ := strings.Cut(base, "_")
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+
+		switch direction {
+		case "up":
+			m.up = string(data)
+		case "down":
+			m.down = string(data)
+		default:
+			return nil, fmt.Errorf("unexpected migration file %q", entry.Name())
+		}
+	}
+
+	if err := atlas.Validate(dir); err != nil {
+		return nil, fmt.Errorf("invalid migrations directory: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, fmt.Errorf("migration %s has no up file", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return strings.Compare(a.Version, b.Version)
+	})
+
+	return &Migrator{
+		log:        log,
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Status lists every migration with when it was applied. It fails with
+// ErrUnknownVersion when the database is ahead of this build. It only reads,
+// so a database that was never migrated reports every migration pending.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists); err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]time.Time)
+	if exists {
+		var err error
+		if applied, err = m.applied(ctx, m.db); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+
+	if len(applied) > 0 {
+		return statuses, ErrUnknownVersion
+	}
+
+	return statuses, nil
+}
+
+// Pending returns the migrations that haven't been applied yet.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]Migration, 0)
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending = append(pending, status.Migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// Up applies every pending migration in order, each in its own transaction.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	return m.locked(ctx, func(conn *sql.Conn, applied map[string]time.Time) ([]Migration, error) {
+		done := make([]Migration, 0)
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration.up,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`,
+				migration.Version, migration.Name,
+			); err != nil {
+				return done, fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+
+		return done, nil
+	})
+}
+
+// Down reverts the last steps applied migrations, newest first.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	return m.locked(ctx, func(conn *sql.Conn, applied map[string]time.Time) ([]Migration, error) {
+		done := make([]Migration, 0)
+		for _, migration := range slices.Backward(m.migrations) {
+			if len(done) == steps {
+				break
+			}
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if migration.down == "" {
+				return done, fmt.Errorf("migration %s has no down file", migration.Version)
+			}
+			if err := m.apply(ctx, conn, migration.down,
+				`DELETE FROM schema_migrations WHERE version = $1`,
+				migration.Version,
+			); err != nil {
+				return done, fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+
+		return done, nil
+	})
+}
+
+// Baseline records the migrations up to and including version as applied
+// without running them, for databases created before migrations existed.
+func (m *Migrator) Baseline(ctx context.Context, version string) ([]Migration, error) {
+	if !slices.ContainsFunc(m.migrations, func(migration Migration) bool { return migration.Version == version }) {
+		return nil, fmt.Errorf("unknown migration version %q", version)
+	}
+
+	return m.locked(ctx, func(conn *sql.Conn, applied map[string]time.Time) ([]Migration, error) {
+		done := make([]Migration, 0)
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if _, err := conn.ExecContext(ctx,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`,
+				migration.Version, migration.Name,
+			); err != nil {
+				return done, err
+			}
+			done = append(done, migration)
+		}
+
+		return done, nil
+	})
+}
+
+// locked runs fn on one connection holding the migration lock, with the
+// applied versions read after the lock was taken.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, applied map[string]time.Time) ([]Migration, error)) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return nil, err
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID)
+
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn(conn, applied)
+}
+
+// apply runs a migration script and its bookkeeping statement in one
+// transaction.
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, script string, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			m.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			m.log.Error("failed to rollback transaction", slog.String("error", rollbackErr.Error()))
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func (m *Migrator) applied(ctx context.Context, q querier) (map[string]time.Time, error) {
+	rows, err := q.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[string]time.Time)
+	for rows.Next() {
+		var version string
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Driver wraps an ent driver to give every query its own span, so time spent
+// in Postgres shows up apart from time spent in Go.
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver wraps drv.
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "exec", query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "query", query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx}, nil
+}
+
+// BeginTx is Tx with options, for ent's Client.BeginTx.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, errors.New("tracing: driver does not support BeginTx")
+	}
+
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx}, nil
+}
+
+// Tx is a transaction of Driver.
+type Tx struct {
+	dialect.Tx
+}
+
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "exec", query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, "query", query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// traceQuery runs query in a span. Only the statement is recorded,
+// never its arguments, which may hold personal data.
+func traceQuery(ctx context.Context, kind, statement string, query func(ctx context.Context) error) error {
+	ctx, span := Start(ctx, "db."+kind,
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", statement),
+	)
+	defer span.End()
+
+	err := query(ctx)
+	Fail(span, err)
+
+	return err
+}
@@ -0,0 +1,121 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// checkTags reports the first `validate` tag in t, or in the types it holds,
+// that Struct can't apply. The result is cached, so each type is checked once.
+func checkTags(t reflect.Type) error {
+	if t == nil {
+		return nil
+	}
+
+	if cached, ok := tagErrors.Load(t); ok {
+		err, _ := cached.(error)
+		return err
+	}
+
+	err := walkTags(t, make(map[reflect.Type]bool))
+	tagErrors.Store(t, err)
+
+	return err
+}
+
+func walkTags(t reflect.Type, seen map[reflect.Type]bool) error {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t == timeType || seen[t] {
+		return nil
+	}
+	seen[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if tag := field.Tag.Get("validate"); tag != "" {
+			if err := checkRules(t, field, tag); err != nil {
+				return fmt.Errorf("validate: %s.%s: %w", t.Name(), field.Name, err)
+			}
+		}
+
+		if err := walkTags(field.Type, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRules reports a rule in tag that is unknown, has a bad parameter or
+// can't apply to field.
+func checkRules(parent reflect.Type, field reflect.StructField, tag string) error {
+	value := field.Type
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+
+		switch name {
+		case "omitempty", "required":
+		case "required_if":
+			other, want, _ := strings.Cut(param, " ")
+			if want == "" {
+				return fmt.Errorf("bad required_if parameter %q", param)
+			}
+			if _, ok := parent.FieldByName(other); !ok {
+				return fmt.Errorf("required_if names unknown field %q", other)
+			}
+		case "eqfield":
+			if _, ok := parent.FieldByName(param); !ok {
+				return fmt.Errorf("eqfield names unknown field %q", param)
+			}
+		case "email":
+			if value.Kind() != reflect.String {
+				return errors.New("email needs a string")
+			}
+		case "min", "max":
+			if _, err := strconv.ParseFloat(param, 64); err != nil {
+				return fmt.Errorf("bad %s parameter %q", name, param)
+			}
+			if !measurable(value.Kind()) {
+				return fmt.Errorf("%s can't measure %s", name, value.Kind())
+			}
+		case "oneof":
+			if len(strings.Fields(param)) == 0 {
+				return errors.New("oneof needs values")
+			}
+		case "past":
+			if value != timeType {
+				return errors.New("past needs a time")
+			}
+		default:
+			return fmt.Errorf("unknown rule %q", name)
+		}
+	}
+
+	return nil
+}
+
+func measurable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
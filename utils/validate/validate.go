@@ -0,0 +1,217 @@
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/citizenkz/core/utils/apperr"
+)
+
+// Rules are declared in a `validate` tag as a comma-separated list:
+//
+//	required            the value must be set; blank strings and empty slices aren't
+//	required_if=F v     required when field F equals v
+//	email               a plain email address
+//	min=n, max=n        length for strings and slices, value for numbers
+//	eqfield=F           equal to field F
+//	oneof=a b c         one of the listed values
+//	past                a time that isn't in the future
+//	omitempty           stop here when the value is unset
+//
+// Rules other than required, required_if and eqfield skip unset values.
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// tagErrors caches the result of checkTags by type.
+var tagErrors sync.Map
+
+// Struct checks v, a pointer to a struct, against its `validate` tags. Nested
+// structs, pointers and slices are checked too. Every failing field is
+// reported under its JSON path, e.g. "filters[0].filter_id", in an apperr
+// validation error. Tags that name an unknown rule, or one that doesn't fit
+// its field, are a plain error.
+func Struct(v any) error {
+	if err := checkTags(reflect.TypeOf(v)); err != nil {
+		return err
+	}
+
+	fields := make(map[string]string)
+	walk(reflect.ValueOf(v), "", fields)
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return apperr.InvalidFields(fields)
+}
+
+func walk(v reflect.Value, path string, fields map[string]string) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name := jsonName(field)
+			if path != "" {
+				name = path + "." + name
+			}
+
+			if tag := field.Tag.Get("validate"); tag != "" {
+				if msg := check(v, v.Field(i), tag); msg != "" {
+					fields[name] = msg
+					continue
+				}
+			}
+
+			walk(v.Field(i), name, fields)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i), fields)
+		}
+	}
+}
+
+// check returns the message of the first rule in tag that value breaks.
+func check(parent reflect.Value, value reflect.Value, tag string) string {
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+
+		switch name {
+		case "omitempty":
+			if isBlank(value) {
+				return ""
+			}
+		case "required":
+			if isBlank(value) {
+				return "is required"
+			}
+		case "required_if":
+			field, want, _ := strings.Cut(param, " ")
+			if fmt.Sprint(indirect(parent.FieldByName(field))) == want && isBlank(value) {
+				return fmt.Sprintf("is required when %s is %s", fieldName(parent, field), want)
+			}
+		case "eqfield":
+			if !reflect.DeepEqual(indirect(value), indirect(parent.FieldByName(param))) {
+				return "must match " + fieldName(parent, param)
+			}
+		default:
+			if isBlank(value) {
+				continue
+			}
+			if msg := checkValue(reflect.Indirect(value), name, param); msg != "" {
+				return msg
+			}
+		}
+	}
+
+	return ""
+}
+
+// checkValue applies the rules that only look at a set value.
+func checkValue(value reflect.Value, name string, param string) string {
+	switch name {
+	case "email":
+		address, err := mail.ParseAddress(value.String())
+		if err != nil || address.Address != value.String() {
+			return "must be a valid email"
+		}
+	case "min", "max":
+		limit, _ := strconv.ParseFloat(param, 64)
+		size, unit := measure(value)
+		if name == "min" && size < limit {
+			return fmt.Sprintf("must be at least %s%s", param, unit)
+		}
+		if name == "max" && size > limit {
+			return fmt.Sprintf("must be at most %s%s", param, unit)
+		}
+	case "oneof":
+		allowed := strings.Fields(param)
+		if !slices.Contains(allowed, fmt.Sprint(value.Interface())) {
+			return "must be one of " + strings.Join(allowed, ", ")
+		}
+	case "past":
+		if t, ok := value.Interface().(time.Time); ok && t.After(time.Now()) {
+			return "must not be in the future"
+		}
+	}
+
+	return ""
+}
+
+// measure returns what min and max compare against, with its unit.
+func measure(value reflect.Value) (float64, string) {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(value.Len()), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), ""
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), ""
+	default:
+		return value.Float(), ""
+	}
+}
+
+func isBlank(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	case reflect.String:
+		return strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	default:
+		return value.IsZero()
+	}
+}
+
+// indirect returns the value a pointer points to, or nil for a nil pointer.
+func indirect(value reflect.Value) any {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	return value.Interface()
+}
+
+func fieldName(parent reflect.Value, name string) string {
+	field, _ := parent.Type().FieldByName(name)
+	return jsonName(field)
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}